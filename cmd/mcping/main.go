@@ -0,0 +1,92 @@
+// Command mcping queries the status of a Minecraft: Java Edition server, like
+// the multiplayer menu does, and prints its version, player count and MOTD.
+//
+// Usage:
+//
+//	mcping [-timeout 5s] [-legacy] [-json] [-favicon icon.png] <host[:port]>
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-mclib/protocol/java_protocol/status"
+)
+
+func main() {
+	timeout := flag.Duration("timeout", 5*time.Second, "timeout of the whole exchange")
+	legacy := flag.Bool("legacy", false, "use the legacy (pre-1.7) 0xFE ping")
+	raw := flag.Bool("json", false, "print the status JSON as received")
+	favicon := flag.String("favicon", "", "save the server icon to this file")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] <host[:port]>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	address := flag.Arg(0)
+
+	var err error
+	if *legacy {
+		err = pingLegacy(address, *timeout)
+	} else {
+		err = ping(address, *timeout, *raw, *favicon)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "mcping:", err)
+		os.Exit(1)
+	}
+}
+
+func ping(address string, timeout time.Duration, raw bool, favicon string) error {
+	res, err := status.Query(address, timeout)
+	if err != nil {
+		return err
+	}
+	if raw {
+		fmt.Println(res.Raw)
+		return nil
+	}
+
+	fmt.Printf("version: %s (protocol %d)\n", res.Version.Name, res.Version.Protocol)
+	if res.Players != nil {
+		fmt.Printf("players: %d/%d\n", res.Players.Online, res.Players.Max)
+		for _, p := range res.Players.Sample {
+			fmt.Printf("  %s %s\n", p.Name, p.ID)
+		}
+	} else {
+		fmt.Println("players: hidden")
+	}
+	fmt.Printf("latency: %v\n", res.Latency.Round(time.Millisecond))
+	fmt.Println(res.Description.ANSI())
+
+	if favicon == "" {
+		return nil
+	}
+	png, err := res.FaviconPNG()
+	if err != nil {
+		return err
+	}
+	if png == nil {
+		return fmt.Errorf("server has no favicon")
+	}
+	return os.WriteFile(favicon, png, 0o644)
+}
+
+func pingLegacy(address string, timeout time.Duration) error {
+	res, err := status.QueryLegacy(address, timeout)
+	if err != nil {
+		return err
+	}
+	if res.Version != "" {
+		fmt.Printf("version: %s (protocol %d)\n", res.Version, res.ProtocolVersion)
+	}
+	fmt.Printf("players: %d/%d\n", res.Online, res.Max)
+	fmt.Println(res.Description().ANSI())
+	return nil
+}
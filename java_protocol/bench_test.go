@@ -0,0 +1,164 @@
+package java_protocol_test
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	jp "github.com/go-mclib/protocol/java_protocol"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// benchPacket is a small play packet similar in shape to Keep Alive / Move Player.
+type benchPacket struct {
+	X, Y, Z  ns.Float64
+	OnGround ns.Boolean
+	Payload  ns.ByteArray
+}
+
+func (p *benchPacket) ID() ns.VarInt   { return 0x1D }
+func (p *benchPacket) State() jp.State { return jp.StatePlay }
+func (p *benchPacket) Bound() jp.Bound { return jp.C2S }
+func (p *benchPacket) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if p.X, err = buf.ReadFloat64(); err != nil {
+		return err
+	}
+	if p.Y, err = buf.ReadFloat64(); err != nil {
+		return err
+	}
+	if p.Z, err = buf.ReadFloat64(); err != nil {
+		return err
+	}
+	if p.OnGround, err = buf.ReadBool(); err != nil {
+		return err
+	}
+	p.Payload, err = buf.ReadByteArray(1 << 21)
+	return err
+}
+func (p *benchPacket) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteFloat64(p.X); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat64(p.Y); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat64(p.Z); err != nil {
+		return err
+	}
+	if err := buf.WriteBool(p.OnGround); err != nil {
+		return err
+	}
+	return buf.WriteByteArray(p.Payload)
+}
+
+// echoServer reads wire packets from conn and writes them back until the connection closes.
+func echoServer(conn net.Conn, threshold int) {
+	server := jp.NewTCPClient()
+	server.SetConn(jp.NewConn(conn))
+	server.SetCompressionThreshold(threshold)
+	for {
+		wire, err := server.ReadWirePacket()
+		if err != nil {
+			return
+		}
+		if err := server.WriteWirePacket(wire); err != nil {
+			return
+		}
+	}
+}
+
+// BenchmarkEcho drives N simulated clients against echoing peers over in-memory pipes,
+// measuring the full codec + Conn round trip (serialize, frame, compress, read, decode).
+func BenchmarkEcho(b *testing.B) {
+	for _, payload := range []int{16, 1024} {
+		for _, threshold := range []int{-1, 256} {
+			for _, clients := range []int{1, 8} {
+				name := fmt.Sprintf("payload=%d/threshold=%d/clients=%d", payload, threshold, clients)
+				b.Run(name, func(b *testing.B) {
+					benchmarkEcho(b, clients, payload, threshold)
+				})
+			}
+		}
+	}
+}
+
+func benchmarkEcho(b *testing.B, clients, payload, threshold int) {
+	pkt := &benchPacket{X: 1.5, Y: 64, Z: -3.25, OnGround: true, Payload: make([]byte, payload)}
+
+	conns := make([]*jp.TCPClient, clients)
+	for i := range conns {
+		clientSide, serverSide := net.Pipe()
+		go echoServer(serverSide, threshold)
+
+		c := jp.NewTCPClient()
+		c.SetConn(jp.NewConn(clientSide))
+		c.SetCompressionThreshold(threshold)
+		c.SetState(jp.StatePlay)
+		conns[i] = c
+	}
+	defer func() {
+		for _, c := range conns {
+			c.Close()
+		}
+	}()
+
+	var remaining atomic.Int64
+	remaining.Store(int64(b.N))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var wg sync.WaitGroup
+	for _, c := range conns {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for remaining.Add(-1) >= 0 {
+				if err := c.WritePacket(pkt); err != nil {
+					b.Error(err)
+					return
+				}
+				wire, err := c.ReadWirePacket()
+				if err != nil {
+					b.Error(err)
+					return
+				}
+				if _, err := jp.ReadPacket[benchPacket](wire); err != nil {
+					b.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "packets/s")
+}
+
+func BenchmarkToWire(b *testing.B) {
+	pkt := &benchPacket{X: 1.5, Y: 64, Z: -3.25, OnGround: true, Payload: make([]byte, 1024)}
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := jp.ToWire(pkt); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkWirePacketWriteTo(b *testing.B) {
+	wire := &jp.WirePacket{PacketID: 0x1D, Data: make([]byte, 1024)}
+	for _, threshold := range []int{-1, 256, 1 << 20} {
+		b.Run(fmt.Sprintf("threshold=%d", threshold), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if err := wire.WriteTo(io.Discard, threshold); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
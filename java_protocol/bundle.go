@@ -0,0 +1,101 @@
+package java_protocol
+
+import (
+	"fmt"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// BundleDelimiterID is the packet ID of the clientbound Bundle Delimiter packet (play state).
+// The packet has no fields; a pair of delimiters wraps packets that the client
+// must apply within the same tick (e.g. an entity spawn with its metadata and equipment).
+//
+// Packet IDs are otherwise generated in go-mclib/data, but vanilla registers the
+// delimiter first among the clientbound play packets, so its ID has been 0x00
+// since bundles were added in 1.19.4. It is defined here so that ReadBundle and
+// WriteBundle work without the generated packets.
+//
+// See https://minecraft.wiki/w/Java_Edition_protocol/Packets#Bundle_Delimiter
+const BundleDelimiterID ns.VarInt = 0x00
+
+// MaxBundleSize is the maximum number of packets in a bundle, matching vanilla.
+const MaxBundleSize = 4096
+
+// IsBundleDelimiter reports whether the wire packet is a Bundle Delimiter.
+// Only meaningful for clientbound packets in the play state.
+func (w *WirePacket) IsBundleDelimiter() bool {
+	return w.PacketID == BundleDelimiterID && len(w.Data) == 0
+}
+
+// ReadBundle reads the next clientbound play packet, grouping bundles.
+//
+// If the next packet is a Bundle Delimiter, packets are read until the closing
+// delimiter and returned together (without the delimiters). Otherwise, the single
+// packet is returned. Must only be used while in the play state, since other
+// states reuse packet ID 0x00.
+func (c *TCPClient) ReadBundle() ([]*WirePacket, error) {
+	wire, err := c.ReadWirePacket()
+	if err != nil {
+		return nil, err
+	}
+	if !wire.IsBundleDelimiter() {
+		return []*WirePacket{wire}, nil
+	}
+
+	var bundle []*WirePacket
+	for {
+		wire, err := c.ReadWirePacket()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bundled packet %d: %w", len(bundle), err)
+		}
+		if wire.IsBundleDelimiter() {
+			c.debugf("<- recv: bundle of %d packets", len(bundle))
+			return bundle, nil
+		}
+		if len(bundle) >= MaxBundleSize {
+			return nil, fmt.Errorf("bundle exceeds %d packets", MaxBundleSize)
+		}
+		bundle = append(bundle, wire)
+	}
+}
+
+// WriteBundle writes the packets wrapped in a pair of Bundle Delimiters, so the
+// client applies them atomically. The write lock is held for the whole bundle,
+// so packets written concurrently from other goroutines cannot interleave.
+// Intended for servers sending clientbound play packets.
+func (c *TCPClient) WriteBundle(packets ...Packet) error {
+	if c.conn == nil {
+		return fmt.Errorf("connection is nil")
+	}
+	if len(packets) > MaxBundleSize {
+		return fmt.Errorf("bundle of %d packets exceeds %d", len(packets), MaxBundleSize)
+	}
+
+	// serialize outside the lock (CPU work, no I/O)
+	wires := make([]*WirePacket, 0, len(packets)+2)
+	delimiter := &WirePacket{PacketID: BundleDelimiterID}
+	wires = append(wires, delimiter)
+	for i, p := range packets {
+		wire, err := ToWire(p)
+		if err != nil {
+			return fmt.Errorf("failed to serialize bundled packet %d: %w", i, err)
+		}
+		wires = append(wires, wire)
+	}
+	wires = append(wires, delimiter)
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	c.debugf("-> send: bundle of %d packets", len(packets))
+
+	if err := c.setWriteDeadline(); err != nil {
+		return err
+	}
+	for i, wire := range wires {
+		if err := c.writeWire(wire); err != nil {
+			return fmt.Errorf("failed to write bundle packet %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package java_protocol_test
+
+import (
+	"net"
+	"testing"
+
+	jp "github.com/go-mclib/protocol/java_protocol"
+)
+
+func TestBundle_RoundTrip(t *testing.T) {
+	a, b := net.Pipe()
+	defer a.Close()
+	defer b.Close()
+
+	server := jp.NewTCPClient()
+	server.SetConn(jp.NewConn(a))
+	client := jp.NewTCPClient()
+	client.SetConn(jp.NewConn(b))
+
+	go func() {
+		server.WriteBundle(&benchPacket{X: 1}, &benchPacket{X: 2})
+		server.WritePacket(&benchPacket{X: 3})
+	}()
+
+	bundle, err := client.ReadBundle()
+	if err != nil {
+		t.Fatalf("read bundle: %v", err)
+	}
+	if len(bundle) != 2 {
+		t.Fatalf("expected 2 bundled packets, got %d", len(bundle))
+	}
+	for i, wire := range bundle {
+		p, err := jp.ReadPacket[benchPacket](wire)
+		if err != nil {
+			t.Fatalf("decode bundled packet %d: %v", i, err)
+		}
+		if float64(p.X) != float64(i+1) {
+			t.Errorf("bundled packet %d: X = %v, want %d", i, p.X, i+1)
+		}
+	}
+
+	single, err := client.ReadBundle()
+	if err != nil {
+		t.Fatalf("read single: %v", err)
+	}
+	if len(single) != 1 || single[0].IsBundleDelimiter() {
+		t.Fatalf("expected a single non-delimiter packet, got %d", len(single))
+	}
+}
@@ -0,0 +1,185 @@
+package java_protocol
+
+import (
+	"io"
+	"math/bits"
+	"slices"
+	"sync"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// CompressionStats tracks how well compression works on a connection, per packet ID
+// and per packet size. It is safe for concurrent use, and a single instance can be
+// shared by many connections to aggregate server-wide numbers.
+//
+// Sizes are measured on the uncompressed payload (Packet ID + Data) and on the
+// frame as sent over the wire (including the length prefix, before encryption).
+type CompressionStats struct {
+	mu      sync.Mutex
+	packets map[packetKey]*PacketCompressionStats
+	buckets [32]sizeBucket
+}
+
+type packetKey struct {
+	id   ns.VarInt
+	sent bool
+}
+
+// PacketCompressionStats are the accumulated sizes for one packet ID and direction.
+type PacketCompressionStats struct {
+	PacketID ns.VarInt
+	// Sent is true for packets written by this side, false for packets read.
+	Sent bool
+
+	// Packets is the total number of packets seen, Compressed how many of them were compressed.
+	Packets    int
+	Compressed int
+	// RawBytes is the total uncompressed payload size, WireBytes the total framed size.
+	RawBytes  int64
+	WireBytes int64
+}
+
+// Ratio returns WireBytes / RawBytes (lower is better), or 1 if nothing was recorded.
+func (s PacketCompressionStats) Ratio() float64 {
+	if s.RawBytes == 0 {
+		return 1
+	}
+	return float64(s.WireBytes) / float64(s.RawBytes)
+}
+
+// sizeBucket accumulates compressed packets whose raw size is in [2^i, 2^(i+1)).
+type sizeBucket struct {
+	packets   int
+	rawBytes  int64
+	wireBytes int64
+}
+
+// NewCompressionStats creates an empty CompressionStats.
+func NewCompressionStats() *CompressionStats {
+	return &CompressionStats{packets: make(map[packetKey]*PacketCompressionStats)}
+}
+
+// Record adds a single packet. sent is true for written packets. rawLen is the uncompressed size of Packet ID + Data,
+// wireLen the size of the frame as written, and compressed whether the payload was
+// zlib compressed.
+func (s *CompressionStats) Record(sent bool, packetID ns.VarInt, rawLen, wireLen int, compressed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := packetKey{id: packetID, sent: sent}
+	p := s.packets[key]
+	if p == nil {
+		p = &PacketCompressionStats{PacketID: packetID, Sent: sent}
+		s.packets[key] = p
+	}
+	p.Packets++
+	p.RawBytes += int64(rawLen)
+	p.WireBytes += int64(wireLen)
+
+	if compressed && rawLen > 0 {
+		p.Compressed++
+		b := &s.buckets[bits.Len(uint(rawLen))-1]
+		b.packets++
+		b.rawBytes += int64(rawLen)
+		b.wireBytes += int64(wireLen)
+	}
+}
+
+// Report returns the per-packet statistics, sent packets first, each sorted by packet ID.
+func (s *CompressionStats) Report() []PacketCompressionStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	report := make([]PacketCompressionStats, 0, len(s.packets))
+	for _, p := range s.packets {
+		report = append(report, *p)
+	}
+	slices.SortFunc(report, func(a, b PacketCompressionStats) int {
+		if a.Sent != b.Sent {
+			if a.Sent {
+				return -1
+			}
+			return 1
+		}
+		return int(a.PacketID - b.PacketID)
+	})
+	return report
+}
+
+// SuggestThreshold suggests a compression threshold from the packets compressed so far.
+//
+// Packets are grouped by raw size into power-of-two buckets. The suggestion is the
+// lower bound of the smallest bucket from which compression saves at least 10% in
+// every larger bucket, i.e. below it compression costs CPU without paying off.
+// Returns false if no compressed packets were recorded yet.
+func (s *CompressionStats) SuggestThreshold() (int, bool) {
+	const maxRatio = 0.9
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	suggestion, seen := -1, false
+	for i := len(s.buckets) - 1; i >= 0; i-- {
+		b := s.buckets[i]
+		if b.packets == 0 {
+			continue
+		}
+		seen = true
+		if float64(b.wireBytes)/float64(b.rawBytes) > maxRatio {
+			break
+		}
+		suggestion = 1 << i
+	}
+	if !seen {
+		return 0, false
+	}
+	if suggestion < 0 {
+		// no bucket compresses well, only the largest packets should be compressed
+		for i := len(s.buckets) - 1; i >= 0; i-- {
+			if s.buckets[i].packets > 0 {
+				return 1 << (i + 1), true
+			}
+		}
+	}
+	return suggestion, true
+}
+
+// Reset clears all recorded statistics.
+func (s *CompressionStats) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.packets = make(map[packetKey]*PacketCompressionStats)
+	s.buckets = [32]sizeBucket{}
+}
+
+// recordWire records a packet framed with the given compression threshold.
+// wireLen is the full frame size including the length prefix.
+func (s *CompressionStats) recordWire(sent bool, w *WirePacket, wireLen, compressionThreshold int) {
+	rawLen := w.PacketID.Len() + len(w.Data)
+	s.Record(sent, w.PacketID, rawLen, wireLen, compressionThreshold >= 0 && rawLen >= compressionThreshold)
+}
+
+// SetCompressionStats attaches statistics collection to the connection; nil disables it.
+// The same CompressionStats may be shared by multiple connections.
+// Like SetCompressionThreshold, it should not be called concurrently with reads or writes.
+func (c *TCPClient) SetCompressionStats(s *CompressionStats) {
+	c.compressionStats = s
+}
+
+// CompressionStats returns the attached statistics, or nil.
+func (c *TCPClient) CompressionStats() *CompressionStats {
+	return c.compressionStats
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += n
+	return n, err
+}
@@ -0,0 +1,115 @@
+package java_protocol_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"net"
+	"testing"
+
+	jp "github.com/go-mclib/protocol/java_protocol"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestCompressionStats_TCPClient(t *testing.T) {
+	a, b := net.Pipe()
+	defer a.Close()
+	defer b.Close()
+
+	sentStats := jp.NewCompressionStats()
+	recvStats := jp.NewCompressionStats()
+
+	sender := jp.NewTCPClient()
+	sender.SetConn(jp.NewConn(a))
+	sender.SetCompressionThreshold(256)
+	sender.SetCompressionStats(sentStats)
+	receiver := jp.NewTCPClient()
+	receiver.SetConn(jp.NewConn(b))
+	receiver.SetCompressionThreshold(256)
+	receiver.SetCompressionStats(recvStats)
+
+	packets := []*benchPacket{
+		{Payload: bytes.Repeat([]byte{'a'}, 16)},
+		{Payload: bytes.Repeat([]byte{'a'}, 4096)},
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, p := range packets {
+			sender.WritePacket(p)
+		}
+	}()
+	for range packets {
+		if _, err := receiver.ReadWirePacket(); err != nil {
+			t.Fatalf("read: %v", err)
+		}
+	}
+	<-done
+
+	sent, recv := sentStats.Report(), recvStats.Report()
+	if len(sent) != 1 || len(recv) != 1 {
+		t.Fatalf("expected one entry per side, got %d sent and %d received", len(sent), len(recv))
+	}
+	if !sent[0].Sent || recv[0].Sent {
+		t.Errorf("wrong direction: sent=%v received=%v", sent[0].Sent, recv[0].Sent)
+	}
+	for _, s := range []jp.PacketCompressionStats{sent[0], recv[0]} {
+		if s.PacketID != 0x1D || s.Packets != 2 || s.Compressed != 1 {
+			t.Errorf("unexpected stats: %+v", s)
+		}
+		if s.Ratio() >= 0.5 {
+			t.Errorf("repetitive payload should compress well, ratio = %.2f", s.Ratio())
+		}
+	}
+	if sent[0].RawBytes != recv[0].RawBytes || sent[0].WireBytes != recv[0].WireBytes {
+		t.Errorf("sent and received sizes differ: %+v vs %+v", sent[0], recv[0])
+	}
+}
+
+func TestCompressionStats_SuggestThreshold(t *testing.T) {
+	stats := jp.NewCompressionStats()
+	if _, ok := stats.SuggestThreshold(); ok {
+		t.Fatal("expected no suggestion without data")
+	}
+
+	// small packets barely shrink, large ones compress well
+	stats.Record(true, 0x01, 64, 66, true)
+	stats.Record(true, 0x01, 100, 98, true)
+	stats.Record(true, 0x02, 300, 200, true)
+	stats.Record(true, 0x03, 5000, 1000, true)
+
+	got, ok := stats.SuggestThreshold()
+	if !ok || got != 256 {
+		t.Errorf("SuggestThreshold() = %d, %v; want 256, true", got, ok)
+	}
+
+	// nothing compresses: suggest above the largest packet seen
+	stats.Reset()
+	random := make([]byte, 1000)
+	rand.Read(random)
+	wire := &jp.WirePacket{PacketID: 0x01, Data: random}
+	var framed bytes.Buffer
+	if err := wire.WriteTo(&framed, 0); err != nil {
+		t.Fatal(err)
+	}
+	stats.Record(true, 0x01, ns.VarInt(0x01).Len()+len(random), framed.Len(), true)
+
+	got, ok = stats.SuggestThreshold()
+	if !ok || got != 1024 {
+		t.Errorf("SuggestThreshold() = %d, %v; want 1024, true", got, ok)
+	}
+}
+
+func TestCompressionStats_ReportOrder(t *testing.T) {
+	stats := jp.NewCompressionStats()
+	stats.Record(false, 0x01, 10, 11, false)
+	stats.Record(true, 0x05, 10, 11, false)
+	stats.Record(true, 0x02, 10, 11, false)
+
+	report := stats.Report()
+	if len(report) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(report))
+	}
+	if !report[0].Sent || report[0].PacketID != 0x02 || report[1].PacketID != 0x05 || report[2].Sent {
+		t.Errorf("unexpected order: %+v", report)
+	}
+}
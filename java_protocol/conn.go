@@ -2,6 +2,7 @@ package java_protocol
 
 import (
 	"net"
+	"time"
 
 	"github.com/go-mclib/protocol/crypto"
 )
@@ -80,3 +81,13 @@ func (c *Conn) RemoteAddr() net.Addr {
 	}
 	return nil
 }
+
+// SetReadDeadline sets the read deadline on the underlying connection.
+func (c *Conn) SetReadDeadline(t time.Time) error {
+	return c.conn.SetReadDeadline(t)
+}
+
+// SetWriteDeadline sets the write deadline on the underlying connection.
+func (c *Conn) SetWriteDeadline(t time.Time) error {
+	return c.conn.SetWriteDeadline(t)
+}
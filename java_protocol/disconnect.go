@@ -0,0 +1,70 @@
+package java_protocol
+
+import (
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// DisconnectError is returned when the server closes the connection with a
+// Disconnect packet. Code handling the login or configuration packets should
+// return it, so that IsRetryable (and RetryDialer) can tell transient
+// disconnects from permanent ones.
+type DisconnectError struct {
+	Reason ns.TextComponent
+}
+
+func (e *DisconnectError) Error() string {
+	return "disconnected: " + e.Reason.String()
+}
+
+// vanilla disconnect reasons worth reconnecting after, by translation key
+var retryableDisconnects = map[string]bool{
+	"disconnect.throttled":                   true,
+	"disconnect.timeout":                     true,
+	"multiplayer.disconnect.server_full":     true,
+	"multiplayer.disconnect.server_shutdown": true,
+	"multiplayer.disconnect.slow_login":      true,
+}
+
+// Retryable reports whether reconnecting may succeed: the server is
+// throttling connections, full, restarting or timed out. Bans, whitelists,
+// version mismatches and unknown (e.g. plugin) reasons are not retryable.
+func (e *DisconnectError) Retryable() bool {
+	if retryableDisconnects[e.Reason.Translate] {
+		return true
+	}
+	// the throttle message has been a literal for most versions
+	return strings.Contains(e.Reason.String(), "Connection throttled")
+}
+
+// IsRetryable reports whether err, returned while connecting or logging in,
+// is transient: connection refused/reset, timeouts, the server closing the
+// connection, or a retryable DisconnectError.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var de *DisconnectError
+	if errors.As(err, &de) {
+		return de.Retryable()
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EHOSTUNREACH) ||
+		errors.Is(err, syscall.ENETUNREACH) {
+		return true
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
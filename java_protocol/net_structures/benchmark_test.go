@@ -0,0 +1,236 @@
+// go test -bench=. -benchmem
+package net_structures_test
+
+import (
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+)
+
+// Representative large payloads, built once and decoded repeatedly.
+var (
+	benchChunkBytes      = mustEncode(newBenchChunk())
+	benchRegistryBytes   = mustEncode(newBenchRegistry())
+	benchPlayerInfoBytes = mustEncode(newBenchPlayerInfo())
+)
+
+type encoder interface {
+	Encode(buf *ns.PacketBuffer) error
+}
+
+func mustEncode(v encoder) []byte {
+	buf := ns.NewWriter()
+	if err := v.Encode(buf); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// newBenchChunk builds a chunk similar to a freshly generated overworld chunk:
+// 3 heightmaps, 24 sections of packed data and a handful of block entities.
+func newBenchChunk() *ns.ChunkData {
+	cd := &ns.ChunkData{Data: make([]byte, 24*(2+1+8*342+1+8*4))}
+	for i := range cd.Data {
+		cd.Data[i] = byte(i * 31)
+	}
+	for _, kind := range []int32{ns.HeightmapWorldSurface, ns.HeightmapMotionBlocking, ns.HeightmapMotionBlockingNoLeaves} {
+		hm := ns.NewPackedLongArray(ns.HeightmapBits(384), 256)
+		for i := range 256 {
+			hm.Set(i, 64+i%32)
+		}
+		cd.SetHeightmap(kind, hm)
+	}
+	for i := range 8 {
+		be := ns.BlockEntity{Y: ns.Int16(i), Type: 7, Data: nbt.Compound{
+			"id":    nbt.String("minecraft:chest"),
+			"Items": nbt.List{ElementType: nbt.TagCompound},
+		}}
+		be.SetXZ(i, i)
+		cd.BlockEntities = append(cd.BlockEntities, be)
+	}
+	return cd
+}
+
+// benchRegistry mimics a Registry Data packet: a list of entries with NBT compounds.
+type benchRegistry struct {
+	Entries []nbt.Tag
+}
+
+func newBenchRegistry() *benchRegistry {
+	r := &benchRegistry{}
+	for i := range 64 {
+		r.Entries = append(r.Entries, nbt.Compound{
+			"ambient_light":    nbt.Float(0),
+			"bed_works":        nbt.Byte(1),
+			"coordinate_scale": nbt.Double(1),
+			"effects":          nbt.String("minecraft:overworld"),
+			"has_ceiling":      nbt.Byte(0),
+			"has_raids":        nbt.Byte(1),
+			"has_skylight":     nbt.Byte(1),
+			"height":           nbt.Int(384),
+			"infiniburn":       nbt.String("#minecraft:infiniburn_overworld"),
+			"logical_height":   nbt.Int(384),
+			"min_y":            nbt.Int(-64),
+			"monster_spawn_light_level": nbt.Compound{
+				"type":          nbt.String("minecraft:uniform"),
+				"min_inclusive": nbt.Int(0),
+				"max_inclusive": nbt.Int(7),
+			},
+			"natural":              nbt.Byte(1),
+			"piglin_safe":          nbt.Byte(0),
+			"respawn_anchor_works": nbt.Byte(0),
+			"ultrawarm":            nbt.Byte(0),
+			"index":                nbt.Int(int32(i)),
+		})
+	}
+	return r
+}
+
+func (r *benchRegistry) Encode(buf *ns.PacketBuffer) error {
+	if err := buf.WriteVarInt(ns.VarInt(len(r.Entries))); err != nil {
+		return err
+	}
+	for _, e := range r.Entries {
+		data, err := nbt.Encode(e, "", true)
+		if err != nil {
+			return err
+		}
+		if _, err := buf.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *benchRegistry) Decode(buf *ns.PacketBuffer) error {
+	n, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	r.Entries = make([]nbt.Tag, n)
+	reader := nbt.NewReaderFrom(buf.Reader())
+	for i := range r.Entries {
+		if r.Entries[i], _, err = reader.ReadTag(true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// benchPlayerInfo mimics a Player Info Update packet adding 100 players with skin properties.
+type benchPlayerInfo struct {
+	Profiles ns.PrefixedArray[ns.GameProfile]
+}
+
+func newBenchPlayerInfo() *benchPlayerInfo {
+	p := &benchPlayerInfo{}
+	for i := range 100 {
+		p.Profiles = append(p.Profiles, ns.GameProfile{
+			UUID:     ns.UUID{byte(i)},
+			Username: ns.String("Player" + string(rune('A'+i%26))),
+			Properties: ns.PrefixedArray[ns.ProfileProperty]{{
+				Name:      "textures",
+				Value:     ns.String(make([]byte, 400)),
+				Signature: ns.Some(ns.String(make([]byte, 684))),
+			}},
+		})
+	}
+	return p
+}
+
+func (p *benchPlayerInfo) Encode(buf *ns.PacketBuffer) error {
+	return p.Profiles.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.GameProfile) error {
+		return v.Encode(b)
+	})
+}
+
+func (p *benchPlayerInfo) Decode(buf *ns.PacketBuffer) error {
+	return p.Profiles.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.GameProfile, error) {
+		var v ns.GameProfile
+		err := v.Decode(b)
+		return v, err
+	})
+}
+
+func BenchmarkDecodeChunkData(b *testing.B) {
+	b.SetBytes(int64(len(benchChunkBytes)))
+	for b.Loop() {
+		var cd ns.ChunkData
+		if err := cd.Decode(ns.NewReader(benchChunkBytes)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeRegistryData(b *testing.B) {
+	b.SetBytes(int64(len(benchRegistryBytes)))
+	for b.Loop() {
+		var r benchRegistry
+		if err := r.Decode(ns.NewReader(benchRegistryBytes)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodePlayerInfo(b *testing.B) {
+	b.SetBytes(int64(len(benchPlayerInfoBytes)))
+	for b.Loop() {
+		var p benchPlayerInfo
+		if err := p.Decode(ns.NewReader(benchPlayerInfoBytes)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncodeChunkData(b *testing.B) {
+	cd := newBenchChunk()
+	for b.Loop() {
+		if err := cd.Encode(ns.NewWriter()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// TestDecodeAllocBudgets fails if decoding the representative payloads above starts
+// allocating noticeably more than it used to. Budgets have ~20% headroom over the
+// measured values; lower them when an optimization lands, and only raise them
+// with a justification in the commit message.
+func TestDecodeAllocBudgets(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping allocation budgets in short mode")
+	}
+
+	budgets := []struct {
+		name   string
+		budget float64
+		decode func() error
+	}{
+		{"chunk data", 380, func() error {
+			var cd ns.ChunkData
+			return cd.Decode(ns.NewReader(benchChunkBytes))
+		}},
+		{"registry data (64 entries)", 9600, func() error {
+			var r benchRegistry
+			return r.Decode(ns.NewReader(benchRegistryBytes))
+		}},
+		{"player info (100 entries)", 1950, func() error {
+			var p benchPlayerInfo
+			return p.Decode(ns.NewReader(benchPlayerInfoBytes))
+		}},
+	}
+
+	for _, tc := range budgets {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.decode(); err != nil {
+				t.Fatalf("decode error: %v", err)
+			}
+			allocs := testing.AllocsPerRun(20, func() {
+				_ = tc.decode()
+			})
+			if allocs > tc.budget {
+				t.Errorf("%.0f allocs per decode, budget is %.0f", allocs, tc.budget)
+			}
+		})
+	}
+}
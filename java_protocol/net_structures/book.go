@@ -0,0 +1,146 @@
+package net_structures
+
+import "fmt"
+
+// Book content limits (see WritableBookContent / WrittenBookContent in vanilla).
+const (
+	BookMaxPages            = 100
+	BookMaxPageLength       = 1024
+	BookMaxTitleLength      = 32
+	BookMaxCraftGenerations = 3
+)
+
+// WritableBookContent is the data of the minecraft:writable_book_content component
+// (book and quill). Pages are plain strings.
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────────────────────┐
+//	│  Pages (Prefixed Array of Filterable[String(1024)]) │
+//	└─────────────────────────────────────────────────────┘
+type WritableBookContent struct {
+	Pages []Filterable[String]
+}
+
+// Decode reads a WritableBookContent from the buffer.
+func (b *WritableBookContent) Decode(buf *PacketBuffer) error {
+	count, err := buf.ReadVarInt()
+	if err != nil {
+		return fmt.Errorf("failed to read page count: %w", err)
+	}
+	if count < 0 || count > BookMaxPages {
+		return fmt.Errorf("invalid page count: %d (max %d)", count, BookMaxPages)
+	}
+	b.Pages = make([]Filterable[String], count)
+	for i := range b.Pages {
+		if err := b.Pages[i].DecodeWith(buf, readBookPage); err != nil {
+			return fmt.Errorf("failed to read page %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Encode writes a WritableBookContent to the buffer.
+func (b *WritableBookContent) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(len(b.Pages))); err != nil {
+		return fmt.Errorf("failed to write page count: %w", err)
+	}
+	for i, page := range b.Pages {
+		if err := page.EncodeWith(buf, writeString); err != nil {
+			return fmt.Errorf("failed to write page %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// WrittenBookContent is the data of the minecraft:written_book_content component
+// (signed book). Pages are text components.
+//
+// Wire format:
+//
+//	┌──────────────────────────────┬─────────────────┬─────────────────────┐
+//	│  Title (Filterable[String])  │  Author (String)│  Generation (VarInt)│
+//	├──────────────────────────────┴─────────────────┴─────────────────────┤
+//	│  Pages (Prefixed Array of Filterable[Text Component])                │
+//	├──────────────────────────────────────────────────────────────────────┤
+//	│  Resolved (Boolean)                                                  │
+//	└──────────────────────────────────────────────────────────────────────┘
+//
+// Generation is 0 for the original, 1 for a copy, 2 for a copy of a copy and
+// 3 for tattered. Resolved is true once selectors and scores in pages have been
+// resolved by the server.
+type WrittenBookContent struct {
+	Title      Filterable[String]
+	Author     String
+	Generation VarInt
+	Pages      []Filterable[TextComponent]
+	Resolved   Boolean
+}
+
+// Decode reads a WrittenBookContent from the buffer.
+func (b *WrittenBookContent) Decode(buf *PacketBuffer) error {
+	if err := b.Title.DecodeWith(buf, func(b *PacketBuffer) (String, error) {
+		return b.ReadString(BookMaxTitleLength)
+	}); err != nil {
+		return fmt.Errorf("failed to read title: %w", err)
+	}
+	var err error
+	if b.Author, err = buf.ReadString(32767); err != nil {
+		return fmt.Errorf("failed to read author: %w", err)
+	}
+	if b.Generation, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read generation: %w", err)
+	}
+	if b.Generation < 0 || b.Generation > BookMaxCraftGenerations {
+		return fmt.Errorf("invalid generation: %d", b.Generation)
+	}
+
+	count, err := buf.ReadCount()
+	if err != nil {
+		return fmt.Errorf("failed to read page count: %w", err)
+	}
+	b.Pages = make([]Filterable[TextComponent], count)
+	for i := range b.Pages {
+		if err := b.Pages[i].DecodeWith(buf, (*PacketBuffer).ReadTextComponent); err != nil {
+			return fmt.Errorf("failed to read page %d: %w", i, err)
+		}
+	}
+
+	if b.Resolved, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read resolved: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a WrittenBookContent to the buffer.
+func (b *WrittenBookContent) Encode(buf *PacketBuffer) error {
+	if err := b.Title.EncodeWith(buf, writeString); err != nil {
+		return fmt.Errorf("failed to write title: %w", err)
+	}
+	if err := buf.WriteString(b.Author); err != nil {
+		return fmt.Errorf("failed to write author: %w", err)
+	}
+	if err := buf.WriteVarInt(b.Generation); err != nil {
+		return fmt.Errorf("failed to write generation: %w", err)
+	}
+	if err := buf.WriteVarInt(VarInt(len(b.Pages))); err != nil {
+		return fmt.Errorf("failed to write page count: %w", err)
+	}
+	for i, page := range b.Pages {
+		if err := page.EncodeWith(buf, (*PacketBuffer).WriteTextComponent); err != nil {
+			return fmt.Errorf("failed to write page %d: %w", i, err)
+		}
+	}
+	if err := buf.WriteBool(b.Resolved); err != nil {
+		return fmt.Errorf("failed to write resolved: %w", err)
+	}
+	return nil
+}
+
+func readBookPage(buf *PacketBuffer) (String, error) {
+	return buf.ReadString(BookMaxPageLength)
+}
+
+func writeString(buf *PacketBuffer, v String) error {
+	return buf.WriteString(v)
+}
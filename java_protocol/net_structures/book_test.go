@@ -0,0 +1,81 @@
+package net_structures_test
+
+import (
+	"bytes"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestWritableBookContent(t *testing.T) {
+	// 2 pages: "Hi" (no filter), "bad" (filtered to "***")
+	raw := []byte{
+		0x02,
+		0x02, 'H', 'i', 0x00,
+		0x03, 'b', 'a', 'd', 0x01, 0x03, '*', '*', '*',
+	}
+
+	var book ns.WritableBookContent
+	if err := book.Decode(ns.NewReader(raw)); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if len(book.Pages) != 2 {
+		t.Fatalf("expected 2 pages, got %d", len(book.Pages))
+	}
+	if book.Pages[0].Get(true) != "Hi" {
+		t.Errorf("page 0 = %q, want %q", book.Pages[0].Get(true), "Hi")
+	}
+	if book.Pages[1].Get(false) != "bad" || book.Pages[1].Get(true) != "***" {
+		t.Errorf("page 1 = %q / %q, want bad / ***", book.Pages[1].Get(false), book.Pages[1].Get(true))
+	}
+
+	buf := ns.NewWriter()
+	if err := book.Encode(buf); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), raw) {
+		t.Errorf("encode mismatch:\n  got:  %x\n  want: %x", buf.Bytes(), raw)
+	}
+}
+
+func TestWritableBookContent_TooManyPages(t *testing.T) {
+	var book ns.WritableBookContent
+	if err := book.Decode(ns.NewReader([]byte{0x65})); err == nil {
+		t.Error("expected error for 101 pages")
+	}
+}
+
+func TestWrittenBookContent_RoundTrip(t *testing.T) {
+	book := ns.WrittenBookContent{
+		Title:      ns.NewFilterable[ns.String]("Journal"),
+		Author:     "Steve",
+		Generation: 1,
+		Pages: []ns.Filterable[ns.TextComponent]{
+			ns.NewFilterable(ns.NewTextComponent("Day 1")),
+			{Raw: ns.NewTextComponent("secret"), Filtered: ns.Some(ns.NewTextComponent("******"))},
+		},
+		Resolved: true,
+	}
+
+	buf := ns.NewWriter()
+	if err := book.Encode(buf); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+
+	var decoded ns.WrittenBookContent
+	if err := decoded.Decode(ns.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if decoded.Title.Raw != "Journal" || decoded.Author != "Steve" || decoded.Generation != 1 || !bool(decoded.Resolved) {
+		t.Errorf("header mismatch: %+v", decoded)
+	}
+	if len(decoded.Pages) != 2 {
+		t.Fatalf("expected 2 pages, got %d", len(decoded.Pages))
+	}
+	if decoded.Pages[0].Raw.Text != "Day 1" {
+		t.Errorf("page 0 = %q, want %q", decoded.Pages[0].Raw.Text, "Day 1")
+	}
+	if decoded.Pages[1].Get(true).Text != "******" {
+		t.Errorf("filtered page 1 = %q, want %q", decoded.Pages[1].Get(true).Text, "******")
+	}
+}
@@ -12,7 +12,7 @@ type PacketBuffer struct {
 	reader io.Reader
 	writer io.Writer
 
-	// For writer mode, we also keep a bytes.Buffer to retrieve written bytes
+	// For writer and duplex mode, we also keep a bytes.Buffer to retrieve written bytes
 	buf *bytes.Buffer
 }
 
@@ -39,6 +39,18 @@ func NewWriter() *PacketBuffer {
 	}
 }
 
+// NewBuffer creates a PacketBuffer for both writing and reading: reads consume
+// the written bytes in order, without copying them. This is convenient for
+// encoding a value and decoding it again.
+func NewBuffer() *PacketBuffer {
+	buf := &bytes.Buffer{}
+	return &PacketBuffer{
+		reader: buf,
+		writer: buf,
+		buf:    buf,
+	}
+}
+
 // NewWriterTo creates a PacketBuffer that writes directly to an io.Writer.
 func NewWriterTo(w io.Writer) *PacketBuffer {
 	return &PacketBuffer{
@@ -46,7 +58,8 @@ func NewWriterTo(w io.Writer) *PacketBuffer {
 	}
 }
 
-// Bytes returns the written bytes. Only valid for buffers created with NewWriter.
+// Bytes returns the written bytes (the unread ones for buffers created with
+// NewBuffer). Only valid for buffers created with NewWriter or NewBuffer.
 func (pb *PacketBuffer) Bytes() []byte {
 	if pb.buf != nil {
 		return pb.buf.Bytes()
@@ -54,7 +67,8 @@ func (pb *PacketBuffer) Bytes() []byte {
 	return nil
 }
 
-// Len returns the number of written bytes. Only valid for buffers created with NewWriter.
+// Len returns the number of bytes Bytes would return. Only valid for buffers
+// created with NewWriter or NewBuffer.
 func (pb *PacketBuffer) Len() int {
 	if pb.buf != nil {
 		return pb.buf.Len()
@@ -62,7 +76,8 @@ func (pb *PacketBuffer) Len() int {
 	return 0
 }
 
-// Reset resets the buffer for reuse. Only valid for buffers created with NewWriter.
+// Reset resets the buffer for reuse. Only valid for buffers created with
+// NewWriter or NewBuffer.
 func (pb *PacketBuffer) Reset() {
 	if pb.buf != nil {
 		pb.buf.Reset()
@@ -110,6 +125,39 @@ func (pb *PacketBuffer) Writer() io.Writer {
 	return pb.writer
 }
 
+// Remaining returns the number of unread bytes, if the underlying reader can
+// report it (as the reader created by NewReader does).
+func (pb *PacketBuffer) Remaining() (int, bool) {
+	if r, ok := pb.reader.(interface{ Len() int }); ok {
+		return r.Len(), true
+	}
+	return 0, false
+}
+
+// ReadCount reads a VarInt element count and validates it before anything is
+// allocated for it: it must not be negative and, since every element takes at
+// least one byte, must not exceed the remaining bytes when those are known.
+func (pb *PacketBuffer) ReadCount() (int, error) {
+	count, err := pb.ReadVarInt()
+	if err != nil {
+		return 0, err
+	}
+	if err := pb.checkCount(int(count)); err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (pb *PacketBuffer) checkCount(count int) error {
+	if count < 0 {
+		return fmt.Errorf("negative count: %d", count)
+	}
+	if n, ok := pb.Remaining(); ok && count > n {
+		return fmt.Errorf("count %d exceeds the %d remaining bytes", count, n)
+	}
+	return nil
+}
+
 // --- VarInt ---
 
 // ReadVarInt reads a variable-length 32-bit integer.
@@ -117,6 +165,12 @@ func (pb *PacketBuffer) ReadVarInt() (VarInt, error) {
 	return DecodeVarInt(pb.reader)
 }
 
+// ReadVarIntStrict reads a VarInt in its canonical encoding of at most maxBytes
+// bytes. See DecodeVarIntStrict.
+func (pb *PacketBuffer) ReadVarIntStrict(maxBytes int) (VarInt, error) {
+	return DecodeVarIntStrict(pb.reader, maxBytes)
+}
+
 // WriteVarInt writes a variable-length 32-bit integer.
 func (pb *PacketBuffer) WriteVarInt(v VarInt) error {
 	return v.Encode(pb.writer)
@@ -269,6 +323,9 @@ func (pb *PacketBuffer) ReadByteArray(maxLen int) (ByteArray, error) {
 	if maxLen > 0 && int(length) > maxLen {
 		return nil, fmt.Errorf("byte array length %d exceeds maximum %d", length, maxLen)
 	}
+	if err := pb.checkCount(int(length)); err != nil {
+		return nil, fmt.Errorf("invalid byte array length: %w", err)
+	}
 
 	data := make([]byte, length)
 	if _, err := pb.Read(data); err != nil {
@@ -328,6 +385,19 @@ func (pb *PacketBuffer) WriteGlobalPos(v GlobalPos) error {
 	return v.Encode(pb.writer)
 }
 
+// ReadOptionalGlobalPos reads a boolean-prefixed global position, as used for
+// the death location in Login (play) and Respawn.
+func (pb *PacketBuffer) ReadOptionalGlobalPos() (PrefixedOptional[GlobalPos], error) {
+	var v PrefixedOptional[GlobalPos]
+	err := v.DecodeWith(pb, (*PacketBuffer).ReadGlobalPos)
+	return v, err
+}
+
+// WriteOptionalGlobalPos writes a boolean-prefixed global position.
+func (pb *PacketBuffer) WriteOptionalGlobalPos(v PrefixedOptional[GlobalPos]) error {
+	return v.EncodeWith(pb, (*PacketBuffer).WriteGlobalPos)
+}
+
 // --- UUID ---
 
 // ReadUUID reads a 128-bit UUID (two 64-bit integers).
@@ -352,6 +422,58 @@ func (pb *PacketBuffer) WriteAngle(v Angle) error {
 	return v.Encode(pb.writer)
 }
 
+// --- Enums ---
+
+// ReadGameMode reads a game mode (single byte, -1/255 = none).
+func (pb *PacketBuffer) ReadGameMode() (GameMode, error) {
+	return DecodeGameMode(pb.reader)
+}
+
+// WriteGameMode writes a game mode as a single byte.
+func (pb *PacketBuffer) WriteGameMode(v GameMode) error {
+	return v.Encode(pb.writer)
+}
+
+// ReadDifficulty reads a difficulty (unsigned byte).
+func (pb *PacketBuffer) ReadDifficulty() (Difficulty, error) {
+	return DecodeDifficulty(pb.reader)
+}
+
+// WriteDifficulty writes a difficulty as an unsigned byte.
+func (pb *PacketBuffer) WriteDifficulty(v Difficulty) error {
+	return v.Encode(pb.writer)
+}
+
+// ReadHand reads a hand (VarInt enum).
+func (pb *PacketBuffer) ReadHand() (Hand, error) {
+	return DecodeHand(pb.reader)
+}
+
+// WriteHand writes a hand as a VarInt.
+func (pb *PacketBuffer) WriteHand(v Hand) error {
+	return v.Encode(pb.writer)
+}
+
+// ReadDirection reads a direction / block face (VarInt enum).
+func (pb *PacketBuffer) ReadDirection() (Direction, error) {
+	return DecodeDirection(pb.reader)
+}
+
+// WriteDirection writes a direction / block face as a VarInt.
+func (pb *PacketBuffer) WriteDirection(v Direction) error {
+	return v.Encode(pb.writer)
+}
+
+// ReadDyeColor reads a dye color (VarInt enum).
+func (pb *PacketBuffer) ReadDyeColor() (DyeColor, error) {
+	return DecodeDyeColor(pb.reader)
+}
+
+// WriteDyeColor writes a dye color as a VarInt.
+func (pb *PacketBuffer) WriteDyeColor(v DyeColor) error {
+	return v.Encode(pb.writer)
+}
+
 // --- Copy methods for primitives (read from source, write to this buffer) ---
 
 // CopyVarInt copies a VarInt from src to this buffer.
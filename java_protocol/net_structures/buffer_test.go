@@ -35,6 +35,34 @@ func TestBufferWriterModes(t *testing.T) {
 	})
 }
 
+func TestBufferDuplex(t *testing.T) {
+	buf := ns.NewBuffer()
+	buf.WriteVarInt(300)
+	buf.WriteString("hello")
+	if n, ok := buf.Remaining(); !ok || n != 8 {
+		t.Errorf("Remaining() = %d, %v, want 8, true", n, ok)
+	}
+
+	v, err := buf.ReadVarInt()
+	if err != nil || v != 300 {
+		t.Fatalf("ReadVarInt() = %d, %v", v, err)
+	}
+	buf.WriteBool(true) // writes may follow reads
+	s, err := buf.ReadString(16)
+	if err != nil || s != "hello" {
+		t.Fatalf("ReadString() = %q, %v", s, err)
+	}
+	if !bytes.Equal(buf.Bytes(), []byte{0x01}) {
+		t.Errorf("Bytes() = %v, want the unread bytes", buf.Bytes())
+	}
+	if b, err := buf.ReadBool(); err != nil || !bool(b) {
+		t.Errorf("ReadBool() = %v, %v", b, err)
+	}
+	if _, err := buf.ReadByte(); err == nil {
+		t.Error("expected EOF after reading all written bytes")
+	}
+}
+
 func TestBufferReadExact(t *testing.T) {
 	data := []byte{0x01, 0x02, 0x03}
 	buf := ns.NewReader(data)
@@ -0,0 +1,312 @@
+package net_structures
+
+import (
+	"fmt"
+
+	"github.com/go-mclib/protocol/nbt"
+)
+
+// ChatTypeParameter is a value substituted into a chat decoration's
+// translation, sent as a VarInt. In registry data it is written by name.
+type ChatTypeParameter VarInt
+
+const (
+	ChatTypeParameterSender  ChatTypeParameter = 0
+	ChatTypeParameterTarget  ChatTypeParameter = 1
+	ChatTypeParameterContent ChatTypeParameter = 2
+)
+
+var chatTypeParameterNames = EnumNames{
+	int32(ChatTypeParameterSender):  "sender",
+	int32(ChatTypeParameterTarget):  "target",
+	int32(ChatTypeParameterContent): "content",
+}
+
+func (p ChatTypeParameter) String() string {
+	return chatTypeParameterNames.Name(int32(p))
+}
+
+func readChatTypeParameter(buf *PacketBuffer) (ChatTypeParameter, error) {
+	v, err := buf.ReadVarInt()
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := chatTypeParameterNames[int32(v)]; !ok {
+		return 0, InvalidEnumError("chat type parameter", int32(v))
+	}
+	return ChatTypeParameter(v), nil
+}
+
+func writeChatTypeParameter(buf *PacketBuffer, p ChatTypeParameter) error {
+	return buf.WriteVarInt(VarInt(p))
+}
+
+// ChatTypeDecoration describes how a chat type displays (or narrates) a
+// message: the translation key, the parameters filled into its placeholders
+// in order, and the style applied to the result. For example, vanilla's
+// minecraft:chat uses "chat.type.text" ("<%s> %s") with sender and content.
+//
+// Wire format:
+//
+//	┌────────────────────────────┬─────────────────────────────────────────┬───────────────┐
+//	│  Translation Key (String)  │  Parameters (Prefixed Array of VarInt)  │  Style (NBT)  │
+//	└────────────────────────────┴─────────────────────────────────────────┴───────────────┘
+type ChatTypeDecoration struct {
+	TranslationKey String
+	Parameters     PrefixedArray[ChatTypeParameter]
+	// Style only uses the style fields (color, bold, ...) of the component.
+	Style TextComponent
+}
+
+// Decode reads a ChatTypeDecoration from the buffer.
+func (d *ChatTypeDecoration) Decode(buf *PacketBuffer) error {
+	var err error
+	if d.TranslationKey, err = buf.ReadString(32767); err != nil {
+		return fmt.Errorf("failed to read decoration translation key: %w", err)
+	}
+	if err := d.Parameters.DecodeWith(buf, readChatTypeParameter); err != nil {
+		return fmt.Errorf("failed to read decoration parameters: %w", err)
+	}
+	tag, _, err := nbt.NewReaderFrom(buf.Reader()).ReadTag(true)
+	if err != nil {
+		return fmt.Errorf("failed to read decoration style: %w", err)
+	}
+	d.Style = TextComponent{}
+	if err := d.Style.UnmarshalNBT(tag); err != nil {
+		return fmt.Errorf("failed to read decoration style: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a ChatTypeDecoration to the buffer.
+func (d *ChatTypeDecoration) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteString(d.TranslationKey); err != nil {
+		return fmt.Errorf("failed to write decoration translation key: %w", err)
+	}
+	if err := d.Parameters.EncodeWith(buf, writeChatTypeParameter); err != nil {
+		return fmt.Errorf("failed to write decoration parameters: %w", err)
+	}
+	style, err := nbt.MarshalTag(d.Style)
+	if err != nil {
+		return fmt.Errorf("failed to write decoration style: %w", err)
+	}
+	data, err := nbt.EncodeNetwork(style)
+	if err != nil {
+		return fmt.Errorf("failed to write decoration style: %w", err)
+	}
+	_, err = buf.Write(data)
+	return err
+}
+
+// UnmarshalNBT reads a decoration from its registry data form:
+//
+//	{translation_key: "chat.type.text", parameters: ["sender", "content"], style: {...}}
+func (d *ChatTypeDecoration) UnmarshalNBT(tag nbt.Tag) error {
+	c, ok := tag.(nbt.Compound)
+	if !ok {
+		return fmt.Errorf("chat type decoration is %s, expected Compound", nbt.TagName(tag.ID()))
+	}
+	*d = ChatTypeDecoration{TranslationKey: String(c.GetString("translation_key"))}
+	for i, elem := range c.GetList("parameters").Elements {
+		name, _ := elem.(nbt.String)
+		p, ok := chatTypeParameterByName(string(name))
+		if !ok {
+			return fmt.Errorf("unknown chat type parameter %d: %q", i, name)
+		}
+		d.Parameters = append(d.Parameters, p)
+	}
+	if style, ok := c["style"]; ok {
+		if err := d.Style.UnmarshalNBT(style); err != nil {
+			return fmt.Errorf("failed to read decoration style: %w", err)
+		}
+	}
+	return nil
+}
+
+// Tag returns the decoration in its registry data form.
+func (d ChatTypeDecoration) Tag() (nbt.Tag, error) {
+	params := nbt.List{ElementType: nbt.TagString}
+	for _, p := range d.Parameters {
+		params.Elements = append(params.Elements, nbt.String(p.String()))
+	}
+	c := nbt.Compound{
+		"translation_key": nbt.String(d.TranslationKey),
+		"parameters":      params,
+	}
+	style, err := nbt.MarshalTag(d.Style)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := style.(nbt.Compound); ok && len(s) > 0 {
+		c["style"] = s
+	}
+	return c, nil
+}
+
+// Format fills the decoration's parameters into its translation and returns
+// the styled component, as the vanilla client does when displaying a message.
+// Target is only used if the decoration has a target parameter; pass an empty
+// component if there is none.
+func (d ChatTypeDecoration) Format(sender, target, content TextComponent) TextComponent {
+	args := make([]TextComponent, len(d.Parameters))
+	for i, p := range d.Parameters {
+		switch p {
+		case ChatTypeParameterSender:
+			args[i] = sender
+		case ChatTypeParameterTarget:
+			args[i] = target
+		case ChatTypeParameterContent:
+			args[i] = content
+		}
+	}
+	tc := d.Style
+	tc.Translate = string(d.TranslationKey)
+	tc.With = args
+	return tc
+}
+
+func chatTypeParameterByName(name string) (ChatTypeParameter, bool) {
+	for v, n := range chatTypeParameterNames {
+		if n == name {
+			return ChatTypeParameter(v), true
+		}
+	}
+	return 0, false
+}
+
+// ChatType is an inline minecraft:chat_type registry entry. Chat packets
+// usually reference one of the entries sent in the configuration phase
+// instead (see ChatTypeBound).
+//
+// Wire format:
+//
+//	┌─────────────────────────────┬──────────────────────────────────┐
+//	│  Chat (ChatTypeDecoration)  │  Narration (ChatTypeDecoration)  │
+//	└─────────────────────────────┴──────────────────────────────────┘
+type ChatType struct {
+	Chat      ChatTypeDecoration `nbt:"chat"`
+	Narration ChatTypeDecoration `nbt:"narration"`
+}
+
+// Decode reads a ChatType from the buffer.
+func (t *ChatType) Decode(buf *PacketBuffer) error {
+	if err := t.Chat.Decode(buf); err != nil {
+		return fmt.Errorf("failed to read chat decoration: %w", err)
+	}
+	if err := t.Narration.Decode(buf); err != nil {
+		return fmt.Errorf("failed to read narration decoration: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a ChatType to the buffer.
+func (t *ChatType) Encode(buf *PacketBuffer) error {
+	if err := t.Chat.Encode(buf); err != nil {
+		return fmt.Errorf("failed to write chat decoration: %w", err)
+	}
+	if err := t.Narration.Encode(buf); err != nil {
+		return fmt.Errorf("failed to write narration decoration: %w", err)
+	}
+	return nil
+}
+
+// Tag returns the chat type in its registry data form, as sent in the
+// Registry Data packet during configuration.
+func (t ChatType) Tag() (nbt.Tag, error) {
+	chat, err := t.Chat.Tag()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal chat decoration: %w", err)
+	}
+	narration, err := t.Narration.Tag()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal narration decoration: %w", err)
+	}
+	return nbt.Compound{"chat": chat, "narration": narration}, nil
+}
+
+// ReadChatType reads an inline ChatType.
+func (pb *PacketBuffer) ReadChatType() (ChatType, error) {
+	var t ChatType
+	err := t.Decode(pb)
+	return t, err
+}
+
+// WriteChatType writes an inline ChatType.
+func (pb *PacketBuffer) WriteChatType(t ChatType) error {
+	return t.Encode(pb)
+}
+
+// ChatTypeBound is a chat type together with the names filled into its
+// sender and target parameters, as sent in Player Chat and Disguised Chat.
+// System Chat has no chat type; it sends the finished component.
+//
+// Wire format:
+//
+//	┌──────────────────────────────┬────────────────────────┬─────────────────────────────────────────────────┐
+//	│  Chat Type (ID or ChatType)  │  Name (TextComponent)  │  Target Name (Prefixed Optional TextComponent)  │
+//	└──────────────────────────────┴────────────────────────┴─────────────────────────────────────────────────┘
+//
+// The ID is the entry's index in the minecraft:chat_type registry, in the
+// order the server sent it during configuration.
+type ChatTypeBound struct {
+	ChatType   IDOrX[ChatType]
+	Name       TextComponent
+	TargetName PrefixedOptional[TextComponent]
+}
+
+// Decode reads a ChatTypeBound from the buffer.
+func (b *ChatTypeBound) Decode(buf *PacketBuffer) error {
+	if err := b.ChatType.DecodeWith(buf, (*PacketBuffer).ReadChatType); err != nil {
+		return fmt.Errorf("failed to read chat type: %w", err)
+	}
+	var err error
+	if b.Name, err = buf.ReadTextComponent(); err != nil {
+		return fmt.Errorf("failed to read chat sender name: %w", err)
+	}
+	if err := b.TargetName.DecodeWith(buf, (*PacketBuffer).ReadTextComponent); err != nil {
+		return fmt.Errorf("failed to read chat target name: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a ChatTypeBound to the buffer.
+func (b *ChatTypeBound) Encode(buf *PacketBuffer) error {
+	if err := b.ChatType.EncodeWith(buf, (*PacketBuffer).WriteChatType); err != nil {
+		return fmt.Errorf("failed to write chat type: %w", err)
+	}
+	if err := buf.WriteTextComponent(b.Name); err != nil {
+		return fmt.Errorf("failed to write chat sender name: %w", err)
+	}
+	if err := b.TargetName.EncodeWith(buf, (*PacketBuffer).WriteTextComponent); err != nil {
+		return fmt.Errorf("failed to write chat target name: %w", err)
+	}
+	return nil
+}
+
+// Resolve returns the chat type, looking registry IDs up in chatTypes: the
+// minecraft:chat_type registry entries in the order they were sent.
+func (b *ChatTypeBound) Resolve(chatTypes []ChatType) (ChatType, error) {
+	id, value, inline := b.ChatType.Get()
+	if inline {
+		return value, nil
+	}
+	if id < 0 || int(id) >= len(chatTypes) {
+		return ChatType{}, fmt.Errorf("chat type %d not in registry (%d entries)", id, len(chatTypes))
+	}
+	return chatTypes[id], nil
+}
+
+// Format returns the message as displayed in chat: content decorated by the
+// chat type's chat decoration with the bound sender and target names.
+func (b *ChatTypeBound) Format(chatTypes []ChatType, content TextComponent) (TextComponent, error) {
+	chatType, err := b.Resolve(chatTypes)
+	if err != nil {
+		return TextComponent{}, err
+	}
+	target, _ := b.TargetName.Get()
+	return chatType.Chat.Format(b.Name, target, content), nil
+}
+
+func init() {
+	RegisterEnumNames("chat type parameter", chatTypeParameterNames)
+}
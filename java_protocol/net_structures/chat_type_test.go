@@ -0,0 +1,142 @@
+package net_structures_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+)
+
+// vanilla's minecraft:msg_command_incoming
+var testWhisperChatType = ns.ChatType{
+	Chat: ns.ChatTypeDecoration{
+		TranslationKey: "commands.message.display.incoming",
+		Parameters:     ns.PrefixedArray[ns.ChatTypeParameter]{ns.ChatTypeParameterSender, ns.ChatTypeParameterContent},
+		Style:          ns.TextComponent{Color: "gray", Italic: boolPtr(true)},
+	},
+	Narration: ns.ChatTypeDecoration{
+		TranslationKey: "chat.type.text.narrate",
+		Parameters:     ns.PrefixedArray[ns.ChatTypeParameter]{ns.ChatTypeParameterSender, ns.ChatTypeParameterContent},
+	},
+}
+
+func TestChatTypeBound_RoundTrip(t *testing.T) {
+	for _, in := range []ns.ChatTypeBound{
+		{ChatType: ns.NewIDRef[ns.ChatType](0), Name: ns.NewTextComponent("Steve")},
+		{
+			ChatType:   ns.NewInlineValue(testWhisperChatType),
+			Name:       ns.TextComponent{Text: "Steve", Color: "gold"},
+			TargetName: ns.Some(ns.NewTextComponent("Alex")),
+		},
+	} {
+		buf := ns.NewWriter()
+		if err := in.Encode(buf); err != nil {
+			t.Fatalf("Encode: %v", err)
+		}
+		var out ns.ChatTypeBound
+		if err := out.Decode(ns.NewReader(buf.Bytes())); err != nil {
+			t.Fatalf("Decode: %v", err)
+		}
+		if !reflect.DeepEqual(in, out) {
+			t.Errorf("round trip mismatch:\n got %+v\nwant %+v", out, in)
+		}
+	}
+}
+
+func TestChatType_RegistryNBT(t *testing.T) {
+	tag, err := testWhisperChatType.Tag()
+	if err != nil {
+		t.Fatalf("Tag: %v", err)
+	}
+	want := `{chat:{parameters:["sender","content"],style:{color:"gray",italic:1b},` +
+		`translation_key:"commands.message.display.incoming"},` +
+		`narration:{parameters:["sender","content"],translation_key:"chat.type.text.narrate"}}`
+	if got := nbt.Stringify(tag); got != want {
+		t.Errorf("Tag() =\n%s\nwant\n%s", got, want)
+	}
+
+	var out ns.ChatType
+	if err := nbt.UnmarshalTag(tag, &out); err != nil {
+		t.Fatalf("UnmarshalTag: %v", err)
+	}
+	if !reflect.DeepEqual(out, testWhisperChatType) {
+		t.Errorf("registry round trip mismatch:\n got %+v\nwant %+v", out, testWhisperChatType)
+	}
+}
+
+func TestChatTypeDecoration_Invalid(t *testing.T) {
+	var d ns.ChatTypeDecoration
+	err := d.UnmarshalNBT(nbt.Compound{
+		"translation_key": nbt.String("chat.type.text"),
+		"parameters":      nbt.List{ElementType: nbt.TagString, Elements: []nbt.Tag{nbt.String("receiver")}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "receiver") {
+		t.Errorf("expected unknown parameter error, got %v", err)
+	}
+
+	w := ns.NewWriter()
+	w.WriteString("chat.type.text")
+	w.WriteVarInt(1)
+	w.WriteVarInt(3) // no such parameter
+	if err := d.Decode(ns.NewReader(w.Bytes())); err == nil {
+		t.Error("expected error for invalid parameter")
+	}
+}
+
+func TestChatTypeDecoration_Format(t *testing.T) {
+	lang := map[string]string{
+		"commands.message.display.incoming": "%s whispers to you: %s",
+		"commands.message.display.outgoing": "You whisper to %s: %s",
+	}
+	translate := func(key string) string { return lang[key] }
+
+	tc := testWhisperChatType.Chat.Format(ns.NewTextComponent("Steve"), ns.TextComponent{}, ns.NewTextComponent("hi"))
+	if got := tc.Render(translate); got != "Steve whispers to you: hi" {
+		t.Errorf("Render() = %q", got)
+	}
+	if tc.Color != "gray" || tc.Italic == nil || !*tc.Italic {
+		t.Errorf("decoration style not applied: %+v", tc)
+	}
+
+	outgoing := ns.ChatTypeDecoration{
+		TranslationKey: "commands.message.display.outgoing",
+		Parameters:     ns.PrefixedArray[ns.ChatTypeParameter]{ns.ChatTypeParameterTarget, ns.ChatTypeParameterContent},
+	}
+	tc = outgoing.Format(ns.NewTextComponent("Steve"), ns.NewTextComponent("Alex"), ns.NewTextComponent("hi"))
+	if got := tc.Render(translate); got != "You whisper to Alex: hi" {
+		t.Errorf("Render() = %q", got)
+	}
+}
+
+func TestChatTypeBound_Format(t *testing.T) {
+	registry := []ns.ChatType{{
+		Chat: ns.ChatTypeDecoration{
+			TranslationKey: "chat.type.text",
+			Parameters:     ns.PrefixedArray[ns.ChatTypeParameter]{ns.ChatTypeParameterSender, ns.ChatTypeParameterContent},
+		},
+	}}
+
+	bound := ns.ChatTypeBound{ChatType: ns.NewIDRef[ns.ChatType](0), Name: ns.NewTextComponent("Steve")}
+	tc, err := bound.Format(registry, ns.NewTextComponent("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tc.String(); got != "chat.type.textStevehello" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := tc.Render(func(string) string { return "<%s> %s" }); got != "<Steve> hello" {
+		t.Errorf("Render() = %q", got)
+	}
+
+	bound.ChatType = ns.NewIDRef[ns.ChatType](1)
+	if _, err := bound.Format(registry, ns.NewTextComponent("hello")); err == nil {
+		t.Error("expected error for unknown chat type ID")
+	}
+
+	bound.ChatType = ns.NewInlineValue(testWhisperChatType)
+	if ct, err := bound.Resolve(nil); err != nil || ct.Chat.TranslationKey != testWhisperChatType.Chat.TranslationKey {
+		t.Errorf("Resolve(inline) = %+v, %v", ct, err)
+	}
+}
@@ -1,8 +1,8 @@
 package net_structures
 
 import (
-	"encoding/binary"
 	"fmt"
+	"slices"
 
 	"github.com/go-mclib/protocol/nbt"
 )
@@ -23,12 +23,19 @@ import (
 type ChunkData struct {
 	// Heightmaps maps heightmap type IDs to long arrays.
 	// Type IDs: 1=WORLD_SURFACE, 4=MOTION_BLOCKING, 5=MOTION_BLOCKING_NO_LEAVES.
+	//
+	// Encoding is deterministic: heightmaps are written in the order they were
+	// decoded (or added via SetHeightmap), followed by any other keys in ascending order.
 	Heightmaps map[int32][]int64
+	// heightmapOrder records the wire order of Heightmaps keys.
+	heightmapOrder []int32
 
 	// Data contains packed chunk sections. Each section contains:
 	// - Block count (short)
 	// - Block states (paletted container)
 	// - Biomes (paletted container)
+	//
+	// Use Sections and SetSections to work with them as ChunkSections.
 	Data []byte
 
 	// BlockEntities in this chunk.
@@ -76,32 +83,81 @@ func (b *BlockEntity) SetXZ(x, z int) {
 	b.PackedXZ = Uint8(((x & 15) << 4) | (z & 15))
 }
 
+// Heightmap type IDs used as keys in ChunkData.Heightmaps.
+const (
+	HeightmapWorldSurface           int32 = 1
+	HeightmapMotionBlocking         int32 = 4
+	HeightmapMotionBlockingNoLeaves int32 = 5
+)
+
+// HeightmapBits returns the bits per entry used by heightmaps for a dimension
+// of the given height (e.g. 384 for the overworld -> 9 bits).
+func HeightmapBits(worldHeight int) int {
+	return BitsForValues(worldHeight + 1)
+}
+
+// Heightmap returns the heightmap of the given type as a PackedLongArray of
+// 256 entries (16×16 columns, indexed by z*16 + x). Each entry is the height
+// above the world's minimum Y. Returns nil if the heightmap is not present.
+func (c *ChunkData) Heightmap(kind int32, worldHeight int) (*PackedLongArray, error) {
+	longs, ok := c.Heightmaps[kind]
+	if !ok {
+		return nil, nil
+	}
+	return PackedLongArrayFromLongs(HeightmapBits(worldHeight), 256, longs)
+}
+
+// SetHeightmap stores a heightmap of the given type.
+func (c *ChunkData) SetHeightmap(kind int32, heightmap *PackedLongArray) {
+	if c.Heightmaps == nil {
+		c.Heightmaps = make(map[int32][]int64)
+	}
+	if _, ok := c.Heightmaps[kind]; !ok {
+		c.heightmapOrder = append(c.heightmapOrder, kind)
+	}
+	c.Heightmaps[kind] = heightmap.Longs()
+}
+
+// heightmapKeys returns the Heightmaps keys in encoding order.
+func (c *ChunkData) heightmapKeys() []int32 {
+	keys := make([]int32, 0, len(c.Heightmaps))
+	seen := make(map[int32]bool, len(c.Heightmaps))
+	for _, key := range c.heightmapOrder {
+		if _, ok := c.Heightmaps[key]; ok && !seen[key] {
+			keys = append(keys, key)
+			seen[key] = true
+		}
+	}
+	rest := len(keys)
+	for key := range c.Heightmaps {
+		if !seen[key] {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys[rest:])
+	return keys
+}
+
 // Decode reads ChunkData from the buffer.
 func (c *ChunkData) Decode(buf *PacketBuffer) error {
 	// read heightmaps map: VarInt count, then (VarInt key, VarInt len, Int64[len]) entries
-	hmCount, err := buf.ReadVarInt()
+	hmCount, err := buf.ReadCount()
 	if err != nil {
 		return fmt.Errorf("failed to read heightmap count: %w", err)
 	}
 	c.Heightmaps = make(map[int32][]int64, hmCount)
-	for range int(hmCount) {
+	c.heightmapOrder = make([]int32, 0, hmCount)
+	for range hmCount {
 		key, err := buf.ReadVarInt()
 		if err != nil {
 			return fmt.Errorf("failed to read heightmap type: %w", err)
 		}
-		arrLen, err := buf.ReadVarInt()
+		longs, err := buf.ReadLongArray()
 		if err != nil {
-			return fmt.Errorf("failed to read heightmap array length: %w", err)
-		}
-		longs := make([]int64, arrLen)
-		for j := range longs {
-			var b [8]byte
-			if _, err := buf.Read(b[:]); err != nil {
-				return fmt.Errorf("failed to read heightmap long %d: %w", j, err)
-			}
-			longs[j] = int64(binary.BigEndian.Uint64(b[:]))
+			return fmt.Errorf("failed to read heightmap %d: %w", key, err)
 		}
 		c.Heightmaps[int32(key)] = longs
+		c.heightmapOrder = append(c.heightmapOrder, int32(key))
 	}
 
 	// read chunk data as byte array (max ~2MB for full chunk)
@@ -111,7 +167,7 @@ func (c *ChunkData) Decode(buf *PacketBuffer) error {
 	}
 
 	// read block entities
-	count, err := buf.ReadVarInt()
+	count, err := buf.ReadCount()
 	if err != nil {
 		return fmt.Errorf("failed to read block entity count: %w", err)
 	}
@@ -132,19 +188,12 @@ func (c *ChunkData) Encode(buf *PacketBuffer) error {
 	if err := buf.WriteVarInt(VarInt(len(c.Heightmaps))); err != nil {
 		return fmt.Errorf("failed to write heightmap count: %w", err)
 	}
-	for key, longs := range c.Heightmaps {
+	for _, key := range c.heightmapKeys() {
 		if err := buf.WriteVarInt(VarInt(key)); err != nil {
 			return fmt.Errorf("failed to write heightmap type: %w", err)
 		}
-		if err := buf.WriteVarInt(VarInt(len(longs))); err != nil {
-			return fmt.Errorf("failed to write heightmap array length: %w", err)
-		}
-		for _, v := range longs {
-			var b [8]byte
-			binary.BigEndian.PutUint64(b[:], uint64(v))
-			if _, err := buf.Write(b[:]); err != nil {
-				return fmt.Errorf("failed to write heightmap long: %w", err)
-			}
+		if err := buf.WriteLongArray(c.Heightmaps[key]); err != nil {
+			return fmt.Errorf("failed to write heightmap %d: %w", key, err)
 		}
 	}
 
@@ -298,7 +347,7 @@ func (l *LightData) Decode(buf *PacketBuffer) error {
 	}
 
 	// read sky light arrays (each is 2048 bytes)
-	skyCount, err := buf.ReadVarInt()
+	skyCount, err := buf.ReadCount()
 	if err != nil {
 		return fmt.Errorf("failed to read sky light array count: %w", err)
 	}
@@ -311,7 +360,7 @@ func (l *LightData) Decode(buf *PacketBuffer) error {
 	}
 
 	// read block light arrays (each is 2048 bytes)
-	blockCount, err := buf.ReadVarInt()
+	blockCount, err := buf.ReadCount()
 	if err != nil {
 		return fmt.Errorf("failed to read block light array count: %w", err)
 	}
@@ -0,0 +1,148 @@
+package net_structures
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// DimensionHeight is the vertical extent of a dimension, as given by its
+// dimension type (min_y and height).
+type DimensionHeight struct {
+	// MinY is the lowest block Y (e.g. -64 in the overworld).
+	MinY int
+	// Height is the number of blocks between MinY and the top of the world; a
+	// multiple of 16 (e.g. 384 in the overworld).
+	Height int
+}
+
+// Sections returns the number of chunk sections in a column.
+func (d DimensionHeight) Sections() int {
+	return d.Height / 16
+}
+
+// ChunkColumn is a parsed chunk: the sections of a ChunkData together with its
+// heightmaps and block entities, addressed with world coordinates.
+type ChunkColumn struct {
+	// X and Z are the chunk coordinates (block coordinates >> 4).
+	X, Z      int32
+	Dimension DimensionHeight
+	// Sections are ordered from the bottom of the world up.
+	Sections      []*ChunkSection
+	Heightmaps    map[int32]*PackedLongArray
+	BlockEntities []BlockEntity
+}
+
+// NewChunkColumn parses the chunk data of the chunk at x, z. The number of
+// sections must match the dimension height.
+func NewChunkColumn(x, z int32, data *ChunkData, dim DimensionHeight, blockStates, biomes PalettedContainerType) (*ChunkColumn, error) {
+	sections, err := data.Sections(blockStates, biomes)
+	if err != nil {
+		return nil, err
+	}
+	if len(sections) != dim.Sections() {
+		return nil, fmt.Errorf("chunk has %d sections, want %d for a height of %d", len(sections), dim.Sections(), dim.Height)
+	}
+
+	heightmaps := make(map[int32]*PackedLongArray, len(data.Heightmaps))
+	for kind := range data.Heightmaps {
+		hm, err := data.Heightmap(kind, dim.Height)
+		if err != nil {
+			return nil, fmt.Errorf("invalid heightmap %d: %w", kind, err)
+		}
+		heightmaps[kind] = hm
+	}
+
+	return &ChunkColumn{
+		X:             x,
+		Z:             z,
+		Dimension:     dim,
+		Sections:      sections,
+		Heightmaps:    heightmaps,
+		BlockEntities: data.BlockEntities,
+	}, nil
+}
+
+// Contains reports whether the block at the given world coordinates is in
+// this column and within the world's height.
+func (c *ChunkColumn) Contains(x, y, z int) bool {
+	return int32(x>>4) == c.X && int32(z>>4) == c.Z &&
+		y >= c.Dimension.MinY && y < c.Dimension.MinY+c.Dimension.Height
+}
+
+// Section returns the section containing the given block Y, or nil if Y is
+// outside the world.
+func (c *ChunkColumn) Section(y int) *ChunkSection {
+	i := (y - c.Dimension.MinY) >> 4
+	if y < c.Dimension.MinY || i >= len(c.Sections) {
+		return nil
+	}
+	return c.Sections[i]
+}
+
+// BlockAt returns the block state ID at the given world coordinates. It
+// returns false if the block is not in this column.
+func (c *ChunkColumn) BlockAt(x, y, z int) (int32, bool) {
+	if !c.Contains(x, y, z) {
+		return 0, false
+	}
+	return c.Section(y).GetBlockState(x, y, z), true
+}
+
+// SetBlockAt sets the block state ID at the given world coordinates. It
+// returns false (and does nothing) if the block is not in this column.
+func (c *ChunkColumn) SetBlockAt(x, y, z int, state int32) bool {
+	if !c.Contains(x, y, z) {
+		return false
+	}
+	c.Section(y).SetBlockState(x, y, z, state)
+	return true
+}
+
+// BiomeAt returns the biome ID at the given world (block) coordinates. It
+// returns false if the block is not in this column.
+func (c *ChunkColumn) BiomeAt(x, y, z int) (int32, bool) {
+	if !c.Contains(x, y, z) {
+		return 0, false
+	}
+	return c.Section(y).GetBiome(x>>2, y>>2, z>>2), true
+}
+
+// BlockEntityAt returns the block entity at the given world coordinates, or
+// nil if there is none.
+func (c *ChunkColumn) BlockEntityAt(x, y, z int) *BlockEntity {
+	if !c.Contains(x, y, z) {
+		return nil
+	}
+	for i := range c.BlockEntities {
+		be := &c.BlockEntities[i]
+		if be.X() == x&15 && be.Z() == z&15 && int(be.Y) == y {
+			return be
+		}
+	}
+	return nil
+}
+
+// Height returns the value of the given heightmap at the world column x, z as
+// a block Y: the Y above the highest block counted by the heightmap. It
+// returns false if the heightmap is missing or x, z is not in this column.
+func (c *ChunkColumn) Height(kind int32, x, z int) (int, bool) {
+	hm, ok := c.Heightmaps[kind]
+	if !ok || int32(x>>4) != c.X || int32(z>>4) != c.Z {
+		return 0, false
+	}
+	return c.Dimension.MinY + hm.Get((z&15)*16+(x&15)), true
+}
+
+// ChunkData encodes the column back into ChunkData.
+func (c *ChunkColumn) ChunkData() (ChunkData, error) {
+	var data ChunkData
+	for _, kind := range slices.Sorted(maps.Keys(c.Heightmaps)) {
+		data.SetHeightmap(kind, c.Heightmaps[kind])
+	}
+	if err := data.SetSections(c.Sections); err != nil {
+		return ChunkData{}, err
+	}
+	data.BlockEntities = c.BlockEntities
+	return data, nil
+}
@@ -0,0 +1,118 @@
+package net_structures_test
+
+import (
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+)
+
+var testOverworld = ns.DimensionHeight{MinY: -64, Height: 384}
+
+func newTestColumn(t *testing.T) *ns.ChunkColumn {
+	t.Helper()
+	sections := make([]*ns.ChunkSection, testOverworld.Sections())
+	for i := range sections {
+		sections[i] = ns.NewChunkSection(testBlockStates, testBiomes)
+	}
+	// bedrock at the bottom of block column 0, 0 and stone at Y=70 in section 8
+	sections[0].SetBlockState(0, 0, 0, 85)
+	sections[8].SetBlockState(3, 70&15, 5, 1)
+	sections[8].SetBiome(0, 70>>2, 1, 7)
+
+	hm := ns.NewPackedLongArray(ns.HeightmapBits(testOverworld.Height), 256)
+	hm.Set(5*16+3, 70-testOverworld.MinY+1)
+
+	var data ns.ChunkData
+	data.SetHeightmap(ns.HeightmapWorldSurface, hm)
+	if err := data.SetSections(sections); err != nil {
+		t.Fatal(err)
+	}
+	be := ns.BlockEntity{Y: 70, Type: 7, Data: nbt.Compound{}}
+	be.SetXZ(3, 5)
+	data.BlockEntities = []ns.BlockEntity{be}
+
+	col, err := ns.NewChunkColumn(-2, 3, &data, testOverworld, testBlockStates, testBiomes)
+	if err != nil {
+		t.Fatalf("failed to parse column: %v", err)
+	}
+	return col
+}
+
+func TestChunkColumn_BlockAt(t *testing.T) {
+	col := newTestColumn(t)
+	// chunk -2, 3 spans X -32..-17 and Z 48..63
+	tests := []struct {
+		x, y, z int
+		state   int32
+		ok      bool
+	}{
+		{-32, -64, 48, 85, true},
+		{-29, 70, 53, 1, true},
+		{-29, 71, 53, 0, true},
+		{-17, 319, 63, 0, true},
+		{-29, 320, 53, 0, false},
+		{-29, -65, 53, 0, false},
+		{-33, 70, 53, 0, false},
+		{-29, 70, 64, 0, false},
+	}
+	for _, tt := range tests {
+		state, ok := col.BlockAt(tt.x, tt.y, tt.z)
+		if state != tt.state || ok != tt.ok {
+			t.Errorf("BlockAt(%d, %d, %d) = %d, %v; want %d, %v", tt.x, tt.y, tt.z, state, ok, tt.state, tt.ok)
+		}
+	}
+
+	if !col.SetBlockAt(-17, 319, 63, 9) {
+		t.Fatal("SetBlockAt in range returned false")
+	}
+	if state, _ := col.BlockAt(-17, 319, 63); state != 9 || col.Section(319).BlockCount != 1 {
+		t.Errorf("after SetBlockAt: state %d, block count %d", state, col.Section(319).BlockCount)
+	}
+	if col.SetBlockAt(0, 0, 0, 9) {
+		t.Error("SetBlockAt outside the column returned true")
+	}
+}
+
+func TestChunkColumn_Lookups(t *testing.T) {
+	col := newTestColumn(t)
+
+	if biome, ok := col.BiomeAt(-32, 70, 53); !ok || biome != 7 {
+		t.Errorf("BiomeAt = %d, %v", biome, ok)
+	}
+	if be := col.BlockEntityAt(-29, 70, 53); be == nil || be.Type != 7 {
+		t.Errorf("BlockEntityAt = %+v", be)
+	}
+	if be := col.BlockEntityAt(-29, 71, 53); be != nil {
+		t.Errorf("unexpected block entity: %+v", be)
+	}
+	if y, ok := col.Height(ns.HeightmapWorldSurface, -29, 53); !ok || y != 71 {
+		t.Errorf("Height = %d, %v; want 71", y, ok)
+	}
+	if _, ok := col.Height(ns.HeightmapMotionBlocking, -29, 53); ok {
+		t.Error("expected missing heightmap")
+	}
+	if col.Section(-65) != nil || col.Section(320) != nil {
+		t.Error("expected no section outside the world")
+	}
+}
+
+func TestChunkColumn_RoundTrip(t *testing.T) {
+	col := newTestColumn(t)
+	data, err := col.ChunkData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := ns.NewChunkColumn(col.X, col.Z, &data, testOverworld, testBlockStates, testBiomes)
+	if err != nil {
+		t.Fatalf("failed to parse re-encoded column: %v", err)
+	}
+	if state, _ := again.BlockAt(-29, 70, 53); state != 1 {
+		t.Errorf("block after round trip = %d", state)
+	}
+
+	nether := ns.DimensionHeight{MinY: 0, Height: 256}
+	if _, err := ns.NewChunkColumn(col.X, col.Z, &data, nether, testBlockStates, testBiomes); err == nil {
+		t.Error("expected section count mismatch error")
+	}
+}
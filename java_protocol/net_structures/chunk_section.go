@@ -0,0 +1,399 @@
+package net_structures
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+)
+
+// Number of entries in the paletted containers of a chunk section.
+const (
+	// SectionBlockCount is the number of blocks in a 16×16×16 chunk section.
+	SectionBlockCount = 16 * 16 * 16
+	// SectionBiomeCount is the number of 4×4×4 biome cells in a chunk section.
+	SectionBiomeCount = 4 * 4 * 4
+)
+
+// PalettedContainerType describes how a kind of paletted container (block
+// states or biomes) is encoded. The widths follow the vanilla strategies; the
+// direct width depends on the size of the registry, which changes between
+// versions.
+type PalettedContainerType struct {
+	// Size is the number of entries (SectionBlockCount or SectionBiomeCount).
+	Size int
+	// MinIndirectBits is the narrowest width of an indirect palette. Smaller
+	// widths on the wire are stored (and re-encoded) with this width, as
+	// vanilla does.
+	MinIndirectBits int
+	// MaxIndirectBits is the widest indirect palette; wider containers use the
+	// direct palette.
+	MaxIndirectBits int
+	// DirectBits is the width of direct palette entries: the bits needed for
+	// every ID of the registry. Decode requires direct data to have this width,
+	// and Set uses it when switching to a direct palette. 0 means unknown: the
+	// width sent on the wire is used.
+	DirectBits int
+}
+
+// BlockStatesContainerType returns the container type of block states for a
+// registry of registrySize block states.
+func BlockStatesContainerType(registrySize int) PalettedContainerType {
+	return PalettedContainerType{Size: SectionBlockCount, MinIndirectBits: 4, MaxIndirectBits: 8, DirectBits: BitsForValues(registrySize)}
+}
+
+// BiomesContainerType returns the container type of biomes for a registry of
+// registrySize biomes.
+func BiomesContainerType(registrySize int) PalettedContainerType {
+	return PalettedContainerType{Size: SectionBiomeCount, MinIndirectBits: 1, MaxIndirectBits: 3, DirectBits: BitsForValues(registrySize)}
+}
+
+// PaletteFormat is the palette kind of a PalettedContainer.
+type PaletteFormat uint8
+
+const (
+	// PaletteSingleValue: every entry has the same value and no data is stored.
+	PaletteSingleValue PaletteFormat = iota
+	// PaletteIndirect: entries are indices into a local palette of registry IDs.
+	PaletteIndirect
+	// PaletteDirect: entries are registry IDs.
+	PaletteDirect
+)
+
+func (f PaletteFormat) String() string {
+	switch f {
+	case PaletteSingleValue:
+		return "single value"
+	case PaletteIndirect:
+		return "indirect"
+	case PaletteDirect:
+		return "direct"
+	default:
+		return fmt.Sprintf("PaletteFormat(%d)", uint8(f))
+	}
+}
+
+// PalettedContainer holds the block states or biomes of a chunk section as
+// registry IDs, compressed with a palette.
+//
+// Wire format:
+//
+//	┌──────────────────────┬─────────────────────────────┬──────────────────────────┐
+//	│  Bits Per Entry (UB) │  Palette                    │  Data (packed longs)     │
+//	└──────────────────────┴─────────────────────────────┴──────────────────────────┘
+//
+// Bits Per Entry selects the palette:
+//   - 0: single value; the palette is one VarInt and there is no data
+//   - up to MaxIndirectBits: indirect; the palette is a VarInt-prefixed array of VarInt IDs
+//   - above: direct; there is no palette and the data holds IDs
+//
+// The data is a PackedLongArray of Size entries without a length prefix (since
+// 1.21.5). Entries are indexed as (y*16 + z)*16 + x for block states and
+// (y*4 + z)*4 + x for biomes.
+//
+// Decoding and re-encoding an unmodified container produces identical bytes:
+// the palette format, width and entry order are kept. The exception is an
+// indirect width below MinIndirectBits, which is widened on decode (as vanilla
+// does) and written back as MinIndirectBits; vanilla never sends those. Set
+// only grows the palette, like the vanilla server.
+//
+// See https://minecraft.wiki/w/Java_Edition_protocol/Chunk_format#Paletted_Container_structure
+type PalettedContainer struct {
+	typ PalettedContainerType
+	// palette holds the single value or the indirect palette; nil when direct.
+	palette []int32
+	data    *PackedLongArray
+}
+
+// NewPalettedContainer creates a container of the given type where every entry is value.
+func NewPalettedContainer(typ PalettedContainerType, value int32) *PalettedContainer {
+	return &PalettedContainer{typ: typ, palette: []int32{value}, data: NewPackedLongArray(0, typ.Size)}
+}
+
+// Type returns the container type.
+func (c *PalettedContainer) Type() PalettedContainerType {
+	return c.typ
+}
+
+// Format returns the palette format.
+func (c *PalettedContainer) Format() PaletteFormat {
+	switch {
+	case c.data.BitsPerEntry() == 0:
+		return PaletteSingleValue
+	case c.palette != nil:
+		return PaletteIndirect
+	default:
+		return PaletteDirect
+	}
+}
+
+// BitsPerEntry returns the width of the stored entries (0 for a single value).
+func (c *PalettedContainer) BitsPerEntry() int {
+	return c.data.BitsPerEntry()
+}
+
+// Palette returns the registry IDs of the palette: one ID for a single value
+// container and nil for a direct one.
+func (c *PalettedContainer) Palette() []int32 {
+	return c.palette
+}
+
+// Get returns the registry ID at index i. Out of range indices, and entries
+// pointing past the end of the palette, return 0.
+func (c *PalettedContainer) Get(i int) int32 {
+	if c.data.BitsPerEntry() == 0 {
+		return c.palette[0]
+	}
+	v := c.data.Get(i)
+	if c.palette == nil {
+		return int32(v)
+	}
+	if v >= len(c.palette) {
+		return 0
+	}
+	return c.palette[v]
+}
+
+// Set sets the registry ID at index i, growing the palette (and switching to a
+// wider or the direct format) if needed. Out of range indices are ignored.
+func (c *PalettedContainer) Set(i int, value int32) {
+	if i < 0 || i >= c.typ.Size {
+		return
+	}
+	if c.palette == nil {
+		c.data.Set(i, int(value))
+		return
+	}
+	if idx := slices.Index(c.palette, value); idx >= 0 {
+		c.data.Set(i, idx)
+		return
+	}
+
+	bits := max(BitsForValues(len(c.palette)+1), c.typ.MinIndirectBits)
+	if bits <= c.typ.MaxIndirectBits {
+		if bits != c.data.BitsPerEntry() {
+			c.data = c.data.Resize(bits)
+		}
+		c.palette = append(c.palette, value)
+		c.data.Set(i, len(c.palette)-1)
+		return
+	}
+
+	direct := NewPackedLongArray(max(c.typ.DirectBits, c.typ.MaxIndirectBits+1), c.typ.Size)
+	for j := range c.typ.Size {
+		direct.Set(j, int(c.Get(j)))
+	}
+	c.palette = nil
+	c.data = direct
+	c.data.Set(i, int(value))
+}
+
+// Decode reads the container from the buffer. The container type must be set,
+// e.g. by creating the container with NewPalettedContainer.
+func (c *PalettedContainer) Decode(buf *PacketBuffer) error {
+	if c.typ.Size <= 0 {
+		return fmt.Errorf("paletted container has no type")
+	}
+	b, err := buf.ReadUint8()
+	if err != nil {
+		return fmt.Errorf("failed to read bits per entry: %w", err)
+	}
+	bits := int(b)
+
+	switch {
+	case bits == 0:
+		value, err := buf.ReadVarInt()
+		if err != nil {
+			return fmt.Errorf("failed to read single value palette: %w", err)
+		}
+		c.palette = []int32{int32(value)}
+		c.data = NewPackedLongArray(0, c.typ.Size)
+		return nil
+	case bits <= c.typ.MaxIndirectBits:
+		bits = max(bits, c.typ.MinIndirectBits)
+		count, err := buf.ReadVarInt()
+		if err != nil {
+			return fmt.Errorf("failed to read palette length: %w", err)
+		}
+		if count <= 0 || count > 1<<bits || buf.checkCount(int(count)) != nil {
+			return fmt.Errorf("invalid palette length: %d", count)
+		}
+		c.palette = make([]int32, count)
+		for i := range c.palette {
+			id, err := buf.ReadVarInt()
+			if err != nil {
+				return fmt.Errorf("failed to read palette entry %d: %w", i, err)
+			}
+			c.palette[i] = int32(id)
+		}
+	case bits <= 32:
+		// since 1.21.5 the data has no length prefix, so it must be read at
+		// the registry's width
+		if c.typ.DirectBits != 0 {
+			if want := max(c.typ.DirectBits, c.typ.MaxIndirectBits+1); bits != want {
+				return fmt.Errorf("direct palette has %d bits per entry, want %d", bits, want)
+			}
+		}
+		c.palette = nil
+	default:
+		return fmt.Errorf("invalid bits per entry: %d", bits)
+	}
+
+	c.data = NewPackedLongArray(bits, c.typ.Size)
+	if err := c.data.Decode(buf); err != nil {
+		return fmt.Errorf("failed to read paletted container data: %w", err)
+	}
+	return nil
+}
+
+// Encode writes the container to the buffer.
+func (c *PalettedContainer) Encode(buf *PacketBuffer) error {
+	bits := c.data.BitsPerEntry()
+	if err := buf.WriteUint8(Uint8(bits)); err != nil {
+		return fmt.Errorf("failed to write bits per entry: %w", err)
+	}
+
+	switch c.Format() {
+	case PaletteSingleValue:
+		if err := buf.WriteVarInt(VarInt(c.palette[0])); err != nil {
+			return fmt.Errorf("failed to write single value palette: %w", err)
+		}
+		return nil
+	case PaletteIndirect:
+		if err := buf.WriteVarInt(VarInt(len(c.palette))); err != nil {
+			return fmt.Errorf("failed to write palette length: %w", err)
+		}
+		for i, id := range c.palette {
+			if err := buf.WriteVarInt(VarInt(id)); err != nil {
+				return fmt.Errorf("failed to write palette entry %d: %w", i, err)
+			}
+		}
+	}
+
+	if err := c.data.Encode(buf); err != nil {
+		return fmt.Errorf("failed to write paletted container data: %w", err)
+	}
+	return nil
+}
+
+// ChunkSection is a 16×16×16 section of a chunk, as stored in ChunkData.Data.
+//
+// Wire format:
+//
+//	┌──────────────────────┬─────────────────────────────┬──────────────────────────┐
+//	│  Block Count (Short) │  Block States (Paletted)    │  Biomes (Paletted)       │
+//	└──────────────────────┴─────────────────────────────┴──────────────────────────┘
+//
+// Coordinates are relative to the section (0-15 for blocks, 0-3 for biome cells).
+type ChunkSection struct {
+	// BlockCount is the number of non-air blocks in the section.
+	BlockCount  Int16
+	BlockStates *PalettedContainer
+	Biomes      *PalettedContainer
+}
+
+// NewChunkSection creates an empty section: every block is state 0 (air) and
+// every biome cell is biome 0.
+func NewChunkSection(blockStates, biomes PalettedContainerType) *ChunkSection {
+	return &ChunkSection{
+		BlockStates: NewPalettedContainer(blockStates, 0),
+		Biomes:      NewPalettedContainer(biomes, 0),
+	}
+}
+
+// GetBlockState returns the block state ID at the given section coordinates.
+func (s *ChunkSection) GetBlockState(x, y, z int) int32 {
+	return s.BlockStates.Get(blockStateIndex(x, y, z))
+}
+
+// SetBlockState sets the block state ID at the given section coordinates.
+// BlockCount is updated treating state 0 (minecraft:air) as the only air
+// state; sections with cave or void air need to fix it up themselves.
+func (s *ChunkSection) SetBlockState(x, y, z int, state int32) {
+	i := blockStateIndex(x, y, z)
+	old := s.BlockStates.Get(i)
+	s.BlockStates.Set(i, state)
+	switch {
+	case old == 0 && state != 0:
+		s.BlockCount++
+	case old != 0 && state == 0:
+		s.BlockCount--
+	}
+}
+
+// GetBiome returns the biome ID of the given biome cell.
+func (s *ChunkSection) GetBiome(x, y, z int) int32 {
+	return s.Biomes.Get(biomeIndex(x, y, z))
+}
+
+// SetBiome sets the biome ID of the given biome cell.
+func (s *ChunkSection) SetBiome(x, y, z int, biome int32) {
+	s.Biomes.Set(biomeIndex(x, y, z), biome)
+}
+
+func blockStateIndex(x, y, z int) int {
+	return (y&15)<<8 | (z&15)<<4 | x&15
+}
+
+func biomeIndex(x, y, z int) int {
+	return (y&3)<<4 | (z&3)<<2 | x&3
+}
+
+// Decode reads a ChunkSection from the buffer. The containers must be set to
+// get their types, e.g. by creating the section with NewChunkSection.
+func (s *ChunkSection) Decode(buf *PacketBuffer) error {
+	if s.BlockStates == nil || s.Biomes == nil {
+		return fmt.Errorf("chunk section has no container types")
+	}
+	var err error
+	if s.BlockCount, err = buf.ReadInt16(); err != nil {
+		return fmt.Errorf("failed to read block count: %w", err)
+	}
+	if err := s.BlockStates.Decode(buf); err != nil {
+		return fmt.Errorf("failed to read block states: %w", err)
+	}
+	if err := s.Biomes.Decode(buf); err != nil {
+		return fmt.Errorf("failed to read biomes: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a ChunkSection to the buffer.
+func (s *ChunkSection) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteInt16(s.BlockCount); err != nil {
+		return fmt.Errorf("failed to write block count: %w", err)
+	}
+	if err := s.BlockStates.Encode(buf); err != nil {
+		return fmt.Errorf("failed to write block states: %w", err)
+	}
+	if err := s.Biomes.Encode(buf); err != nil {
+		return fmt.Errorf("failed to write biomes: %w", err)
+	}
+	return nil
+}
+
+// Sections decodes Data into chunk sections, from the bottom of the world up.
+func (c *ChunkData) Sections(blockStates, biomes PalettedContainerType) ([]*ChunkSection, error) {
+	r := bytes.NewReader(c.Data)
+	buf := NewReaderFrom(r)
+	var sections []*ChunkSection
+	for r.Len() > 0 {
+		section := NewChunkSection(blockStates, biomes)
+		if err := section.Decode(buf); err != nil {
+			return nil, fmt.Errorf("failed to read chunk section %d: %w", len(sections), err)
+		}
+		sections = append(sections, section)
+	}
+	return sections, nil
+}
+
+// SetSections encodes sections into Data.
+func (c *ChunkData) SetSections(sections []*ChunkSection) error {
+	buf := NewWriter()
+	for i, section := range sections {
+		if err := section.Encode(buf); err != nil {
+			return fmt.Errorf("failed to write chunk section %d: %w", i, err)
+		}
+	}
+	c.Data = buf.Bytes()
+	return nil
+}
@@ -0,0 +1,226 @@
+package net_structures_test
+
+import (
+	"bytes"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+var (
+	testBlockStates = ns.BlockStatesContainerType(29873) // 15 bits
+	testBiomes      = ns.BiomesContainerType(65)         // 7 bits
+)
+
+func TestPalettedContainer_Decode(t *testing.T) {
+	// indirect block states: 4 bits, palette [air, stone, dirt], 256 longs
+	w := ns.NewWriter()
+	w.WriteUint8(4)
+	w.WriteVarInt(3)
+	for _, id := range []ns.VarInt{0, 1, 10} {
+		w.WriteVarInt(id)
+	}
+	data := ns.NewPackedLongArray(4, ns.SectionBlockCount)
+	data.Set(0, 1)
+	data.Set(1, 2)
+	data.Set(4095, 1)
+	data.Encode(w)
+	raw := bytes.Clone(w.Bytes())
+
+	c := ns.NewPalettedContainer(testBlockStates, 0)
+	if err := c.Decode(ns.NewReader(raw)); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if c.Format() != ns.PaletteIndirect || c.BitsPerEntry() != 4 {
+		t.Errorf("got %v with %d bits", c.Format(), c.BitsPerEntry())
+	}
+	if c.Get(0) != 1 || c.Get(1) != 10 || c.Get(2) != 0 || c.Get(4095) != 1 {
+		t.Errorf("unexpected values: %d %d %d %d", c.Get(0), c.Get(1), c.Get(2), c.Get(4095))
+	}
+
+	out := ns.NewWriter()
+	if err := c.Encode(out); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	if !bytes.Equal(out.Bytes(), raw) {
+		t.Error("re-encoded container differs from the input")
+	}
+}
+
+func TestPalettedContainer_RoundTrip(t *testing.T) {
+	single := ns.NewPalettedContainer(testBiomes, 5)
+	direct := ns.NewPalettedContainer(testBiomes, 0)
+	for i := range ns.SectionBiomeCount {
+		direct.Set(i, int32(i))
+	}
+
+	for _, tt := range []struct {
+		in     *ns.PalettedContainer
+		format ns.PaletteFormat
+		size   int
+	}{
+		{single, ns.PaletteSingleValue, 2},
+		{direct, ns.PaletteDirect, 1 + 8*ns.PackedLongsLen(7, ns.SectionBiomeCount)},
+	} {
+		w := ns.NewWriter()
+		if err := tt.in.Encode(w); err != nil {
+			t.Fatalf("%v: encode error: %v", tt.format, err)
+		}
+		if w.Len() != tt.size {
+			t.Errorf("%v: encoded %d bytes, want %d", tt.format, w.Len(), tt.size)
+		}
+		out := ns.NewPalettedContainer(testBiomes, 0)
+		if err := out.Decode(ns.NewReader(w.Bytes())); err != nil {
+			t.Fatalf("%v: decode error: %v", tt.format, err)
+		}
+		if out.Format() != tt.format {
+			t.Errorf("got format %v, want %v", out.Format(), tt.format)
+		}
+		for i := range ns.SectionBiomeCount {
+			if out.Get(i) != tt.in.Get(i) {
+				t.Fatalf("%v: entry %d = %d, want %d", tt.format, i, out.Get(i), tt.in.Get(i))
+			}
+		}
+	}
+}
+
+func TestPalettedContainer_Grow(t *testing.T) {
+	c := ns.NewPalettedContainer(testBlockStates, 0)
+	steps := []struct {
+		values int
+		format ns.PaletteFormat
+		bits   int
+	}{
+		{1, ns.PaletteSingleValue, 0},
+		{2, ns.PaletteIndirect, 4},
+		{16, ns.PaletteIndirect, 4},
+		{17, ns.PaletteIndirect, 5},
+		{256, ns.PaletteIndirect, 8},
+		{257, ns.PaletteDirect, 15},
+	}
+	next := 1
+	for _, step := range steps {
+		for ; next < step.values; next++ {
+			c.Set(next, int32(next*100))
+		}
+		if c.Format() != step.format || c.BitsPerEntry() != step.bits {
+			t.Errorf("%d values: got %v with %d bits, want %v with %d", step.values, c.Format(), c.BitsPerEntry(), step.format, step.bits)
+		}
+	}
+	for i := range ns.SectionBlockCount {
+		want := int32(0)
+		if i < next {
+			want = int32(i * 100)
+		}
+		if c.Get(i) != want {
+			t.Fatalf("entry %d = %d, want %d", i, c.Get(i), want)
+		}
+	}
+}
+
+func TestPalettedContainer_DirectWidth(t *testing.T) {
+	w := ns.NewWriter()
+	w.WriteUint8(8)
+	data := ns.NewPackedLongArray(8, ns.SectionBiomeCount)
+	data.Set(63, 200)
+	data.Encode(w)
+
+	// without a known registry size, the wire width is used
+	unknown := testBiomes
+	unknown.DirectBits = 0
+	c := ns.NewPalettedContainer(unknown, 0)
+	if err := c.Decode(ns.NewReader(w.Bytes())); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if c.Format() != ns.PaletteDirect || c.BitsPerEntry() != 8 || c.Get(63) != 200 {
+		t.Errorf("got %v with %d bits, entry 63 = %d", c.Format(), c.BitsPerEntry(), c.Get(63))
+	}
+
+	// a 7 bit registry rejects it
+	if err := ns.NewPalettedContainer(testBiomes, 0).Decode(ns.NewReader(w.Bytes())); err == nil {
+		t.Error("expected error for a direct width that does not match the registry")
+	}
+}
+
+func TestPalettedContainer_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  ns.PalettedContainerType
+		data []byte
+	}{
+		{"too wide", testBlockStates, []byte{40}},
+		{"empty palette", testBlockStates, []byte{4, 0x00}},
+		{"palette too long", testBiomes, []byte{1, 0x03, 0x00, 0x01, 0x02}},
+		{"truncated palette", testBlockStates, []byte{4, 0x02, 0x00}},
+		{"truncated data", testBlockStates, []byte{4, 0x01, 0x00, 0x00}},
+		{"empty", testBlockStates, nil},
+	}
+	for _, tt := range tests {
+		if err := ns.NewPalettedContainer(tt.typ, 0).Decode(ns.NewReader(tt.data)); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+
+	var untyped ns.PalettedContainer
+	if err := untyped.Decode(ns.NewReader([]byte{0, 0})); err == nil {
+		t.Error("expected error for a container without a type")
+	}
+}
+
+func TestChunkSection_BlockState(t *testing.T) {
+	s := ns.NewChunkSection(testBlockStates, testBiomes)
+	s.SetBlockState(1, 2, 3, 1)
+	s.SetBlockState(15, 15, 15, 10)
+	s.SetBlockState(15, 15, 15, 11)
+	s.SetBiome(3, 0, 1, 4)
+
+	if s.BlockCount != 2 {
+		t.Errorf("block count = %d, want 2", s.BlockCount)
+	}
+	if got := s.GetBlockState(1, 2, 3); got != 1 {
+		t.Errorf("block at 1,2,3 = %d", got)
+	}
+	if got := s.BlockStates.Get((2*16+3)*16 + 1); got != 1 {
+		t.Errorf("block index layout: got %d", got)
+	}
+	if got := s.GetBiome(3, 0, 1); got != 4 {
+		t.Errorf("biome = %d", got)
+	}
+
+	s.SetBlockState(1, 2, 3, 0)
+	if s.BlockCount != 1 {
+		t.Errorf("block count = %d, want 1", s.BlockCount)
+	}
+}
+
+func TestChunkData_Sections(t *testing.T) {
+	sections := []*ns.ChunkSection{
+		ns.NewChunkSection(testBlockStates, testBiomes),
+		ns.NewChunkSection(testBlockStates, testBiomes),
+	}
+	sections[0].SetBlockState(0, 0, 0, 79)
+	sections[1].SetBiome(1, 1, 1, 2)
+
+	var chunk ns.ChunkData
+	if err := chunk.SetSections(sections); err != nil {
+		t.Fatal(err)
+	}
+	got, err := chunk.Sections(testBlockStates, testBiomes)
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d sections", len(got))
+	}
+	if got[0].GetBlockState(0, 0, 0) != 79 || got[0].BlockCount != 1 {
+		t.Errorf("section 0: block %d, count %d", got[0].GetBlockState(0, 0, 0), got[0].BlockCount)
+	}
+	if got[1].GetBiome(1, 1, 1) != 2 || got[1].Biomes.Format() != ns.PaletteIndirect {
+		t.Errorf("section 1: biome %d (%v)", got[1].GetBiome(1, 1, 1), got[1].Biomes.Format())
+	}
+
+	chunk.Data = chunk.Data[:len(chunk.Data)-1]
+	if _, err := chunk.Sections(testBlockStates, testBiomes); err == nil {
+		t.Error("expected error for truncated section data")
+	}
+}
@@ -206,6 +206,49 @@ func TestChunkData_RoundTrip(t *testing.T) {
 	}
 }
 
+func TestChunkData_HeightmapOrder(t *testing.T) {
+	// heightmaps in non-sorted wire order: 5, 1, 4
+	w := ns.NewWriter()
+	w.WriteVarInt(3)
+	for _, key := range []ns.VarInt{5, 1, 4} {
+		w.WriteVarInt(key)
+		w.WriteLongArray([]int64{int64(key)})
+	}
+	w.WriteByteArray(nil)
+	w.WriteVarInt(0)
+	original := w.Bytes()
+
+	var cd ns.ChunkData
+	if err := cd.Decode(ns.NewReader(original)); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	for range 10 {
+		buf := ns.NewWriter()
+		if err := cd.Encode(buf); err != nil {
+			t.Fatalf("encode error: %v", err)
+		}
+		if !bytes.Equal(buf.Bytes(), original) {
+			t.Fatalf("re-encoding changed the heightmap order:\n got: %x\nwant: %x", buf.Bytes(), original)
+		}
+	}
+
+	// keys without a recorded order are written in ascending order
+	built := ns.ChunkData{Heightmaps: map[int32][]int64{5: {5}, 4: {4}, 1: {1}}}
+	buf := ns.NewWriter()
+	if err := built.Encode(buf); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	r := ns.NewReader(buf.Bytes())
+	r.ReadVarInt()
+	for _, want := range []ns.VarInt{1, 4, 5} {
+		key, _ := r.ReadVarInt()
+		r.ReadLongArray()
+		if key != want {
+			t.Errorf("heightmap key = %d, want %d", key, want)
+		}
+	}
+}
+
 func TestChunkData_WithBlockEntities(t *testing.T) {
 	cd := ns.ChunkData{
 		Heightmaps: map[int32][]int64{},
@@ -52,6 +52,9 @@ func (a *PrefixedArray[T]) DecodeWith(buf *PacketBuffer, decode ElementDecoder[T
 	if length < 0 {
 		return fmt.Errorf("negative array length: %d", length)
 	}
+	if err := buf.checkCount(int(length)); err != nil {
+		return fmt.Errorf("invalid array length: %w", err)
+	}
 
 	*a = make([]T, length)
 	for i := range *a {
@@ -198,6 +201,9 @@ func (b *BitSet) Decode(buf *PacketBuffer) error {
 	if length < 0 {
 		return fmt.Errorf("negative bitset length: %d", length)
 	}
+	if err := buf.checkCount(int(length)); err != nil {
+		return fmt.Errorf("invalid bitset length: %w", err)
+	}
 
 	b.data = make([]int64, length)
 	for i := range b.data {
@@ -256,6 +262,54 @@ func (b *BitSet) Longs() []int64 {
 	return b.data
 }
 
+// Resize grows or shrinks the bit set in place to hold size bits.
+// Bits at or beyond size are cleared.
+func (b *BitSet) Resize(size int) {
+	if size < 0 {
+		size = 0
+	}
+	numLongs := (size + 63) / 64
+	if numLongs <= len(b.data) {
+		b.data = b.data[:numLongs]
+	} else {
+		b.data = append(b.data, make([]int64, numLongs-len(b.data))...)
+	}
+	if rem := size % 64; rem != 0 {
+		b.data[numLongs-1] &= 1<<rem - 1
+	}
+}
+
+// Equal reports whether both bit sets have the same bits set.
+// Trailing zero longs are ignored, so sets of different capacity can be equal.
+func (b *BitSet) Equal(other *BitSet) bool {
+	n := max(len(b.data), len(other.data))
+	for i := range n {
+		var x, y int64
+		if i < len(b.data) {
+			x = b.data[i]
+		}
+		if i < len(other.data) {
+			y = other.data[i]
+		}
+		if x != y {
+			return false
+		}
+	}
+	return true
+}
+
+// ToFixedBitSet converts the bit set to a FixedBitSet of the given size.
+// Bits at or beyond size are dropped.
+func (b *BitSet) ToFixedBitSet(size int) *FixedBitSet {
+	f := NewFixedBitSet(size)
+	for i := range min(size, len(b.data)*64) {
+		if b.Get(i) {
+			f.Set(i)
+		}
+	}
+	return f
+}
+
 // -----------------------------------------------------------------------------
 // Fixed BitSet
 // -----------------------------------------------------------------------------
@@ -337,6 +391,35 @@ func (b *FixedBitSet) Bytes() []byte {
 	return b.data
 }
 
+// Resize grows or shrinks the bit set in place to hold size bits.
+// Bits at or beyond size are cleared.
+func (b *FixedBitSet) Resize(size int) {
+	if size < 0 {
+		size = 0
+	}
+	numBytes := (size + 7) / 8
+	if numBytes <= len(b.data) {
+		b.data = b.data[:numBytes]
+	} else {
+		b.data = append(b.data, make([]byte, numBytes-len(b.data))...)
+	}
+	if rem := size % 8; rem != 0 {
+		b.data[numBytes-1] &= 1<<rem - 1
+	}
+	b.size = size
+}
+
+// ToBitSet converts the fixed bit set to a dynamically-sized BitSet.
+func (b *FixedBitSet) ToBitSet() *BitSet {
+	bs := NewBitSet(b.size)
+	for i := range b.size {
+		if b.Get(i) {
+			bs.Set(i)
+		}
+	}
+	return bs
+}
+
 // -----------------------------------------------------------------------------
 // ID Set
 // -----------------------------------------------------------------------------
@@ -388,7 +471,10 @@ func (s *IDSet) Decode(buf *PacketBuffer) error {
 	} else {
 		// inline IDs
 		s.IsTag = false
-		count := int(typeVal - 1)
+		count := int(typeVal) - 1
+		if err := buf.checkCount(count); err != nil {
+			return fmt.Errorf("invalid id set length: %w", err)
+		}
 		s.IDs = make([]VarInt, count)
 		for i := range s.IDs {
 			s.IDs[i], err = buf.ReadVarInt()
@@ -572,3 +658,59 @@ func (x IDOrX[T]) Get() (id VarInt, value T, isInline bool) {
 	}
 	return x.ID, x.Value, false
 }
+
+// -----------------------------------------------------------------------------
+// Filterable (Raw + Optional Filtered)
+// -----------------------------------------------------------------------------
+
+// Filterable holds a raw value and an optional chat-filtered version of it.
+// Used for book titles and pages. Clients with text filtering enabled show the
+// filtered value if present, everyone else sees the raw one.
+//
+// Wire format:
+//
+//	┌─────────────┬──────────────────────┬────────────────────────────────┐
+//	│  Raw (T)    │  Has Filtered (Bool) │  Filtered (T, if present)      │
+//	└─────────────┴──────────────────────┴────────────────────────────────┘
+type Filterable[T any] struct {
+	Raw      T
+	Filtered PrefixedOptional[T]
+}
+
+// NewFilterable creates a Filterable without a filtered value.
+func NewFilterable[T any](raw T) Filterable[T] {
+	return Filterable[T]{Raw: raw}
+}
+
+// DecodeWith reads a Filterable using the provided decoder for both values.
+func (f *Filterable[T]) DecodeWith(buf *PacketBuffer, decode ElementDecoder[T]) error {
+	var err error
+	f.Raw, err = decode(buf)
+	if err != nil {
+		return fmt.Errorf("failed to read filterable raw value: %w", err)
+	}
+	if err := f.Filtered.DecodeWith(buf, decode); err != nil {
+		return fmt.Errorf("failed to read filterable filtered value: %w", err)
+	}
+	return nil
+}
+
+// EncodeWith writes a Filterable using the provided encoder for both values.
+func (f Filterable[T]) EncodeWith(buf *PacketBuffer, encode ElementEncoder[T]) error {
+	if err := encode(buf, f.Raw); err != nil {
+		return fmt.Errorf("failed to write filterable raw value: %w", err)
+	}
+	if err := f.Filtered.EncodeWith(buf, encode); err != nil {
+		return fmt.Errorf("failed to write filterable filtered value: %w", err)
+	}
+	return nil
+}
+
+// Get returns the filtered value if filtering is requested and one is present,
+// otherwise the raw value.
+func (f Filterable[T]) Get(filter bool) T {
+	if filter && f.Filtered.Present {
+		return f.Filtered.Value
+	}
+	return f.Raw
+}
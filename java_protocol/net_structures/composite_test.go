@@ -184,6 +184,83 @@ func TestFixedBitSet(t *testing.T) {
 	}
 }
 
+func TestBitSet_Resize(t *testing.T) {
+	bs := ns.NewBitSet(0)
+	bs.Set(3)
+	bs.Set(70)
+	bs.Set(100)
+
+	bs.Resize(71)
+	if len(bs.Longs()) != 2 {
+		t.Fatalf("expected 2 longs, got %d", len(bs.Longs()))
+	}
+	if !bs.Get(3) || !bs.Get(70) || bs.Get(100) {
+		t.Error("resize should keep bits below size and drop the rest")
+	}
+
+	bs.Resize(200)
+	if len(bs.Longs()) != 4 || bs.Get(100) {
+		t.Error("growing should add zeroed longs")
+	}
+}
+
+func TestBitSet_Equal(t *testing.T) {
+	a := ns.NewBitSet(64)
+	b := ns.NewBitSet(256)
+	a.Set(5)
+	b.Set(5)
+	if !a.Equal(b) || !b.Equal(a) {
+		t.Error("sets with the same bits should be equal regardless of capacity")
+	}
+	b.Set(130)
+	if a.Equal(b) {
+		t.Error("sets with different bits should not be equal")
+	}
+}
+
+func TestBitSet_FixedConversion(t *testing.T) {
+	// light masks: world height 384 = 24 sections + 2 = 26 bits
+	bs := ns.NewBitSet(0)
+	for _, i := range []int{0, 7, 8, 25, 40} {
+		bs.Set(i)
+	}
+
+	fixed := bs.ToFixedBitSet(26)
+	if fixed.Size() != 26 || len(fixed.Bytes()) != 4 {
+		t.Fatalf("unexpected fixed bitset shape: size=%d bytes=%d", fixed.Size(), len(fixed.Bytes()))
+	}
+	for _, i := range []int{0, 7, 8, 25} {
+		if !fixed.Get(i) {
+			t.Errorf("bit %d should be set", i)
+		}
+	}
+
+	back := fixed.ToBitSet()
+	bs.Clear(40)
+	if !back.Equal(bs) {
+		t.Errorf("round trip mismatch: got %x, want %x", back.Longs(), bs.Longs())
+	}
+}
+
+func TestFixedBitSet_Resize(t *testing.T) {
+	fbs := ns.NewFixedBitSet(16)
+	fbs.Set(2)
+	fbs.Set(11)
+
+	fbs.Resize(10)
+	if fbs.Size() != 10 || len(fbs.Bytes()) != 2 {
+		t.Fatalf("unexpected shape: size=%d bytes=%d", fbs.Size(), len(fbs.Bytes()))
+	}
+	if !fbs.Get(2) || fbs.Get(11) {
+		t.Error("resize should keep bits below size and drop the rest")
+	}
+
+	fbs.Resize(16)
+	if fbs.Get(11) {
+		t.Error("bits dropped by shrinking should stay cleared after growing")
+	}
+}
+
 // IDSet wire format:
 //   VarInt type (0 = tag, >0 = inline count + 1)
 //   if type=0: Identifier (tag name)
@@ -0,0 +1,524 @@
+package net_structures
+
+import "fmt"
+
+// EntityDataType is the name of an entity data serializer, the type of a single
+// entity metadata value.
+type EntityDataType string
+
+// Entity data serializer names, following the fields of vanilla's
+// EntityDataSerializers. The Go type of each value is noted.
+const (
+	EntityDataByte                          EntityDataType = "byte"                             // Int8
+	EntityDataInt                           EntityDataType = "int"                              // VarInt
+	EntityDataLong                          EntityDataType = "long"                             // VarLong
+	EntityDataFloat                         EntityDataType = "float"                            // Float32
+	EntityDataString                        EntityDataType = "string"                           // String
+	EntityDataComponent                     EntityDataType = "component"                        // TextComponent
+	EntityDataOptionalComponent             EntityDataType = "optional_component"               // PrefixedOptional[TextComponent]
+	EntityDataItemStack                     EntityDataType = "item_stack"                       // Slot
+	EntityDataBoolean                       EntityDataType = "boolean"                          // Boolean
+	EntityDataRotations                     EntityDataType = "rotations"                        // Vector3f (degrees)
+	EntityDataBlockPos                      EntityDataType = "block_pos"                        // Position
+	EntityDataOptionalBlockPos              EntityDataType = "optional_block_pos"               // PrefixedOptional[Position]
+	EntityDataDirection                     EntityDataType = "direction"                        // Direction
+	EntityDataOptionalLivingEntityReference EntityDataType = "optional_living_entity_reference" // PrefixedOptional[UUID]
+	EntityDataBlockState                    EntityDataType = "block_state"                      // VarInt
+	EntityDataOptionalBlockState            EntityDataType = "optional_block_state"             // VarInt (0 = absent)
+	EntityDataParticle                      EntityDataType = "particle"                         // Particle
+	EntityDataParticles                     EntityDataType = "particles"                        // []Particle
+	EntityDataVillagerData                  EntityDataType = "villager_data"                    // VillagerData
+	EntityDataOptionalUnsignedInt           EntityDataType = "optional_unsigned_int"            // PrefixedOptional[VarInt]
+	EntityDataPose                          EntityDataType = "pose"                             // Pose
+	EntityDataCatVariant                    EntityDataType = "cat_variant"                      // VarInt
+	EntityDataCowVariant                    EntityDataType = "cow_variant"                      // VarInt
+	EntityDataWolfVariant                   EntityDataType = "wolf_variant"                     // VarInt
+	EntityDataWolfSoundVariant              EntityDataType = "wolf_sound_variant"               // VarInt
+	EntityDataFrogVariant                   EntityDataType = "frog_variant"                     // VarInt
+	EntityDataPigVariant                    EntityDataType = "pig_variant"                      // VarInt
+	EntityDataChickenVariant                EntityDataType = "chicken_variant"                  // VarInt
+	EntityDataZombieNautilusVariant         EntityDataType = "zombie_nautilus_variant"          // VarInt
+	EntityDataOptionalGlobalPos             EntityDataType = "optional_global_pos"              // PrefixedOptional[GlobalPos]
+	EntityDataPaintingVariant               EntityDataType = "painting_variant"                 // IDOrX[PaintingVariant]
+	EntityDataSnifferState                  EntityDataType = "sniffer_state"                    // VarInt
+	EntityDataArmadilloState                EntityDataType = "armadillo_state"                  // VarInt
+	EntityDataCopperGolemState              EntityDataType = "copper_golem_state"               // VarInt
+	EntityDataWeatheringCopperState         EntityDataType = "weathering_copper_state"          // VarInt
+	EntityDataVector3                       EntityDataType = "vector3"                          // Vector3f
+	EntityDataQuaternion                    EntityDataType = "quaternion"                       // Quaternionf
+	EntityDataResolvableProfile             EntityDataType = "resolvable_profile"               // ResolvableProfile
+)
+
+// EntityDataTypes lists the entity data serializer names in ID order, i.e.
+// EntityDataTypes[id] is the name of serializer id. Serializer IDs are assigned
+// in registration order and change between versions; the list for the targeted
+// version comes from go-mclib/data.
+type EntityDataTypes []EntityDataType
+
+// Name returns the name of the serializer with the given ID.
+func (t EntityDataTypes) Name(id VarInt) (EntityDataType, bool) {
+	if id < 0 || int(id) >= len(t) {
+		return "", false
+	}
+	return t[id], true
+}
+
+// ID returns the ID of the named serializer.
+func (t EntityDataTypes) ID(name EntityDataType) (VarInt, bool) {
+	for i, n := range t {
+		if n == name {
+			return VarInt(i), true
+		}
+	}
+	return 0, false
+}
+
+// EntityMetadataRegistries holds the version-specific lists entity metadata
+// depends on: serializer IDs, particle type IDs (particle values) and data
+// component type IDs (components of item stack values).
+type EntityMetadataRegistries struct {
+	DataTypes  EntityDataTypes
+	Particles  ParticleTypes
+	Components SlotComponentTypes
+}
+
+// Pose is the pose of an entity.
+type Pose VarInt
+
+const (
+	PoseStanding    Pose = 0
+	PoseFallFlying  Pose = 1
+	PoseSleeping    Pose = 2
+	PoseSwimming    Pose = 3
+	PoseSpinAttack  Pose = 4
+	PoseCrouching   Pose = 5
+	PoseLongJumping Pose = 6
+	PoseDying       Pose = 7
+	PoseCroaking    Pose = 8
+	PoseUsingTongue Pose = 9
+	PoseSitting     Pose = 10
+	PoseRoaring     Pose = 11
+	PoseSniffing    Pose = 12
+	PoseEmerging    Pose = 13
+	PoseDigging     Pose = 14
+	PoseSliding     Pose = 15
+	PoseShooting    Pose = 16
+	PoseInhaling    Pose = 17
+)
+
+var poseNames = EnumNames{
+	int32(PoseStanding):    "standing",
+	int32(PoseFallFlying):  "fall_flying",
+	int32(PoseSleeping):    "sleeping",
+	int32(PoseSwimming):    "swimming",
+	int32(PoseSpinAttack):  "spin_attack",
+	int32(PoseCrouching):   "crouching",
+	int32(PoseLongJumping): "long_jumping",
+	int32(PoseDying):       "dying",
+	int32(PoseCroaking):    "croaking",
+	int32(PoseUsingTongue): "using_tongue",
+	int32(PoseSitting):     "sitting",
+	int32(PoseRoaring):     "roaring",
+	int32(PoseSniffing):    "sniffing",
+	int32(PoseEmerging):    "emerging",
+	int32(PoseDigging):     "digging",
+	int32(PoseSliding):     "sliding",
+	int32(PoseShooting):    "shooting",
+	int32(PoseInhaling):    "inhaling",
+}
+
+func (p Pose) String() string {
+	return poseNames.Name(int32(p))
+}
+
+// VillagerData is the type, profession and level of a villager or zombie villager.
+//
+// Wire format:
+//
+//	┌──────────────────┬────────────────────────┬──────────────────┐
+//	│  Type (VarInt)   │  Profession (VarInt)   │  Level (VarInt)  │
+//	└──────────────────┴────────────────────────┴──────────────────┘
+//
+// Type and profession are registry IDs from minecraft:villager_type and
+// minecraft:villager_profession.
+type VillagerData struct {
+	Type       VarInt
+	Profession VarInt
+	Level      VarInt
+}
+
+// Decode reads VillagerData from the buffer.
+func (v *VillagerData) Decode(buf *PacketBuffer) error {
+	var err error
+	if v.Type, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read villager type: %w", err)
+	}
+	if v.Profession, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read villager profession: %w", err)
+	}
+	if v.Level, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read villager level: %w", err)
+	}
+	return nil
+}
+
+// Encode writes VillagerData to the buffer.
+func (v *VillagerData) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(v.Type); err != nil {
+		return fmt.Errorf("failed to write villager type: %w", err)
+	}
+	if err := buf.WriteVarInt(v.Profession); err != nil {
+		return fmt.Errorf("failed to write villager profession: %w", err)
+	}
+	if err := buf.WriteVarInt(v.Level); err != nil {
+		return fmt.Errorf("failed to write villager level: %w", err)
+	}
+	return nil
+}
+
+// EntityMetadataEntry is a single entity metadata value. The Go type of Value
+// depends on Type (see the EntityData* constants).
+type EntityMetadataEntry struct {
+	Index Uint8
+	Type  EntityDataType
+	Value any
+}
+
+// EntityMetadata is the list of changed entity data values sent in the Set
+// Entity Metadata packet.
+//
+// Wire format:
+//
+//	┌────────────────────────┬──────────────────┬───────────────────────────┐
+//	│  Index (Unsigned Byte) │  Type (VarInt)   │  Value (depends on Type)  │
+//	├────────────────────────┴──────────────────┴───────────────────────────┤
+//	│  ... repeated, terminated by Index 0xFF                               │
+//	└───────────────────────────────────────────────────────────────────────┘
+//
+// The meaning of each index depends on the entity type and its superclasses
+// (e.g. index 9 of a living entity is its health).
+type EntityMetadata struct {
+	Entries []EntityMetadataEntry
+}
+
+// EntityMetadataEnd is the index that terminates entity metadata.
+const EntityMetadataEnd = 0xFF
+
+// Get returns the entry with the given index.
+func (m *EntityMetadata) Get(index Uint8) (EntityMetadataEntry, bool) {
+	for _, e := range m.Entries {
+		if e.Index == index {
+			return e, true
+		}
+	}
+	return EntityMetadataEntry{}, false
+}
+
+// EntityMetadataValue returns the value with the given index if it is present
+// and of type T.
+func EntityMetadataValue[T any](m *EntityMetadata, index Uint8) (T, bool) {
+	e, ok := m.Get(index)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	v, ok := e.Value.(T)
+	return v, ok
+}
+
+// Set sets (or replaces) the value with the given index and returns m, so
+// calls can be chained. The Go type of value must match typ.
+func (m *EntityMetadata) Set(index Uint8, typ EntityDataType, value any) *EntityMetadata {
+	for i := range m.Entries {
+		if m.Entries[i].Index == index {
+			m.Entries[i] = EntityMetadataEntry{Index: index, Type: typ, Value: value}
+			return m
+		}
+	}
+	m.Entries = append(m.Entries, EntityMetadataEntry{Index: index, Type: typ, Value: value})
+	return m
+}
+
+// SetByte sets a byte value (e.g. entity flags at index 0).
+func (m *EntityMetadata) SetByte(index Uint8, v Int8) *EntityMetadata {
+	return m.Set(index, EntityDataByte, v)
+}
+
+// SetVarInt sets an int value.
+func (m *EntityMetadata) SetVarInt(index Uint8, v VarInt) *EntityMetadata {
+	return m.Set(index, EntityDataInt, v)
+}
+
+// SetFloat sets a float value (e.g. health at index 9 of living entities).
+func (m *EntityMetadata) SetFloat(index Uint8, v Float32) *EntityMetadata {
+	return m.Set(index, EntityDataFloat, v)
+}
+
+// SetString sets a string value.
+func (m *EntityMetadata) SetString(index Uint8, v String) *EntityMetadata {
+	return m.Set(index, EntityDataString, v)
+}
+
+// SetBool sets a boolean value.
+func (m *EntityMetadata) SetBool(index Uint8, v Boolean) *EntityMetadata {
+	return m.Set(index, EntityDataBoolean, v)
+}
+
+// SetTextComponent sets a text component value.
+func (m *EntityMetadata) SetTextComponent(index Uint8, v TextComponent) *EntityMetadata {
+	return m.Set(index, EntityDataComponent, v)
+}
+
+// SetOptionalTextComponent sets an optional text component value (e.g. the
+// custom name at index 2).
+func (m *EntityMetadata) SetOptionalTextComponent(index Uint8, v PrefixedOptional[TextComponent]) *EntityMetadata {
+	return m.Set(index, EntityDataOptionalComponent, v)
+}
+
+// SetSlot sets an item stack value.
+func (m *EntityMetadata) SetSlot(index Uint8, v Slot) *EntityMetadata {
+	return m.Set(index, EntityDataItemStack, v)
+}
+
+// SetPose sets a pose value (index 6).
+func (m *EntityMetadata) SetPose(index Uint8, v Pose) *EntityMetadata {
+	return m.Set(index, EntityDataPose, v)
+}
+
+// DecodeWith reads EntityMetadata, resolving serializer, particle type and
+// component type IDs through reg.
+func (m *EntityMetadata) DecodeWith(buf *PacketBuffer, reg EntityMetadataRegistries) error {
+	m.Entries = m.Entries[:0]
+	for {
+		index, err := buf.ReadUint8()
+		if err != nil {
+			return fmt.Errorf("failed to read metadata index: %w", err)
+		}
+		if index == EntityMetadataEnd {
+			return nil
+		}
+		id, err := buf.ReadVarInt()
+		if err != nil {
+			return fmt.Errorf("failed to read metadata %d type: %w", index, err)
+		}
+		typ, ok := reg.DataTypes.Name(id)
+		if !ok {
+			return fmt.Errorf("unknown metadata %d type id: %d", index, id)
+		}
+		codec, ok := entityDataCodecs[typ]
+		if !ok {
+			return fmt.Errorf("unsupported metadata %d type: %s", index, typ)
+		}
+		value, err := codec.decode(buf, reg)
+		if err != nil {
+			return fmt.Errorf("failed to read metadata %d (%s): %w", index, typ, err)
+		}
+		m.Entries = append(m.Entries, EntityMetadataEntry{Index: index, Type: typ, Value: value})
+	}
+}
+
+// EncodeWith writes EntityMetadata, resolving serializer IDs through reg.
+func (m *EntityMetadata) EncodeWith(buf *PacketBuffer, reg EntityMetadataRegistries) error {
+	for _, e := range m.Entries {
+		if e.Index == EntityMetadataEnd {
+			return fmt.Errorf("metadata index %d is reserved", EntityMetadataEnd)
+		}
+		id, ok := reg.DataTypes.ID(e.Type)
+		if !ok {
+			return fmt.Errorf("unknown metadata %d type: %s", e.Index, e.Type)
+		}
+		codec, ok := entityDataCodecs[e.Type]
+		if !ok {
+			return fmt.Errorf("unsupported metadata %d type: %s", e.Index, e.Type)
+		}
+		if err := buf.WriteUint8(e.Index); err != nil {
+			return fmt.Errorf("failed to write metadata index: %w", err)
+		}
+		if err := buf.WriteVarInt(id); err != nil {
+			return fmt.Errorf("failed to write metadata %d type: %w", e.Index, err)
+		}
+		if err := codec.encode(buf, e.Value); err != nil {
+			return fmt.Errorf("failed to write metadata %d (%s): %w", e.Index, e.Type, err)
+		}
+	}
+	if err := buf.WriteUint8(EntityMetadataEnd); err != nil {
+		return fmt.Errorf("failed to write metadata end: %w", err)
+	}
+	return nil
+}
+
+// entityDataCodec reads and writes the values of one serializer.
+type entityDataCodec struct {
+	decode func(buf *PacketBuffer, reg EntityMetadataRegistries) (any, error)
+	encode func(buf *PacketBuffer, v any) error
+}
+
+// valueCodec returns the codec of a serializer whose values need no registries.
+func valueCodec[T any](read ElementDecoder[T], write ElementEncoder[T]) entityDataCodec {
+	return entityDataCodec{
+		decode: func(buf *PacketBuffer, _ EntityMetadataRegistries) (any, error) {
+			return read(buf)
+		},
+		encode: encodeValue(write),
+	}
+}
+
+// encodeValue checks that a value has the Go type of its serializer before writing it.
+func encodeValue[T any](write ElementEncoder[T]) func(buf *PacketBuffer, v any) error {
+	return func(buf *PacketBuffer, v any) error {
+		t, ok := v.(T)
+		if !ok {
+			var zero T
+			return fmt.Errorf("expected %T value, got %T", zero, v)
+		}
+		return write(buf, t)
+	}
+}
+
+// structCodec returns the codec of a serializer whose values are structs with
+// pointer Decode and Encode methods.
+func structCodec[T any, PT interface {
+	*T
+	Decode(*PacketBuffer) error
+	Encode(*PacketBuffer) error
+}]() entityDataCodec {
+	return valueCodec(func(buf *PacketBuffer) (T, error) {
+		var v T
+		err := PT(&v).Decode(buf)
+		return v, err
+	}, func(buf *PacketBuffer, v T) error {
+		return PT(&v).Encode(buf)
+	})
+}
+
+func optionalCodec[T any](read ElementDecoder[T], write ElementEncoder[T]) entityDataCodec {
+	return valueCodec(func(buf *PacketBuffer) (PrefixedOptional[T], error) {
+		var o PrefixedOptional[T]
+		err := o.DecodeWith(buf, read)
+		return o, err
+	}, func(buf *PacketBuffer, o PrefixedOptional[T]) error {
+		return o.EncodeWith(buf, write)
+	})
+}
+
+var varIntCodec = valueCodec((*PacketBuffer).ReadVarInt, (*PacketBuffer).WriteVarInt)
+
+var entityDataCodecs = map[EntityDataType]entityDataCodec{
+	EntityDataByte:                          valueCodec((*PacketBuffer).ReadInt8, (*PacketBuffer).WriteInt8),
+	EntityDataInt:                           varIntCodec,
+	EntityDataLong:                          valueCodec((*PacketBuffer).ReadVarLong, (*PacketBuffer).WriteVarLong),
+	EntityDataFloat:                         valueCodec((*PacketBuffer).ReadFloat32, (*PacketBuffer).WriteFloat32),
+	EntityDataString:                        valueCodec(readMetadataString, (*PacketBuffer).WriteString),
+	EntityDataComponent:                     valueCodec((*PacketBuffer).ReadTextComponent, (*PacketBuffer).WriteTextComponent),
+	EntityDataOptionalComponent:             optionalCodec((*PacketBuffer).ReadTextComponent, (*PacketBuffer).WriteTextComponent),
+	EntityDataItemStack:                     {decode: decodeMetadataSlot, encode: encodeValue((*PacketBuffer).WriteSlot)},
+	EntityDataBoolean:                       valueCodec((*PacketBuffer).ReadBool, (*PacketBuffer).WriteBool),
+	EntityDataRotations:                     structCodec[Vector3f](),
+	EntityDataBlockPos:                      valueCodec((*PacketBuffer).ReadPosition, (*PacketBuffer).WritePosition),
+	EntityDataOptionalBlockPos:              optionalCodec((*PacketBuffer).ReadPosition, (*PacketBuffer).WritePosition),
+	EntityDataDirection:                     valueCodec((*PacketBuffer).ReadDirection, (*PacketBuffer).WriteDirection),
+	EntityDataOptionalLivingEntityReference: optionalCodec((*PacketBuffer).ReadUUID, (*PacketBuffer).WriteUUID),
+	EntityDataBlockState:                    varIntCodec,
+	EntityDataOptionalBlockState:            varIntCodec,
+	EntityDataParticle:                      {decode: decodeMetadataParticle, encode: encodeValue(writeMetadataParticle)},
+	EntityDataParticles:                     {decode: decodeMetadataParticles, encode: encodeValue(writeMetadataParticles)},
+	EntityDataVillagerData:                  structCodec[VillagerData](),
+	EntityDataOptionalUnsignedInt:           valueCodec(readOptionalUnsignedInt, writeOptionalUnsignedInt),
+	EntityDataPose:                          valueCodec(readPose, writePose),
+	EntityDataCatVariant:                    varIntCodec,
+	EntityDataCowVariant:                    varIntCodec,
+	EntityDataWolfVariant:                   varIntCodec,
+	EntityDataWolfSoundVariant:              varIntCodec,
+	EntityDataFrogVariant:                   varIntCodec,
+	EntityDataPigVariant:                    varIntCodec,
+	EntityDataChickenVariant:                varIntCodec,
+	EntityDataZombieNautilusVariant:         varIntCodec,
+	EntityDataOptionalGlobalPos:             valueCodec((*PacketBuffer).ReadOptionalGlobalPos, (*PacketBuffer).WriteOptionalGlobalPos),
+	EntityDataPaintingVariant:               valueCodec(readPaintingVariantHolder, writePaintingVariantHolder),
+	EntityDataSnifferState:                  varIntCodec,
+	EntityDataArmadilloState:                varIntCodec,
+	EntityDataCopperGolemState:              varIntCodec,
+	EntityDataWeatheringCopperState:         varIntCodec,
+	EntityDataVector3:                       structCodec[Vector3f](),
+	EntityDataQuaternion:                    structCodec[Quaternionf](),
+	EntityDataResolvableProfile:             structCodec[ResolvableProfile](),
+}
+
+func readMetadataString(buf *PacketBuffer) (String, error) {
+	return buf.ReadString(32767)
+}
+
+func decodeMetadataSlot(buf *PacketBuffer, reg EntityMetadataRegistries) (any, error) {
+	return buf.ReadSlot(reg.Components.Decoder())
+}
+
+func decodeMetadataParticle(buf *PacketBuffer, reg EntityMetadataRegistries) (any, error) {
+	var p Particle
+	err := p.DecodeWith(buf, reg.Particles, reg.Components.Decoder())
+	return p, err
+}
+
+func decodeMetadataParticles(buf *PacketBuffer, reg EntityMetadataRegistries) (any, error) {
+	count, err := buf.ReadCount()
+	if err != nil {
+		return nil, fmt.Errorf("invalid particle count: %w", err)
+	}
+	particles := make([]Particle, count)
+	for i := range particles {
+		if err := particles[i].DecodeWith(buf, reg.Particles, reg.Components.Decoder()); err != nil {
+			return nil, fmt.Errorf("failed to read particle %d: %w", i, err)
+		}
+	}
+	return particles, nil
+}
+
+func writeMetadataParticle(buf *PacketBuffer, p Particle) error {
+	return p.Encode(buf)
+}
+
+func writeMetadataParticles(buf *PacketBuffer, particles []Particle) error {
+	if err := buf.WriteVarInt(VarInt(len(particles))); err != nil {
+		return fmt.Errorf("failed to write particle count: %w", err)
+	}
+	for i := range particles {
+		if err := particles[i].Encode(buf); err != nil {
+			return fmt.Errorf("failed to write particle %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// readOptionalUnsignedInt reads a VarInt where 0 means absent and n means n-1.
+func readOptionalUnsignedInt(buf *PacketBuffer) (PrefixedOptional[VarInt], error) {
+	v, err := buf.ReadVarInt()
+	if err != nil || v == 0 {
+		return None[VarInt](), err
+	}
+	return Some(v - 1), nil
+}
+
+func writeOptionalUnsignedInt(buf *PacketBuffer, v PrefixedOptional[VarInt]) error {
+	if !v.Present {
+		return buf.WriteVarInt(0)
+	}
+	return buf.WriteVarInt(v.Value + 1)
+}
+
+func readPose(buf *PacketBuffer) (Pose, error) {
+	v, err := buf.ReadVarInt()
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := poseNames[int32(v)]; !ok {
+		return 0, InvalidEnumError("pose", int32(v))
+	}
+	return Pose(v), nil
+}
+
+func writePose(buf *PacketBuffer, p Pose) error {
+	return buf.WriteVarInt(VarInt(p))
+}
+
+func init() {
+	RegisterEnumNames("pose", poseNames)
+}
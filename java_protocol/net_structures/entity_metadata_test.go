@@ -0,0 +1,113 @@
+package net_structures_test
+
+import (
+	"strings"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// testMetadataRegistries uses a made-up serializer order for tests.
+var testMetadataRegistries = ns.EntityMetadataRegistries{
+	DataTypes: ns.EntityDataTypes{
+		ns.EntityDataByte,
+		ns.EntityDataInt,
+		ns.EntityDataFloat,
+		ns.EntityDataOptionalComponent,
+		ns.EntityDataItemStack,
+		ns.EntityDataBoolean,
+		ns.EntityDataPose,
+		ns.EntityDataOptionalUnsignedInt,
+		ns.EntityDataParticles,
+		ns.EntityDataVillagerData,
+		ns.EntityDataQuaternion,
+	},
+	Particles:  testParticleTypes,
+	Components: containerComponentTypes,
+}
+
+func TestEntityMetadata_RoundTrip(t *testing.T) {
+	var in ns.EntityMetadata
+	in.SetByte(0, 0x02).
+		SetOptionalTextComponent(2, ns.Some(ns.NewTextComponent("Steve"))).
+		SetPose(6, ns.PoseCrouching).
+		SetFloat(9, 20).
+		SetSlot(8, ns.NewSlot(5, 1)).
+		Set(10, ns.EntityDataOptionalUnsignedInt, ns.Some[ns.VarInt](0)).
+		Set(11, ns.EntityDataOptionalUnsignedInt, ns.None[ns.VarInt]()).
+		Set(12, ns.EntityDataParticles, []ns.Particle{{Type: 0}}).
+		Set(18, ns.EntityDataVillagerData, ns.VillagerData{Type: 1, Profession: 5, Level: 2}).
+		Set(13, ns.EntityDataQuaternion, ns.Quaternionf{W: 1})
+
+	buf := ns.NewBuffer()
+	if err := in.EncodeWith(buf, testMetadataRegistries); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	var out ns.EntityMetadata
+	if err := out.DecodeWith(buf, testMetadataRegistries); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if len(out.Entries) != len(in.Entries) {
+		t.Fatalf("got %d entries, want %d", len(out.Entries), len(in.Entries))
+	}
+
+	if health, ok := ns.EntityMetadataValue[ns.Float32](&out, 9); !ok || health != 20 {
+		t.Errorf("health = %v, %v", health, ok)
+	}
+	if pose, ok := ns.EntityMetadataValue[ns.Pose](&out, 6); !ok || pose != ns.PoseCrouching {
+		t.Errorf("pose = %v, %v", pose, ok)
+	}
+	name, ok := ns.EntityMetadataValue[ns.PrefixedOptional[ns.TextComponent]](&out, 2)
+	if text, present := name.Get(); !ok || !present || text.Text != "Steve" {
+		t.Errorf("custom name = %+v, %v", name, ok)
+	}
+	if v, _ := ns.EntityMetadataValue[ns.PrefixedOptional[ns.VarInt]](&out, 10); !v.Present || v.Value != 0 {
+		t.Errorf("optional unsigned int = %+v, want Some(0)", v)
+	}
+	if v, _ := ns.EntityMetadataValue[ns.PrefixedOptional[ns.VarInt]](&out, 11); v.Present {
+		t.Errorf("optional unsigned int = %+v, want None", v)
+	}
+	if v, _ := ns.EntityMetadataValue[ns.VillagerData](&out, 18); v.Profession != 5 {
+		t.Errorf("villager data = %+v", v)
+	}
+	if _, ok := ns.EntityMetadataValue[ns.VarInt](&out, 9); ok {
+		t.Error("expected type mismatch for health as VarInt")
+	}
+	if _, ok := out.Get(1); ok {
+		t.Error("expected missing index 1")
+	}
+}
+
+func TestEntityMetadata_Set_Replaces(t *testing.T) {
+	var m ns.EntityMetadata
+	m.SetFloat(9, 20).SetFloat(9, 5)
+	if len(m.Entries) != 1 || m.Entries[0].Value != ns.Float32(5) {
+		t.Errorf("unexpected entries: %+v", m.Entries)
+	}
+}
+
+func TestEntityMetadata_Errors(t *testing.T) {
+	var m ns.EntityMetadata
+	m.Set(0, ns.EntityDataFloat, ns.VarInt(1))
+	if err := m.EncodeWith(ns.NewWriter(), testMetadataRegistries); err == nil || !strings.Contains(err.Error(), "expected") {
+		t.Errorf("expected type mismatch error, got: %v", err)
+	}
+
+	m = ns.EntityMetadata{}
+	m.Set(0, ns.EntityDataVector3, ns.Vector3f{})
+	if err := m.EncodeWith(ns.NewWriter(), testMetadataRegistries); err == nil || !strings.Contains(err.Error(), "unknown metadata 0 type") {
+		t.Errorf("expected unknown type error, got: %v", err)
+	}
+
+	for name, data := range map[string][]byte{
+		"unknown type id":  {0x00, 0x7F},
+		"invalid pose":     {0x06, 0x06, 0x7F},
+		"missing end":      {0x00, 0x00, 0x01},
+		"truncated header": {},
+	} {
+		var out ns.EntityMetadata
+		if err := out.DecodeWith(ns.NewReader(data), testMetadataRegistries); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
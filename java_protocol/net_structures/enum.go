@@ -0,0 +1,90 @@
+package net_structures
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// EnumNames maps the numeric values of a protocol enum to their vanilla names.
+// Used to make debug output and decode errors readable (e.g. "creative"
+// instead of "1").
+type EnumNames map[int32]string
+
+// Name returns the name of v, or its decimal value if no name is known.
+func (n EnumNames) Name(v int32) string {
+	if name, ok := n[v]; ok {
+		return name
+	}
+	return strconv.Itoa(int(v))
+}
+
+// Format returns "name (v)" if the name is known, otherwise "unknown (v)".
+func (n EnumNames) Format(v int32) string {
+	if name, ok := n[v]; ok {
+		return fmt.Sprintf("%s (%d)", name, v)
+	}
+	return fmt.Sprintf("unknown (%d)", v)
+}
+
+// Valid returns the known values as "v=name" pairs sorted by value,
+// e.g. "0=survival, 1=creative".
+func (n EnumNames) Valid() string {
+	values := make([]int32, 0, len(n))
+	for v := range n {
+		values = append(values, v)
+	}
+	slices.Sort(values)
+
+	var sb strings.Builder
+	for i, v := range values {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%d=%s", v, n[v])
+	}
+	return sb.String()
+}
+
+var (
+	enumNamesMu sync.RWMutex
+	enumNames   = map[string]EnumNames{}
+)
+
+// RegisterEnumNames registers (or replaces) the names for an enum kind.
+// Kinds are free-form human readable keys such as "game mode" or "entity animation";
+// higher-level packages can register enums that are not defined here.
+func RegisterEnumNames(kind string, names EnumNames) {
+	enumNamesMu.Lock()
+	defer enumNamesMu.Unlock()
+	enumNames[kind] = names
+}
+
+// LookupEnumNames returns the registered names for an enum kind.
+func LookupEnumNames(kind string) (EnumNames, bool) {
+	enumNamesMu.RLock()
+	defer enumNamesMu.RUnlock()
+	names, ok := enumNames[kind]
+	return names, ok
+}
+
+// EnumName returns the registered name of v for the given kind,
+// or its decimal value if the kind or value is unknown.
+func EnumName(kind string, v int32) string {
+	names, _ := LookupEnumNames(kind)
+	return names.Name(v)
+}
+
+// InvalidEnumError returns an error describing an invalid enum value, listing
+// the valid values if the kind is registered:
+//
+//	invalid game mode: 7 (valid: 0=survival, 1=creative, 2=adventure, 3=spectator)
+func InvalidEnumError(kind string, v int32) error {
+	names, ok := LookupEnumNames(kind)
+	if !ok || len(names) == 0 {
+		return fmt.Errorf("invalid %s: %d", kind, v)
+	}
+	return fmt.Errorf("invalid %s: %d (valid: %s)", kind, v, names.Valid())
+}
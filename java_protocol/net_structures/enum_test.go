@@ -0,0 +1,56 @@
+package net_structures_test
+
+import (
+	"strings"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestEnumNames(t *testing.T) {
+	names := ns.EnumNames{0: "survival", 1: "creative"}
+
+	if got := names.Name(1); got != "creative" {
+		t.Errorf("Name(1) = %q, want %q", got, "creative")
+	}
+	if got := names.Name(9); got != "9" {
+		t.Errorf("Name(9) = %q, want %q", got, "9")
+	}
+	if got := names.Format(0); got != "survival (0)" {
+		t.Errorf("Format(0) = %q, want %q", got, "survival (0)")
+	}
+	if got := names.Valid(); got != "0=survival, 1=creative" {
+		t.Errorf("Valid() = %q", got)
+	}
+}
+
+func TestInvalidEnumError(t *testing.T) {
+	ns.RegisterEnumNames("test mode", ns.EnumNames{1: "b", 0: "a"})
+
+	err := ns.InvalidEnumError("test mode", 5)
+	if want := "invalid test mode: 5 (valid: 0=a, 1=b)"; err.Error() != want {
+		t.Errorf("got %q, want %q", err.Error(), want)
+	}
+	if got := ns.EnumName("test mode", 1); got != "b" {
+		t.Errorf("EnumName = %q, want %q", got, "b")
+	}
+
+	err = ns.InvalidEnumError("unregistered", 3)
+	if want := "invalid unregistered: 3"; err.Error() != want {
+		t.Errorf("got %q, want %q", err.Error(), want)
+	}
+}
+
+func TestResolvableProfile_InvalidKind(t *testing.T) {
+	var p ns.ResolvableProfile
+	err := p.Decode(ns.NewReader([]byte{0x05}))
+	if err == nil {
+		t.Fatal("expected error for invalid kind")
+	}
+	if !strings.Contains(err.Error(), "0=partial, 1=complete") {
+		t.Errorf("error should list valid kinds, got: %v", err)
+	}
+	if ns.ProfileComplete.String() != "complete" {
+		t.Errorf("String() = %q, want %q", ns.ProfileComplete.String(), "complete")
+	}
+}
@@ -0,0 +1,280 @@
+package net_structures
+
+import "io"
+
+// GameMode is a player's game mode.
+//
+// Sent as an Unsigned Byte (e.g. Login (play), Respawn). The "previous game mode"
+// fields are sent as a signed Byte where -1 means undefined; since -1 and 255
+// share the same byte, GameModeNone covers both encodings.
+type GameMode int8
+
+const (
+	GameModeNone      GameMode = -1
+	GameModeSurvival  GameMode = 0
+	GameModeCreative  GameMode = 1
+	GameModeAdventure GameMode = 2
+	GameModeSpectator GameMode = 3
+)
+
+var gameModeNames = EnumNames{
+	int32(GameModeNone):      "none",
+	int32(GameModeSurvival):  "survival",
+	int32(GameModeCreative):  "creative",
+	int32(GameModeAdventure): "adventure",
+	int32(GameModeSpectator): "spectator",
+}
+
+func (g GameMode) String() string {
+	return gameModeNames.Name(int32(g))
+}
+
+// Valid reports whether g is a known game mode (including GameModeNone).
+func (g GameMode) Valid() bool {
+	_, ok := gameModeNames[int32(g)]
+	return ok
+}
+
+// Encode writes the GameMode to w as a single byte.
+func (g GameMode) Encode(w io.Writer) error {
+	return Int8(g).Encode(w)
+}
+
+// DecodeGameMode reads a GameMode from r.
+func DecodeGameMode(r io.Reader) (GameMode, error) {
+	v, err := DecodeInt8(r)
+	if err != nil {
+		return 0, err
+	}
+	g := GameMode(v)
+	if !g.Valid() {
+		return 0, InvalidEnumError("game mode", int32(v))
+	}
+	return g, nil
+}
+
+// Difficulty is the world difficulty, sent as an Unsigned Byte.
+type Difficulty uint8
+
+const (
+	DifficultyPeaceful Difficulty = 0
+	DifficultyEasy     Difficulty = 1
+	DifficultyNormal   Difficulty = 2
+	DifficultyHard     Difficulty = 3
+)
+
+var difficultyNames = EnumNames{
+	int32(DifficultyPeaceful): "peaceful",
+	int32(DifficultyEasy):     "easy",
+	int32(DifficultyNormal):   "normal",
+	int32(DifficultyHard):     "hard",
+}
+
+func (d Difficulty) String() string {
+	return difficultyNames.Name(int32(d))
+}
+
+// Encode writes the Difficulty to w as an unsigned byte.
+func (d Difficulty) Encode(w io.Writer) error {
+	return Uint8(d).Encode(w)
+}
+
+// DecodeDifficulty reads a Difficulty from r.
+func DecodeDifficulty(r io.Reader) (Difficulty, error) {
+	v, err := DecodeUint8(r)
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := difficultyNames[int32(v)]; !ok {
+		return 0, InvalidEnumError("difficulty", int32(v))
+	}
+	return Difficulty(v), nil
+}
+
+// Hand is the hand used for an interaction, sent as a VarInt.
+type Hand VarInt
+
+const (
+	HandMain Hand = 0
+	HandOff  Hand = 1
+)
+
+var handNames = EnumNames{
+	int32(HandMain): "main_hand",
+	int32(HandOff):  "off_hand",
+}
+
+func (h Hand) String() string {
+	return handNames.Name(int32(h))
+}
+
+// Other returns the opposite hand.
+func (h Hand) Other() Hand {
+	if h == HandMain {
+		return HandOff
+	}
+	return HandMain
+}
+
+// Encode writes the Hand to w as a VarInt.
+func (h Hand) Encode(w io.Writer) error {
+	return VarInt(h).Encode(w)
+}
+
+// DecodeHand reads a Hand from r.
+func DecodeHand(r io.Reader) (Hand, error) {
+	v, err := DecodeVarInt(r)
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := handNames[int32(v)]; !ok {
+		return 0, InvalidEnumError("hand", int32(v))
+	}
+	return Hand(v), nil
+}
+
+// Direction is a block face or cardinal direction, sent as a VarInt
+// (e.g. Use Item On). Player Action sends the face as a Byte instead;
+// use Int8(dir) and Direction(v) to convert.
+type Direction VarInt
+
+const (
+	DirectionDown  Direction = 0 // -Y
+	DirectionUp    Direction = 1 // +Y
+	DirectionNorth Direction = 2 // -Z
+	DirectionSouth Direction = 3 // +Z
+	DirectionWest  Direction = 4 // -X
+	DirectionEast  Direction = 5 // +X
+)
+
+var directionNames = EnumNames{
+	int32(DirectionDown):  "down",
+	int32(DirectionUp):    "up",
+	int32(DirectionNorth): "north",
+	int32(DirectionSouth): "south",
+	int32(DirectionWest):  "west",
+	int32(DirectionEast):  "east",
+}
+
+var directionOffsets = [6][3]int{
+	DirectionDown:  {0, -1, 0},
+	DirectionUp:    {0, 1, 0},
+	DirectionNorth: {0, 0, -1},
+	DirectionSouth: {0, 0, 1},
+	DirectionWest:  {-1, 0, 0},
+	DirectionEast:  {1, 0, 0},
+}
+
+func (d Direction) String() string {
+	return directionNames.Name(int32(d))
+}
+
+// Opposite returns the direction facing the other way.
+// Directions come in pairs (down/up, north/south, west/east), so this flips the lowest bit.
+func (d Direction) Opposite() Direction {
+	return d ^ 1
+}
+
+// Offset returns the unit vector (dx, dy, dz) pointing in this direction.
+// Unknown directions return (0, 0, 0).
+func (d Direction) Offset() (dx, dy, dz int) {
+	if d < 0 || int(d) >= len(directionOffsets) {
+		return 0, 0, 0
+	}
+	o := directionOffsets[d]
+	return o[0], o[1], o[2]
+}
+
+// Encode writes the Direction to w as a VarInt.
+func (d Direction) Encode(w io.Writer) error {
+	return VarInt(d).Encode(w)
+}
+
+// DecodeDirection reads a Direction from r.
+func DecodeDirection(r io.Reader) (Direction, error) {
+	v, err := DecodeVarInt(r)
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := directionNames[int32(v)]; !ok {
+		return 0, InvalidEnumError("direction", int32(v))
+	}
+	return Direction(v), nil
+}
+
+// DyeColor is one of the 16 dye colors, sent as a VarInt
+// (banner layers, shulker boxes, sheep, collar colors).
+type DyeColor VarInt
+
+const (
+	DyeWhite     DyeColor = 0
+	DyeOrange    DyeColor = 1
+	DyeMagenta   DyeColor = 2
+	DyeLightBlue DyeColor = 3
+	DyeYellow    DyeColor = 4
+	DyeLime      DyeColor = 5
+	DyePink      DyeColor = 6
+	DyeGray      DyeColor = 7
+	DyeLightGray DyeColor = 8
+	DyeCyan      DyeColor = 9
+	DyePurple    DyeColor = 10
+	DyeBlue      DyeColor = 11
+	DyeBrown     DyeColor = 12
+	DyeGreen     DyeColor = 13
+	DyeRed       DyeColor = 14
+	DyeBlack     DyeColor = 15
+)
+
+var dyeColorNames = EnumNames{
+	int32(DyeWhite):     "white",
+	int32(DyeOrange):    "orange",
+	int32(DyeMagenta):   "magenta",
+	int32(DyeLightBlue): "light_blue",
+	int32(DyeYellow):    "yellow",
+	int32(DyeLime):      "lime",
+	int32(DyePink):      "pink",
+	int32(DyeGray):      "gray",
+	int32(DyeLightGray): "light_gray",
+	int32(DyeCyan):      "cyan",
+	int32(DyePurple):    "purple",
+	int32(DyeBlue):      "blue",
+	int32(DyeBrown):     "brown",
+	int32(DyeGreen):     "green",
+	int32(DyeRed):       "red",
+	int32(DyeBlack):     "black",
+}
+
+func (c DyeColor) String() string {
+	return dyeColorNames.Name(int32(c))
+}
+
+// Encode writes the DyeColor to w as a VarInt.
+func (c DyeColor) Encode(w io.Writer) error {
+	return VarInt(c).Encode(w)
+}
+
+// DecodeDyeColor reads a DyeColor from r.
+func DecodeDyeColor(r io.Reader) (DyeColor, error) {
+	v, err := DecodeVarInt(r)
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := dyeColorNames[int32(v)]; !ok {
+		return 0, InvalidEnumError("dye color", int32(v))
+	}
+	return DyeColor(v), nil
+}
+
+// Offset returns the position one block away in the given direction.
+func (p Position) Offset(d Direction) Position {
+	dx, dy, dz := d.Offset()
+	return Position{X: p.X + dx, Y: p.Y + dy, Z: p.Z + dz}
+}
+
+func init() {
+	RegisterEnumNames("game mode", gameModeNames)
+	RegisterEnumNames("difficulty", difficultyNames)
+	RegisterEnumNames("hand", handNames)
+	RegisterEnumNames("direction", directionNames)
+	RegisterEnumNames("dye color", dyeColorNames)
+}
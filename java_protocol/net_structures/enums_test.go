@@ -0,0 +1,111 @@
+package net_structures_test
+
+import (
+	"bytes"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestGameMode(t *testing.T) {
+	tests := []struct {
+		raw  []byte
+		want ns.GameMode
+	}{
+		{[]byte{0x00}, ns.GameModeSurvival},
+		{[]byte{0x03}, ns.GameModeSpectator},
+		{[]byte{0xff}, ns.GameModeNone},
+	}
+
+	for _, tc := range tests {
+		got, err := ns.NewReader(tc.raw).ReadGameMode()
+		if err != nil {
+			t.Fatalf("decode %x: %v", tc.raw, err)
+		}
+		if got != tc.want {
+			t.Errorf("decode %x = %v, want %v", tc.raw, got, tc.want)
+		}
+
+		buf := ns.NewWriter()
+		if err := buf.WriteGameMode(got); err != nil {
+			t.Fatalf("encode error: %v", err)
+		}
+		if !bytes.Equal(buf.Bytes(), tc.raw) {
+			t.Errorf("encode %v = %x, want %x", got, buf.Bytes(), tc.raw)
+		}
+	}
+
+	if _, err := ns.NewReader([]byte{0x07}).ReadGameMode(); err == nil {
+		t.Error("expected error for invalid game mode")
+	}
+	if ns.GameModeCreative.String() != "creative" {
+		t.Errorf("String() = %q, want %q", ns.GameModeCreative.String(), "creative")
+	}
+}
+
+func TestDifficulty(t *testing.T) {
+	d, err := ns.NewReader([]byte{0x02}).ReadDifficulty()
+	if err != nil || d != ns.DifficultyNormal {
+		t.Fatalf("got %v, %v; want normal", d, err)
+	}
+	if _, err := ns.NewReader([]byte{0x04}).ReadDifficulty(); err == nil {
+		t.Error("expected error for invalid difficulty")
+	}
+}
+
+func TestHand(t *testing.T) {
+	buf := ns.NewWriter()
+	if err := buf.WriteHand(ns.HandOff); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	h, err := ns.NewReader(buf.Bytes()).ReadHand()
+	if err != nil || h != ns.HandOff {
+		t.Fatalf("got %v, %v; want off_hand", h, err)
+	}
+	if h.Other() != ns.HandMain {
+		t.Errorf("Other() = %v, want main_hand", h.Other())
+	}
+}
+
+func TestDirection(t *testing.T) {
+	pairs := [][2]ns.Direction{
+		{ns.DirectionDown, ns.DirectionUp},
+		{ns.DirectionNorth, ns.DirectionSouth},
+		{ns.DirectionWest, ns.DirectionEast},
+	}
+	for _, p := range pairs {
+		if p[0].Opposite() != p[1] || p[1].Opposite() != p[0] {
+			t.Errorf("%v and %v should be opposites", p[0], p[1])
+		}
+	}
+
+	pos := ns.NewPosition(10, 64, -5)
+	if got := pos.Offset(ns.DirectionNorth); got != ns.NewPosition(10, 64, -6) {
+		t.Errorf("north of %v = %v", pos, got)
+	}
+	if got := pos.Offset(ns.DirectionUp); got != ns.NewPosition(10, 65, -5) {
+		t.Errorf("up of %v = %v", pos, got)
+	}
+
+	d, err := ns.NewReader([]byte{0x05}).ReadDirection()
+	if err != nil || d != ns.DirectionEast {
+		t.Fatalf("got %v, %v; want east", d, err)
+	}
+	if _, err := ns.NewReader([]byte{0x06}).ReadDirection(); err == nil {
+		t.Error("expected error for invalid direction")
+	}
+}
+
+func TestDyeColor(t *testing.T) {
+	buf := ns.NewWriter()
+	if err := buf.WriteDyeColor(ns.DyeLightBlue); err != nil {
+		t.Fatal(err)
+	}
+	c, err := ns.NewReader(buf.Bytes()).ReadDyeColor()
+	if err != nil || c != ns.DyeLightBlue || c.String() != "light_blue" {
+		t.Fatalf("got %v, %v", c, err)
+	}
+	if _, err := ns.NewReader([]byte{16}).ReadDyeColor(); err == nil {
+		t.Error("expected error for dye color 16")
+	}
+}
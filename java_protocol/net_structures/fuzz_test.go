@@ -0,0 +1,187 @@
+package net_structures_test
+
+import (
+	"strings"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// fuzzComponentTypes registers every typed component, so fuzzed slots reach all
+// component decoders.
+var fuzzComponentTypes = ns.SlotComponentTypes{
+	ns.ComponentAttributeModifiers,
+	ns.ComponentAxolotlVariant,
+	ns.ComponentBannerPatterns,
+	ns.ComponentBaseColor,
+	ns.ComponentBees,
+	ns.ComponentBlockEntityData,
+	ns.ComponentBlockState,
+	ns.ComponentBlocksAttacks,
+	ns.ComponentBreakSound,
+	ns.ComponentBucketEntityData,
+	ns.ComponentBundleContents,
+	ns.ComponentCanBreak,
+	ns.ComponentCanPlaceOn,
+	ns.ComponentCatCollar,
+	ns.ComponentCatVariant,
+	ns.ComponentChargedProjectiles,
+	ns.ComponentChickenVariant,
+	ns.ComponentConsumable,
+	ns.ComponentContainer,
+	ns.ComponentCowVariant,
+	ns.ComponentCreativeSlotLock,
+	ns.ComponentCustomData,
+	ns.ComponentCustomModelData,
+	ns.ComponentCustomName,
+	ns.ComponentDamage,
+	ns.ComponentDamageResistant,
+	ns.ComponentDeathProtection,
+	ns.ComponentDebugStickState,
+	ns.ComponentDyedColor,
+	ns.ComponentEnchantable,
+	ns.ComponentEnchantmentGlintOverride,
+	ns.ComponentEnchantments,
+	ns.ComponentEntityData,
+	ns.ComponentEquippable,
+	ns.ComponentFireworkExplosion,
+	ns.ComponentFireworks,
+	ns.ComponentFood,
+	ns.ComponentFoxVariant,
+	ns.ComponentFrogVariant,
+	ns.ComponentGlider,
+	ns.ComponentHorseVariant,
+	ns.ComponentInstrument,
+	ns.ComponentIntangibleProjectile,
+	ns.ComponentItemModel,
+	ns.ComponentItemName,
+	ns.ComponentJukeboxPlayable,
+	ns.ComponentLlamaVariant,
+	ns.ComponentLodestoneTracker,
+	ns.ComponentLore,
+	ns.ComponentMapColor,
+	ns.ComponentMapDecorations,
+	ns.ComponentMapID,
+	ns.ComponentMapPostProcessing,
+	ns.ComponentMaxDamage,
+	ns.ComponentMaxStackSize,
+	ns.ComponentMooshroomVariant,
+	ns.ComponentNoteBlockSound,
+	ns.ComponentOminousBottleAmplifier,
+	ns.ComponentPaintingVariant,
+	ns.ComponentParrotVariant,
+	ns.ComponentPigVariant,
+	ns.ComponentPotDecorations,
+	ns.ComponentPotionContents,
+	ns.ComponentPotionDurationScale,
+	ns.ComponentProfile,
+	ns.ComponentProvidesBannerPatterns,
+	ns.ComponentProvidesTrimMaterial,
+	ns.ComponentRabbitVariant,
+	ns.ComponentRarity,
+	ns.ComponentRepairCost,
+	ns.ComponentRepairable,
+	ns.ComponentSalmonSize,
+	ns.ComponentSheepColor,
+	ns.ComponentShulkerColor,
+	ns.ComponentStoredEnchantments,
+	ns.ComponentSuspiciousStewEffects,
+	ns.ComponentTool,
+	ns.ComponentTooltipDisplay,
+	ns.ComponentTooltipStyle,
+	ns.ComponentTrim,
+	ns.ComponentTropicalFishBaseColor,
+	ns.ComponentTropicalFishPattern,
+	ns.ComponentTropicalFishPatternColor,
+	ns.ComponentUnbreakable,
+	ns.ComponentUseCooldown,
+	ns.ComponentUseRemainder,
+	ns.ComponentVillagerVariant,
+	ns.ComponentWeapon,
+	ns.ComponentWolfCollar,
+	ns.ComponentWolfSoundVariant,
+	ns.ComponentWolfVariant,
+	ns.ComponentWritableBookContent,
+	ns.ComponentWrittenBookContent,
+}
+
+func TestMalformedCounts(t *testing.T) {
+	w := ns.NewWriter()
+	w.WriteVarInt(1)  // count
+	w.WriteVarInt(10) // item id
+	w.WriteVarInt(-1) // add count
+	w.WriteVarInt(0)
+	negative := w.Bytes()
+
+	w = ns.NewWriter()
+	w.WriteVarInt(1)
+	w.WriteVarInt(10)
+	w.WriteVarInt(1 << 30)
+	w.WriteVarInt(0)
+	huge := w.Bytes()
+
+	for name, data := range map[string][]byte{"negative": negative, "huge": huge} {
+		var slot ns.Slot
+		err := slot.Decode(ns.NewReader(data), fuzzComponentTypes.Decoder())
+		if err == nil || !strings.Contains(err.Error(), "slot add count") {
+			t.Errorf("%s: expected add count error, got: %v", name, err)
+		}
+	}
+
+	var ids ns.IDSet
+	if err := ids.Decode(ns.NewReader([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0x0F})); err == nil {
+		t.Error("expected error for negative id set length")
+	}
+}
+
+func FuzzSlotDecode(f *testing.F) {
+	for _, slot := range []ns.Slot{ns.EmptySlot(), ns.NewSlot(1, 64)} {
+		w := ns.NewWriter()
+		if err := slot.Encode(w); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(w.Bytes())
+	}
+	f.Add([]byte{0x01, 0x0A, 0x01, 0x00, 0x06, 0x01, 0x00})
+
+	decode := fuzzComponentTypes.Decoder()
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var slot ns.Slot
+		if err := slot.Decode(ns.NewReader(data), decode); err != nil {
+			return
+		}
+		for _, c := range slot.Components.Add {
+			fuzzComponentTypes.Parse(c)
+		}
+	})
+}
+
+func FuzzHashedSlotDecode(f *testing.F) {
+	f.Add([]byte{0x00})
+	f.Add([]byte{0x01, 0x0A, 0x01, 0x01, 0x03, 0x00, 0x00, 0x00, 0x01, 0x01, 0x05})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var slot ns.HashedSlot
+		slot.Decode(ns.NewReader(data))
+	})
+}
+
+func FuzzChunkDataDecode(f *testing.F) {
+	f.Add([]byte{0x00, 0x00, 0x00})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var chunk ns.ChunkData
+		chunk.Decode(ns.NewReader(data))
+		chunk.Data = data
+		chunk.Sections(ns.BlockStatesContainerType(29873), ns.BiomesContainerType(65))
+		var light ns.LightData
+		light.Decode(ns.NewReader(data))
+	})
+}
+
+func FuzzEntityMetadataDecode(f *testing.F) {
+	f.Add([]byte{0xFF})
+	f.Add([]byte{0x00, 0x00, 0x02, 0x06, 0x06, 0x05, 0xFF})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var meta ns.EntityMetadata
+		meta.DecodeWith(ns.NewReader(data), testMetadataRegistries)
+	})
+}
@@ -79,7 +79,7 @@ func (s *HashedSlot) Decode(buf *PacketBuffer) error {
 	}
 
 	// HashedPatchMap: added components
-	addCount, err := buf.ReadVarInt()
+	addCount, err := buf.ReadCount()
 	if err != nil {
 		return fmt.Errorf("failed to read hashed slot add count: %w", err)
 	}
@@ -94,7 +94,7 @@ func (s *HashedSlot) Decode(buf *PacketBuffer) error {
 	}
 
 	// HashedPatchMap: removed components
-	removeCount, err := buf.ReadVarInt()
+	removeCount, err := buf.ReadCount()
 	if err != nil {
 		return fmt.Errorf("failed to read hashed slot remove count: %w", err)
 	}
@@ -0,0 +1,184 @@
+package net_structures
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// PackedLongArray stores fixed-width unsigned values tightly packed into longs.
+// Used by heightmaps and paletted containers (block states and biomes).
+//
+// Since 1.16, entries never span across long boundaries: each long holds
+// floor(64 / bitsPerEntry) entries starting from the least significant bit,
+// and any leftover high bits are padding.
+//
+//	long:  │ padding │ entry n-1 │ ... │ entry 1 │ entry 0 │
+//	bit:   63                                            0
+//
+// See https://minecraft.wiki/w/Java_Edition_protocol/Chunk_format#Data_Array_format
+type PackedLongArray struct {
+	data          []int64
+	bitsPerEntry  int
+	size          int
+	valuesPerLong int
+	mask          uint64
+}
+
+// PackedLongsLen returns the number of longs needed to store size entries
+// of bitsPerEntry bits each. Returns 0 if bitsPerEntry is 0; widths above 64
+// count as 64.
+func PackedLongsLen(bitsPerEntry, size int) int {
+	if bitsPerEntry <= 0 {
+		return 0
+	}
+	valuesPerLong := 64 / min(bitsPerEntry, 64)
+	return (size + valuesPerLong - 1) / valuesPerLong
+}
+
+// BitsForValues returns the minimum number of bits needed to represent
+// values in the range [0, count).
+func BitsForValues(count int) int {
+	if count <= 1 {
+		return 0
+	}
+	return bits.Len(uint(count - 1))
+}
+
+// NewPackedLongArray creates a zeroed PackedLongArray holding size entries.
+// A bitsPerEntry of 0 creates an array where every entry is 0 and no longs are stored.
+// bitsPerEntry is clamped to the range 0..64.
+func NewPackedLongArray(bitsPerEntry, size int) *PackedLongArray {
+	bitsPerEntry = min(max(bitsPerEntry, 0), 64)
+	return newPackedLongArray(bitsPerEntry, size, make([]int64, PackedLongsLen(bitsPerEntry, size)))
+}
+
+// PackedLongArrayFromLongs wraps existing longs (without copying).
+// Returns an error if the number of longs doesn't match bitsPerEntry and size.
+func PackedLongArrayFromLongs(bitsPerEntry, size int, data []int64) (*PackedLongArray, error) {
+	if bitsPerEntry < 0 || bitsPerEntry > 64 {
+		return nil, fmt.Errorf("invalid bits per entry: %d", bitsPerEntry)
+	}
+	if want := PackedLongsLen(bitsPerEntry, size); len(data) != want {
+		return nil, fmt.Errorf("packed array length mismatch: got %d longs, want %d for %d entries of %d bits", len(data), want, size, bitsPerEntry)
+	}
+	return newPackedLongArray(bitsPerEntry, size, data), nil
+}
+
+func newPackedLongArray(bitsPerEntry, size int, data []int64) *PackedLongArray {
+	a := &PackedLongArray{bitsPerEntry: bitsPerEntry, size: size, data: data}
+	if bitsPerEntry > 0 {
+		a.valuesPerLong = 64 / bitsPerEntry
+		a.mask = 1<<bitsPerEntry - 1
+	}
+	return a
+}
+
+// Get returns the entry at index i. Out of range indices return 0.
+func (a *PackedLongArray) Get(i int) int {
+	if a.bitsPerEntry == 0 || i < 0 || i >= a.size {
+		return 0
+	}
+	long := uint64(a.data[i/a.valuesPerLong])
+	shift := (i % a.valuesPerLong) * a.bitsPerEntry
+	return int(long >> shift & a.mask)
+}
+
+// Set sets the entry at index i. The value is truncated to bitsPerEntry bits.
+// Out of range indices are ignored.
+func (a *PackedLongArray) Set(i int, v int) {
+	if a.bitsPerEntry == 0 || i < 0 || i >= a.size {
+		return
+	}
+	idx := i / a.valuesPerLong
+	shift := (i % a.valuesPerLong) * a.bitsPerEntry
+	long := uint64(a.data[idx])
+	long &^= a.mask << shift
+	long |= (uint64(v) & a.mask) << shift
+	a.data[idx] = int64(long)
+}
+
+// Resize returns a copy of the array repacked with a different number of bits per entry.
+// Values that don't fit in the new width are truncated. Like NewPackedLongArray,
+// bitsPerEntry is clamped to the range 0..64.
+func (a *PackedLongArray) Resize(bitsPerEntry int) *PackedLongArray {
+	resized := NewPackedLongArray(bitsPerEntry, a.size)
+	for i := range a.size {
+		resized.Set(i, a.Get(i))
+	}
+	return resized
+}
+
+// BitsPerEntry returns the width of each entry in bits.
+func (a *PackedLongArray) BitsPerEntry() int {
+	return a.bitsPerEntry
+}
+
+// Size returns the number of entries.
+func (a *PackedLongArray) Size() int {
+	return a.size
+}
+
+// Longs returns the underlying long array.
+func (a *PackedLongArray) Longs() []int64 {
+	return a.data
+}
+
+// Decode reads the packed longs from the buffer. The number of longs is derived
+// from the configured bits per entry and size (no length prefix), as used by
+// paletted containers since 1.21.5.
+func (a *PackedLongArray) Decode(buf *PacketBuffer) error {
+	for i := range a.data {
+		v, err := buf.ReadInt64()
+		if err != nil {
+			return fmt.Errorf("failed to read packed long %d: %w", i, err)
+		}
+		a.data[i] = int64(v)
+	}
+	return nil
+}
+
+// Encode writes the packed longs to the buffer (no length prefix).
+func (a *PackedLongArray) Encode(buf *PacketBuffer) error {
+	for i, v := range a.data {
+		if err := buf.WriteInt64(Int64(v)); err != nil {
+			return fmt.Errorf("failed to write packed long %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ReadLongArray reads a VarInt length-prefixed array of longs.
+func (pb *PacketBuffer) ReadLongArray() ([]int64, error) {
+	length, err := pb.ReadVarInt()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read long array length: %w", err)
+	}
+	if length < 0 {
+		return nil, fmt.Errorf("negative long array length: %d", length)
+	}
+	if err := pb.checkCount(int(length)); err != nil {
+		return nil, fmt.Errorf("invalid long array length: %w", err)
+	}
+	longs := make([]int64, length)
+	for i := range longs {
+		v, err := pb.ReadInt64()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read long %d: %w", i, err)
+		}
+		longs[i] = int64(v)
+	}
+	return longs, nil
+}
+
+// WriteLongArray writes a VarInt length-prefixed array of longs.
+func (pb *PacketBuffer) WriteLongArray(longs []int64) error {
+	if err := pb.WriteVarInt(VarInt(len(longs))); err != nil {
+		return fmt.Errorf("failed to write long array length: %w", err)
+	}
+	for i, v := range longs {
+		if err := pb.WriteInt64(Int64(v)); err != nil {
+			return fmt.Errorf("failed to write long %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package net_structures_test
+
+import (
+	"bytes"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestPackedLongsLen(t *testing.T) {
+	tests := []struct {
+		bits, size, want int
+	}{
+		{0, 4096, 0},
+		{4, 4096, 256},   // 16 per long
+		{5, 4096, 342},   // 12 per long, 4 bits padding
+		{9, 256, 37},     // heightmap for 384-tall world, 7 per long
+		{15, 4096, 1024}, // 4 per long
+		{64, 3, 3},
+	}
+
+	for _, tc := range tests {
+		if got := ns.PackedLongsLen(tc.bits, tc.size); got != tc.want {
+			t.Errorf("PackedLongsLen(%d, %d) = %d, want %d", tc.bits, tc.size, got, tc.want)
+		}
+	}
+}
+
+func TestBitsForValues(t *testing.T) {
+	tests := []struct {
+		count, want int
+	}{
+		{0, 0},
+		{1, 0},
+		{2, 1},
+		{16, 4},
+		{17, 5},
+		{385, 9},
+	}
+
+	for _, tc := range tests {
+		if got := ns.BitsForValues(tc.count); got != tc.want {
+			t.Errorf("BitsForValues(%d) = %d, want %d", tc.count, got, tc.want)
+		}
+	}
+}
+
+func TestPackedLongArray_GetSet(t *testing.T) {
+	for _, bits := range []int{1, 4, 5, 9, 15, 31, 64} {
+		a := ns.NewPackedLongArray(bits, 100)
+		for i := range 100 {
+			a.Set(i, i*7)
+		}
+		mask := uint64(1)<<bits - 1
+		for i := range 100 {
+			want := int(uint64(i*7) & mask)
+			if got := a.Get(i); got != want {
+				t.Fatalf("bits=%d: Get(%d) = %d, want %d", bits, i, got, want)
+			}
+		}
+	}
+}
+
+func TestPackedLongArray_NoSpanning(t *testing.T) {
+	// 5 bits per entry: 12 entries per long, entry 12 starts in the second long
+	a := ns.NewPackedLongArray(5, 13)
+	a.Set(11, 31)
+	a.Set(12, 1)
+
+	longs := a.Longs()
+	if len(longs) != 2 {
+		t.Fatalf("expected 2 longs, got %d", len(longs))
+	}
+	if longs[0] != 31<<55 {
+		t.Errorf("first long = %#x, want %#x", longs[0], int64(31)<<55)
+	}
+	if longs[1] != 1 {
+		t.Errorf("second long = %#x, want 0x1", longs[1])
+	}
+}
+
+func TestPackedLongArray_ZeroBits(t *testing.T) {
+	a := ns.NewPackedLongArray(0, 4096)
+	a.Set(10, 5)
+	if a.Get(10) != 0 {
+		t.Error("zero-bit array should always return 0")
+	}
+	if len(a.Longs()) != 0 {
+		t.Errorf("zero-bit array should store no longs, got %d", len(a.Longs()))
+	}
+}
+
+func TestPackedLongArray_Resize(t *testing.T) {
+	a := ns.NewPackedLongArray(4, 64)
+	for i := range 64 {
+		a.Set(i, i%16)
+	}
+
+	grown := a.Resize(9)
+	if grown.BitsPerEntry() != 9 || grown.Size() != 64 {
+		t.Fatalf("unexpected resized shape: bits=%d size=%d", grown.BitsPerEntry(), grown.Size())
+	}
+	for i := range 64 {
+		if grown.Get(i) != i%16 {
+			t.Fatalf("Get(%d) after resize = %d, want %d", i, grown.Get(i), i%16)
+		}
+	}
+
+	// original is untouched
+	if a.BitsPerEntry() != 4 || a.Get(15) != 15 {
+		t.Error("Resize modified the original array")
+	}
+}
+
+func TestPackedLongArray_ClampsBitsPerEntry(t *testing.T) {
+	if n := ns.PackedLongsLen(65, 4); n != 4 {
+		t.Errorf("PackedLongsLen(65, 4) = %d, want 4", n)
+	}
+
+	a := ns.NewPackedLongArray(65, 4)
+	if a.BitsPerEntry() != 64 {
+		t.Fatalf("BitsPerEntry() = %d, want 64", a.BitsPerEntry())
+	}
+	a.Set(3, 42)
+	if a.Get(3) != 42 {
+		t.Errorf("Get(3) = %d, want 42", a.Get(3))
+	}
+
+	if r := a.Resize(100); r.BitsPerEntry() != 64 || r.Get(3) != 42 {
+		t.Errorf("Resize(100): bits=%d Get(3)=%d", r.BitsPerEntry(), r.Get(3))
+	}
+	if r := a.Resize(-1); r.BitsPerEntry() != 0 || r.Get(3) != 0 {
+		t.Errorf("Resize(-1): bits=%d Get(3)=%d", r.BitsPerEntry(), r.Get(3))
+	}
+}
+
+func TestPackedLongArrayFromLongs(t *testing.T) {
+	if _, err := ns.PackedLongArrayFromLongs(9, 256, make([]int64, 36)); err == nil {
+		t.Error("expected error for wrong long count")
+	}
+
+	longs := make([]int64, 37)
+	longs[0] = 64 | 65<<9
+	a, err := ns.PackedLongArrayFromLongs(9, 256, longs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.Get(0) != 64 || a.Get(1) != 65 {
+		t.Errorf("got (%d, %d), want (64, 65)", a.Get(0), a.Get(1))
+	}
+}
+
+func TestPackedLongArray_RoundTrip(t *testing.T) {
+	a := ns.NewPackedLongArray(15, 8)
+	for i := range 8 {
+		a.Set(i, 1000+i)
+	}
+
+	buf := ns.NewWriter()
+	if err := a.Encode(buf); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	// 4 entries per long, no length prefix
+	if buf.Len() != 16 {
+		t.Fatalf("encoded length = %d, want 16", buf.Len())
+	}
+
+	decoded := ns.NewPackedLongArray(15, 8)
+	if err := decoded.Decode(ns.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	for i := range 8 {
+		if decoded.Get(i) != 1000+i {
+			t.Errorf("Get(%d) = %d, want %d", i, decoded.Get(i), 1000+i)
+		}
+	}
+}
+
+func TestLongArray_RoundTrip(t *testing.T) {
+	raw := []byte{
+		0x02,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	}
+
+	longs, err := ns.NewReader(raw).ReadLongArray()
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if len(longs) != 2 || longs[0] != 1 || longs[1] != -1 {
+		t.Fatalf("unexpected longs: %v", longs)
+	}
+
+	buf := ns.NewWriter()
+	if err := buf.WriteLongArray(longs); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), raw) {
+		t.Errorf("encode mismatch:\n  got:  %x\n  want: %x", buf.Bytes(), raw)
+	}
+}
+
+func TestChunkData_Heightmap(t *testing.T) {
+	hm := ns.NewPackedLongArray(ns.HeightmapBits(384), 256)
+	hm.Set(0, 128)
+	hm.Set(255, 384)
+
+	var cd ns.ChunkData
+	cd.SetHeightmap(ns.HeightmapMotionBlocking, hm)
+	if len(cd.Heightmaps[ns.HeightmapMotionBlocking]) != 37 {
+		t.Fatalf("unexpected long count: %d", len(cd.Heightmaps[ns.HeightmapMotionBlocking]))
+	}
+
+	got, err := cd.Heightmap(ns.HeightmapMotionBlocking, 384)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Get(0) != 128 || got.Get(255) != 384 {
+		t.Errorf("got (%d, %d), want (128, 384)", got.Get(0), got.Get(255))
+	}
+
+	missing, err := cd.Heightmap(ns.HeightmapWorldSurface, 384)
+	if err != nil || missing != nil {
+		t.Errorf("expected nil heightmap for missing type, got %v, %v", missing, err)
+	}
+}
@@ -0,0 +1,634 @@
+package net_structures
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ParticleOptions is the typed data of a particle type that has options (e.g.
+// the color and scale of minecraft:dust).
+//
+// Particle type IDs depend on the minecraft:particle_type registry of the
+// protocol version, so options are registered by name and resolved to IDs
+// through ParticleTypes.
+type ParticleOptions interface {
+	Decode(buf *PacketBuffer) error
+	Encode(buf *PacketBuffer) error
+}
+
+// Particle type names with options implemented in this package.
+const (
+	ParticleBlock               Identifier = "minecraft:block"
+	ParticleBlockCrumble        Identifier = "minecraft:block_crumble"
+	ParticleBlockMarker         Identifier = "minecraft:block_marker"
+	ParticleDragonBreath        Identifier = "minecraft:dragon_breath"
+	ParticleDust                Identifier = "minecraft:dust"
+	ParticleDustColorTransition Identifier = "minecraft:dust_color_transition"
+	ParticleDustPillar          Identifier = "minecraft:dust_pillar"
+	ParticleEffect              Identifier = "minecraft:effect"
+	ParticleEntityEffect        Identifier = "minecraft:entity_effect"
+	ParticleFallingDust         Identifier = "minecraft:falling_dust"
+	ParticleFlash               Identifier = "minecraft:flash"
+	ParticleInstantEffect       Identifier = "minecraft:instant_effect"
+	ParticleItem                Identifier = "minecraft:item"
+	ParticleSculkCharge         Identifier = "minecraft:sculk_charge"
+	ParticleShriek              Identifier = "minecraft:shriek"
+	ParticleTintedLeaves        Identifier = "minecraft:tinted_leaves"
+	ParticleTrail               Identifier = "minecraft:trail"
+	ParticleVibration           Identifier = "minecraft:vibration"
+)
+
+var (
+	particleOptionsMu sync.RWMutex
+	particleOptions   = map[Identifier]func() ParticleOptions{}
+)
+
+// RegisterParticleOptions registers (or replaces) the options of a particle
+// type. Particle types without registered options are read as having none.
+func RegisterParticleOptions(name Identifier, factory func() ParticleOptions) {
+	particleOptionsMu.Lock()
+	defer particleOptionsMu.Unlock()
+	particleOptions[name] = factory
+}
+
+// NewParticleOptions returns new, empty options for the given particle type, or
+// false if the type has no options.
+func NewParticleOptions(name Identifier) (ParticleOptions, bool) {
+	particleOptionsMu.RLock()
+	factory, ok := particleOptions[name]
+	particleOptionsMu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return factory(), true
+}
+
+// ParticleTypes lists the particle type names in registry ID order, i.e.
+// ParticleTypes[id] is the name of particle type id. The list comes from the
+// minecraft:particle_type registry of the targeted version.
+type ParticleTypes []Identifier
+
+// Name returns the name of the particle type with the given ID.
+func (t ParticleTypes) Name(id VarInt) (Identifier, bool) {
+	if id < 0 || int(id) >= len(t) {
+		return "", false
+	}
+	return t[id], true
+}
+
+// ID returns the registry ID of the named particle type.
+func (t ParticleTypes) ID(name Identifier) (VarInt, bool) {
+	for i, n := range t {
+		if n == name {
+			return VarInt(i), true
+		}
+	}
+	return 0, false
+}
+
+// Particle returns a particle of the named type. Options must be nil for
+// particle types without options.
+func (t ParticleTypes) Particle(name Identifier, options ParticleOptions) (Particle, error) {
+	id, ok := t.ID(name)
+	if !ok {
+		return Particle{}, fmt.Errorf("unknown particle type: %s", name)
+	}
+	return Particle{Type: id, Options: options}, nil
+}
+
+// Particle is a particle type with its options.
+//
+// Wire format:
+//
+//	┌──────────────────┬───────────────────────────────────┐
+//	│  Type (VarInt)   │  Options (depends on Type)        │
+//	└──────────────────┴───────────────────────────────────┘
+//
+// Type is a registry ID from minecraft:particle_type.
+type Particle struct {
+	Type    VarInt
+	Options ParticleOptions // nil for particle types without options
+}
+
+// DecodeWith reads a Particle, resolving its type through types. decode is used
+// for the components of item particles (see SlotComponentTypes.Decoder).
+func (p *Particle) DecodeWith(buf *PacketBuffer, types ParticleTypes, decode SlotDecoder) error {
+	var err error
+	if p.Type, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read particle type: %w", err)
+	}
+	name, ok := types.Name(p.Type)
+	if !ok {
+		return fmt.Errorf("unknown particle type id: %d", p.Type)
+	}
+	options, ok := NewParticleOptions(name)
+	if !ok {
+		p.Options = nil
+		return nil
+	}
+	if nested, ok := options.(NestedSlotComponent); ok {
+		err = nested.DecodeWith(buf, decode)
+	} else {
+		err = options.Decode(buf)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s particle options: %w", name, err)
+	}
+	p.Options = options
+	return nil
+}
+
+// Encode writes a Particle to the buffer.
+func (p *Particle) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(p.Type); err != nil {
+		return fmt.Errorf("failed to write particle type: %w", err)
+	}
+	if p.Options == nil {
+		return nil
+	}
+	if err := p.Options.Encode(buf); err != nil {
+		return fmt.Errorf("failed to write particle options: %w", err)
+	}
+	return nil
+}
+
+// BlockParticleOptions are the options of block particles (minecraft:block,
+// minecraft:block_marker, minecraft:falling_dust, minecraft:dust_pillar and
+// minecraft:block_crumble).
+//
+// Wire format:
+//
+//	┌──────────────────────────┐
+//	│  Block State (VarInt)    │
+//	└──────────────────────────┘
+type BlockParticleOptions struct {
+	BlockState VarInt
+}
+
+// Decode reads BlockParticleOptions from the buffer.
+func (o *BlockParticleOptions) Decode(buf *PacketBuffer) error {
+	var err error
+	if o.BlockState, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read particle block state: %w", err)
+	}
+	return nil
+}
+
+// Encode writes BlockParticleOptions to the buffer.
+func (o *BlockParticleOptions) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(o.BlockState); err != nil {
+		return fmt.Errorf("failed to write particle block state: %w", err)
+	}
+	return nil
+}
+
+// DustParticleOptions are the options of minecraft:dust.
+//
+// Wire format:
+//
+//	┌────────────────┬─────────────────┐
+//	│  Color (Int)   │  Scale (Float)  │
+//	└────────────────┴─────────────────┘
+//
+// Color is RGB (0xRRGGBB); Scale is clamped to 0.01-4 by the client.
+type DustParticleOptions struct {
+	Color Int32
+	Scale Float32
+}
+
+// Decode reads DustParticleOptions from the buffer.
+func (o *DustParticleOptions) Decode(buf *PacketBuffer) error {
+	var err error
+	if o.Color, err = buf.ReadInt32(); err != nil {
+		return fmt.Errorf("failed to read dust color: %w", err)
+	}
+	if o.Scale, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read dust scale: %w", err)
+	}
+	return nil
+}
+
+// Encode writes DustParticleOptions to the buffer.
+func (o *DustParticleOptions) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteInt32(o.Color); err != nil {
+		return fmt.Errorf("failed to write dust color: %w", err)
+	}
+	if err := buf.WriteFloat32(o.Scale); err != nil {
+		return fmt.Errorf("failed to write dust scale: %w", err)
+	}
+	return nil
+}
+
+// DustColorTransitionOptions are the options of minecraft:dust_color_transition.
+//
+// Wire format:
+//
+//	┌─────────────────────┬───────────────────┬─────────────────┐
+//	│  From Color (Int)   │  To Color (Int)   │  Scale (Float)  │
+//	└─────────────────────┴───────────────────┴─────────────────┘
+type DustColorTransitionOptions struct {
+	FromColor Int32
+	ToColor   Int32
+	Scale     Float32
+}
+
+// Decode reads DustColorTransitionOptions from the buffer.
+func (o *DustColorTransitionOptions) Decode(buf *PacketBuffer) error {
+	var err error
+	if o.FromColor, err = buf.ReadInt32(); err != nil {
+		return fmt.Errorf("failed to read dust from color: %w", err)
+	}
+	if o.ToColor, err = buf.ReadInt32(); err != nil {
+		return fmt.Errorf("failed to read dust to color: %w", err)
+	}
+	if o.Scale, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read dust scale: %w", err)
+	}
+	return nil
+}
+
+// Encode writes DustColorTransitionOptions to the buffer.
+func (o *DustColorTransitionOptions) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteInt32(o.FromColor); err != nil {
+		return fmt.Errorf("failed to write dust from color: %w", err)
+	}
+	if err := buf.WriteInt32(o.ToColor); err != nil {
+		return fmt.Errorf("failed to write dust to color: %w", err)
+	}
+	if err := buf.WriteFloat32(o.Scale); err != nil {
+		return fmt.Errorf("failed to write dust scale: %w", err)
+	}
+	return nil
+}
+
+// ColorParticleOptions are the options of tinted particles (minecraft:entity_effect,
+// minecraft:tinted_leaves and minecraft:flash).
+//
+// Wire format:
+//
+//	┌────────────────┐
+//	│  Color (Int)   │
+//	└────────────────┘
+//
+// Color is ARGB (0xAARRGGBB).
+type ColorParticleOptions struct {
+	Color Int32
+}
+
+// Decode reads ColorParticleOptions from the buffer.
+func (o *ColorParticleOptions) Decode(buf *PacketBuffer) error {
+	var err error
+	if o.Color, err = buf.ReadInt32(); err != nil {
+		return fmt.Errorf("failed to read particle color: %w", err)
+	}
+	return nil
+}
+
+// Encode writes ColorParticleOptions to the buffer.
+func (o *ColorParticleOptions) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteInt32(o.Color); err != nil {
+		return fmt.Errorf("failed to write particle color: %w", err)
+	}
+	return nil
+}
+
+// SpellParticleOptions are the options of minecraft:effect and minecraft:instant_effect.
+//
+// Wire format:
+//
+//	┌────────────────┬─────────────────┐
+//	│  Color (Int)   │  Power (Float)  │
+//	└────────────────┴─────────────────┘
+//
+// Color is RGB (0xRRGGBB).
+type SpellParticleOptions struct {
+	Color Int32
+	Power Float32
+}
+
+// Decode reads SpellParticleOptions from the buffer.
+func (o *SpellParticleOptions) Decode(buf *PacketBuffer) error {
+	var err error
+	if o.Color, err = buf.ReadInt32(); err != nil {
+		return fmt.Errorf("failed to read particle color: %w", err)
+	}
+	if o.Power, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read particle power: %w", err)
+	}
+	return nil
+}
+
+// Encode writes SpellParticleOptions to the buffer.
+func (o *SpellParticleOptions) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteInt32(o.Color); err != nil {
+		return fmt.Errorf("failed to write particle color: %w", err)
+	}
+	if err := buf.WriteFloat32(o.Power); err != nil {
+		return fmt.Errorf("failed to write particle power: %w", err)
+	}
+	return nil
+}
+
+// PowerParticleOptions are the options of minecraft:dragon_breath.
+//
+// Wire format:
+//
+//	┌─────────────────┐
+//	│  Power (Float)  │
+//	└─────────────────┘
+type PowerParticleOptions struct {
+	Power Float32
+}
+
+// Decode reads PowerParticleOptions from the buffer.
+func (o *PowerParticleOptions) Decode(buf *PacketBuffer) error {
+	var err error
+	if o.Power, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read particle power: %w", err)
+	}
+	return nil
+}
+
+// Encode writes PowerParticleOptions to the buffer.
+func (o *PowerParticleOptions) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteFloat32(o.Power); err != nil {
+		return fmt.Errorf("failed to write particle power: %w", err)
+	}
+	return nil
+}
+
+// ItemParticleOptions are the options of minecraft:item.
+//
+// Wire format:
+//
+//	┌──────────────────┐
+//	│  Item (Slot)     │
+//	└──────────────────┘
+type ItemParticleOptions struct {
+	Item Slot
+}
+
+// Decode reads ItemParticleOptions whose slot has no components.
+// Use DecodeWith (or Particle.DecodeWith) for a slot with components.
+func (o *ItemParticleOptions) Decode(buf *PacketBuffer) error {
+	return o.DecodeWith(buf, rejectNestedComponents)
+}
+
+// DecodeWith reads ItemParticleOptions using decode for the slot's components.
+func (o *ItemParticleOptions) DecodeWith(buf *PacketBuffer, decode SlotDecoder) error {
+	if err := o.Item.Decode(buf, decode); err != nil {
+		return fmt.Errorf("failed to read particle item: %w", err)
+	}
+	return nil
+}
+
+// Encode writes ItemParticleOptions to the buffer.
+func (o *ItemParticleOptions) Encode(buf *PacketBuffer) error {
+	if err := o.Item.Encode(buf); err != nil {
+		return fmt.Errorf("failed to write particle item: %w", err)
+	}
+	return nil
+}
+
+// PositionSourceType is the kind of target a vibration travels to.
+type PositionSourceType VarInt
+
+const (
+	PositionSourceBlock  PositionSourceType = 0
+	PositionSourceEntity PositionSourceType = 1
+)
+
+var positionSourceTypeNames = EnumNames{
+	int32(PositionSourceBlock):  "block",
+	int32(PositionSourceEntity): "entity",
+}
+
+func (t PositionSourceType) String() string {
+	return positionSourceTypeNames.Name(int32(t))
+}
+
+// PositionSource is the destination of a vibration.
+//
+// Wire format:
+//
+//	┌─────────────────────┬──────────────────────────────────────────────────────────┐
+//	│  Type (VarInt Enum) │  Block: Position | Entity: Entity ID (VarInt) +          │
+//	│                     │  Y Offset (Float)                                        │
+//	└─────────────────────┴──────────────────────────────────────────────────────────┘
+type PositionSource struct {
+	Type PositionSourceType
+
+	Block    Position // PositionSourceBlock
+	EntityID VarInt   // PositionSourceEntity
+	YOffset  Float32  // PositionSourceEntity
+}
+
+// Decode reads a PositionSource from the buffer.
+func (s *PositionSource) Decode(buf *PacketBuffer) error {
+	v, err := buf.ReadVarInt()
+	if err != nil {
+		return fmt.Errorf("failed to read position source type: %w", err)
+	}
+	s.Type = PositionSourceType(v)
+	switch s.Type {
+	case PositionSourceBlock:
+		if s.Block, err = buf.ReadPosition(); err != nil {
+			return fmt.Errorf("failed to read position source block: %w", err)
+		}
+	case PositionSourceEntity:
+		if s.EntityID, err = buf.ReadVarInt(); err != nil {
+			return fmt.Errorf("failed to read position source entity: %w", err)
+		}
+		if s.YOffset, err = buf.ReadFloat32(); err != nil {
+			return fmt.Errorf("failed to read position source y offset: %w", err)
+		}
+	default:
+		return InvalidEnumError("position source type", int32(v))
+	}
+	return nil
+}
+
+// Encode writes a PositionSource to the buffer.
+func (s *PositionSource) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(s.Type)); err != nil {
+		return fmt.Errorf("failed to write position source type: %w", err)
+	}
+	switch s.Type {
+	case PositionSourceBlock:
+		if err := buf.WritePosition(s.Block); err != nil {
+			return fmt.Errorf("failed to write position source block: %w", err)
+		}
+	case PositionSourceEntity:
+		if err := buf.WriteVarInt(s.EntityID); err != nil {
+			return fmt.Errorf("failed to write position source entity: %w", err)
+		}
+		if err := buf.WriteFloat32(s.YOffset); err != nil {
+			return fmt.Errorf("failed to write position source y offset: %w", err)
+		}
+	default:
+		return InvalidEnumError("position source type", int32(s.Type))
+	}
+	return nil
+}
+
+// VibrationParticleOptions are the options of minecraft:vibration.
+//
+// Wire format:
+//
+//	┌────────────────────────────────┬───────────────────────────┐
+//	│  Destination (PositionSource)  │  Arrival Ticks (VarInt)   │
+//	└────────────────────────────────┴───────────────────────────┘
+type VibrationParticleOptions struct {
+	Destination  PositionSource
+	ArrivalTicks VarInt
+}
+
+// Decode reads VibrationParticleOptions from the buffer.
+func (o *VibrationParticleOptions) Decode(buf *PacketBuffer) error {
+	if err := o.Destination.Decode(buf); err != nil {
+		return fmt.Errorf("failed to read vibration destination: %w", err)
+	}
+	var err error
+	if o.ArrivalTicks, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read vibration arrival ticks: %w", err)
+	}
+	return nil
+}
+
+// Encode writes VibrationParticleOptions to the buffer.
+func (o *VibrationParticleOptions) Encode(buf *PacketBuffer) error {
+	if err := o.Destination.Encode(buf); err != nil {
+		return fmt.Errorf("failed to write vibration destination: %w", err)
+	}
+	if err := buf.WriteVarInt(o.ArrivalTicks); err != nil {
+		return fmt.Errorf("failed to write vibration arrival ticks: %w", err)
+	}
+	return nil
+}
+
+// TrailParticleOptions are the options of minecraft:trail.
+//
+// Wire format:
+//
+//	┌─────────────────┬────────────────┬─────────────────────┐
+//	│  Target (Vec3)  │  Color (Int)   │  Duration (VarInt)  │
+//	└─────────────────┴────────────────┴─────────────────────┘
+//
+// Color is RGB (0xRRGGBB); Duration is in ticks.
+type TrailParticleOptions struct {
+	Target   Vec3
+	Color    Int32
+	Duration VarInt
+}
+
+// Decode reads TrailParticleOptions from the buffer.
+func (o *TrailParticleOptions) Decode(buf *PacketBuffer) error {
+	if err := o.Target.Decode(buf); err != nil {
+		return fmt.Errorf("failed to read trail target: %w", err)
+	}
+	var err error
+	if o.Color, err = buf.ReadInt32(); err != nil {
+		return fmt.Errorf("failed to read trail color: %w", err)
+	}
+	if o.Duration, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read trail duration: %w", err)
+	}
+	return nil
+}
+
+// Encode writes TrailParticleOptions to the buffer.
+func (o *TrailParticleOptions) Encode(buf *PacketBuffer) error {
+	if err := o.Target.Encode(buf); err != nil {
+		return fmt.Errorf("failed to write trail target: %w", err)
+	}
+	if err := buf.WriteInt32(o.Color); err != nil {
+		return fmt.Errorf("failed to write trail color: %w", err)
+	}
+	if err := buf.WriteVarInt(o.Duration); err != nil {
+		return fmt.Errorf("failed to write trail duration: %w", err)
+	}
+	return nil
+}
+
+// SculkChargeParticleOptions are the options of minecraft:sculk_charge.
+//
+// Wire format:
+//
+//	┌────────────────┐
+//	│  Roll (Float)  │
+//	└────────────────┘
+//
+// Roll is in radians.
+type SculkChargeParticleOptions struct {
+	Roll Float32
+}
+
+// Decode reads SculkChargeParticleOptions from the buffer.
+func (o *SculkChargeParticleOptions) Decode(buf *PacketBuffer) error {
+	var err error
+	if o.Roll, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read sculk charge roll: %w", err)
+	}
+	return nil
+}
+
+// Encode writes SculkChargeParticleOptions to the buffer.
+func (o *SculkChargeParticleOptions) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteFloat32(o.Roll); err != nil {
+		return fmt.Errorf("failed to write sculk charge roll: %w", err)
+	}
+	return nil
+}
+
+// ShriekParticleOptions are the options of minecraft:shriek.
+//
+// Wire format:
+//
+//	┌──────────────────┐
+//	│  Delay (VarInt)  │
+//	└──────────────────┘
+//
+// Delay is in ticks.
+type ShriekParticleOptions struct {
+	Delay VarInt
+}
+
+// Decode reads ShriekParticleOptions from the buffer.
+func (o *ShriekParticleOptions) Decode(buf *PacketBuffer) error {
+	var err error
+	if o.Delay, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read shriek delay: %w", err)
+	}
+	return nil
+}
+
+// Encode writes ShriekParticleOptions to the buffer.
+func (o *ShriekParticleOptions) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(o.Delay); err != nil {
+		return fmt.Errorf("failed to write shriek delay: %w", err)
+	}
+	return nil
+}
+
+func init() {
+	RegisterEnumNames("position source type", positionSourceTypeNames)
+
+	RegisterParticleOptions(ParticleBlock, func() ParticleOptions { return &BlockParticleOptions{} })
+	RegisterParticleOptions(ParticleBlockCrumble, func() ParticleOptions { return &BlockParticleOptions{} })
+	RegisterParticleOptions(ParticleBlockMarker, func() ParticleOptions { return &BlockParticleOptions{} })
+	RegisterParticleOptions(ParticleDragonBreath, func() ParticleOptions { return &PowerParticleOptions{} })
+	RegisterParticleOptions(ParticleDust, func() ParticleOptions { return &DustParticleOptions{} })
+	RegisterParticleOptions(ParticleDustColorTransition, func() ParticleOptions { return &DustColorTransitionOptions{} })
+	RegisterParticleOptions(ParticleDustPillar, func() ParticleOptions { return &BlockParticleOptions{} })
+	RegisterParticleOptions(ParticleEffect, func() ParticleOptions { return &SpellParticleOptions{} })
+	RegisterParticleOptions(ParticleEntityEffect, func() ParticleOptions { return &ColorParticleOptions{} })
+	RegisterParticleOptions(ParticleFallingDust, func() ParticleOptions { return &BlockParticleOptions{} })
+	RegisterParticleOptions(ParticleFlash, func() ParticleOptions { return &ColorParticleOptions{} })
+	RegisterParticleOptions(ParticleInstantEffect, func() ParticleOptions { return &SpellParticleOptions{} })
+	RegisterParticleOptions(ParticleItem, func() ParticleOptions { return &ItemParticleOptions{} })
+	RegisterParticleOptions(ParticleSculkCharge, func() ParticleOptions { return &SculkChargeParticleOptions{} })
+	RegisterParticleOptions(ParticleShriek, func() ParticleOptions { return &ShriekParticleOptions{} })
+	RegisterParticleOptions(ParticleTintedLeaves, func() ParticleOptions { return &ColorParticleOptions{} })
+	RegisterParticleOptions(ParticleTrail, func() ParticleOptions { return &TrailParticleOptions{} })
+	RegisterParticleOptions(ParticleVibration, func() ParticleOptions { return &VibrationParticleOptions{} })
+}
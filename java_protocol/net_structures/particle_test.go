@@ -0,0 +1,86 @@
+package net_structures_test
+
+import (
+	"strings"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// testParticleTypes is a made-up particle type registry for tests.
+var testParticleTypes = ns.ParticleTypes{
+	"minecraft:flame",
+	ns.ParticleDust,
+	ns.ParticleItem,
+	ns.ParticleVibration,
+	ns.ParticleBlock,
+}
+
+func roundTripParticle(t *testing.T, in ns.Particle) ns.Particle {
+	t.Helper()
+	buf := ns.NewBuffer()
+	if err := in.Encode(buf); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	var out ns.Particle
+	if err := out.DecodeWith(buf, testParticleTypes, containerComponentTypes.Decoder()); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("%d trailing bytes", buf.Len())
+	}
+	return out
+}
+
+func TestParticle_RoundTrip(t *testing.T) {
+	flame, err := testParticleTypes.Particle("minecraft:flame", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out := roundTripParticle(t, flame); out.Type != 0 || out.Options != nil {
+		t.Errorf("unexpected particle: %+v", out)
+	}
+
+	dust, _ := testParticleTypes.Particle(ns.ParticleDust, &ns.DustParticleOptions{Color: 0xFF0000, Scale: 1.5})
+	out := roundTripParticle(t, dust)
+	if o, ok := out.Options.(*ns.DustParticleOptions); !ok || o.Color != 0xFF0000 || o.Scale != 1.5 {
+		t.Errorf("unexpected dust options: %#v", out.Options)
+	}
+
+	vibration, _ := testParticleTypes.Particle(ns.ParticleVibration, &ns.VibrationParticleOptions{
+		Destination:  ns.PositionSource{Type: ns.PositionSourceEntity, EntityID: 42, YOffset: 0.5},
+		ArrivalTicks: 20,
+	})
+	out = roundTripParticle(t, vibration)
+	if o, ok := out.Options.(*ns.VibrationParticleOptions); !ok || o.Destination.EntityID != 42 || o.ArrivalTicks != 20 {
+		t.Errorf("unexpected vibration options: %#v", out.Options)
+	}
+
+	if _, err := testParticleTypes.Particle("minecraft:unknown", nil); err == nil {
+		t.Error("expected error for unknown particle type")
+	}
+}
+
+func TestParticle_ItemWithComponents(t *testing.T) {
+	food, err := containerComponentTypes.Raw(ns.ComponentFood, &ns.Food{Nutrition: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	apple := ns.NewSlot(7, 1)
+	apple.Components.Add = []ns.RawSlotComponent{food}
+
+	item, _ := testParticleTypes.Particle(ns.ParticleItem, &ns.ItemParticleOptions{Item: apple})
+	out := roundTripParticle(t, item)
+	if o, ok := out.Options.(*ns.ItemParticleOptions); !ok || o.Item.ItemID != 7 || o.Item.GetComponent(2) == nil {
+		t.Errorf("unexpected item options: %#v", out.Options)
+	}
+}
+
+func TestParticle_UnknownType(t *testing.T) {
+	buf := ns.NewBuffer()
+	buf.WriteVarInt(99)
+	var p ns.Particle
+	if err := p.DecodeWith(buf, testParticleTypes, nil); err == nil || !strings.Contains(err.Error(), "unknown particle type id: 99") {
+		t.Errorf("expected unknown type error, got: %v", err)
+	}
+}
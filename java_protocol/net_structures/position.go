@@ -65,7 +65,7 @@ func UnpackPosition(val int64) Position {
 }
 
 // GlobalPos represents a position in a specific dimension.
-// Used for things like death locations.
+// Used for death locations (Login (play), Respawn) and the lodestone_tracker component.
 //
 // Wire format:
 //
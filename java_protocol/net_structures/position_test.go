@@ -91,3 +91,55 @@ func TestPosition_PackUnpack(t *testing.T) {
 		}
 	}
 }
+
+func TestGlobalPos(t *testing.T) {
+	// "minecraft:overworld" + position (0, 64, 0)
+	raw := append([]byte{0x13}, "minecraft:overworld"...)
+	raw = append(raw, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40)
+	expected := ns.GlobalPos{Dimension: "minecraft:overworld", Pos: ns.NewPosition(0, 64, 0)}
+
+	got, err := ns.NewReader(raw).ReadGlobalPos()
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if got != expected {
+		t.Errorf("decode mismatch: got %+v, want %+v", got, expected)
+	}
+
+	buf := ns.NewWriter()
+	if err := buf.WriteGlobalPos(expected); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), raw) {
+		t.Errorf("encode mismatch:\n  got:  %x\n  want: %x", buf.Bytes(), raw)
+	}
+}
+
+func TestOptionalGlobalPos(t *testing.T) {
+	// absent
+	got, err := ns.NewReader([]byte{0x00}).ReadOptionalGlobalPos()
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if got.Present {
+		t.Error("expected absent death location")
+	}
+
+	// present, round trip
+	pos := ns.GlobalPos{Dimension: "minecraft:the_nether", Pos: ns.NewPosition(-12, 40, 300)}
+	buf := ns.NewWriter()
+	if err := buf.WriteOptionalGlobalPos(ns.Some(pos)); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	if buf.Bytes()[0] != 0x01 {
+		t.Fatalf("expected presence byte 0x01, got 0x%02x", buf.Bytes()[0])
+	}
+
+	decoded, err := ns.NewReader(buf.Bytes()).ReadOptionalGlobalPos()
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if v, ok := decoded.Get(); !ok || v != pos {
+		t.Errorf("round-trip mismatch: got %+v (present=%v), want %+v", v, ok, pos)
+	}
+}
@@ -118,7 +118,21 @@ const (
 	ProfileComplete ResolvableProfileKind = 1
 )
 
+var resolvableProfileKindNames = EnumNames{
+	int32(ProfilePartial):  "partial",
+	int32(ProfileComplete): "complete",
+}
+
+func init() {
+	RegisterEnumNames("resolvable profile kind", resolvableProfileKindNames)
+}
+
+func (k ResolvableProfileKind) String() string {
+	return resolvableProfileKindNames.Name(int32(k))
+}
+
 // ResolvableProfile represents a player profile that can be either partial or complete.
+// It is the data of the minecraft:profile component (player heads).
 //
 // Wire format:
 //
@@ -221,7 +235,7 @@ func (p *ResolvableProfile) Decode(buf *PacketBuffer) error {
 		}
 
 	default:
-		return fmt.Errorf("unknown resolvable profile kind: %d", p.Kind)
+		return InvalidEnumError("resolvable profile kind", int32(p.Kind))
 	}
 	return nil
 }
@@ -283,7 +297,7 @@ func (p *ResolvableProfile) Encode(buf *PacketBuffer) error {
 		}
 
 	default:
-		return fmt.Errorf("unknown resolvable profile kind: %d", p.Kind)
+		return InvalidEnumError("resolvable profile kind", int32(p.Kind))
 	}
 	return nil
 }
@@ -0,0 +1,59 @@
+package net_structures
+
+import (
+	"fmt"
+
+	"github.com/go-mclib/protocol/nbt"
+)
+
+// RawNBT holds the exact network-format bytes of a single NBT tag.
+// Use it for passthrough fields (e.g. in proxies) where the NBT never needs to be
+// inspected: decoding skips over the tag without building a tree, and encoding
+// re-emits the captured bytes verbatim.
+//
+// An empty RawNBT (or a single TAG_End byte) represents absent NBT data.
+type RawNBT []byte
+
+// Decode captures a single network-format NBT tag from the buffer.
+func (n *RawNBT) Decode(buf *PacketBuffer) error {
+	data, err := nbt.NewReaderFrom(buf.Reader()).ReadRaw(true)
+	if err != nil {
+		return fmt.Errorf("failed to read raw nbt: %w", err)
+	}
+	*n = data
+	return nil
+}
+
+// Encode writes the captured bytes. An empty RawNBT is written as TAG_End.
+func (n RawNBT) Encode(buf *PacketBuffer) error {
+	if len(n) == 0 {
+		return buf.WriteByte(nbt.TagEnd)
+	}
+	_, err := buf.Write(n)
+	return err
+}
+
+// IsEmpty returns true if no tag is present (empty or TAG_End).
+func (n RawNBT) IsEmpty() bool {
+	return len(n) == 0 || (len(n) == 1 && n[0] == nbt.TagEnd)
+}
+
+// Tag decodes the captured bytes into an nbt.Tag.
+func (n RawNBT) Tag() (nbt.Tag, error) {
+	if len(n) == 0 {
+		return nbt.End{}, nil
+	}
+	return nbt.DecodeNetwork(n)
+}
+
+// ReadRawNBT reads a single NBT tag as raw bytes.
+func (pb *PacketBuffer) ReadRawNBT() (RawNBT, error) {
+	var n RawNBT
+	err := n.Decode(pb)
+	return n, err
+}
+
+// WriteRawNBT writes raw NBT bytes verbatim.
+func (pb *PacketBuffer) WriteRawNBT(n RawNBT) error {
+	return n.Encode(pb)
+}
@@ -0,0 +1,67 @@
+package net_structures_test
+
+import (
+	"bytes"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+)
+
+func TestRawNBT_Passthrough(t *testing.T) {
+	payload, err := nbt.EncodeNetwork(nbt.Compound{
+		"id":   nbt.String("proxy:action"),
+		"data": nbt.LongArray{1, 2, 3},
+	})
+	if err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	// NBT followed by a VarInt that must still be readable
+	raw := append(bytes.Clone(payload), 0x2a)
+
+	buf := ns.NewReader(raw)
+	got, err := buf.ReadRawNBT()
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if !bytes.Equal(got, payload) {
+		t.Errorf("captured bytes mismatch:\n  got:  %x\n  want: %x", []byte(got), payload)
+	}
+	if v, err := buf.ReadVarInt(); err != nil || v != 42 {
+		t.Errorf("trailing VarInt = %d, %v; want 42", v, err)
+	}
+
+	out := ns.NewWriter()
+	if err := out.WriteRawNBT(got); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	if !bytes.Equal(out.Bytes(), payload) {
+		t.Errorf("re-encoded bytes mismatch:\n  got:  %x\n  want: %x", out.Bytes(), payload)
+	}
+
+	tag, err := got.Tag()
+	if err != nil {
+		t.Fatalf("tag error: %v", err)
+	}
+	if tag.(nbt.Compound).GetString("id") != "proxy:action" {
+		t.Errorf("unexpected tag: %v", tag)
+	}
+}
+
+func TestRawNBT_Empty(t *testing.T) {
+	got, err := ns.NewReader([]byte{0x00}).ReadRawNBT()
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if !got.IsEmpty() {
+		t.Error("TAG_End should be empty")
+	}
+
+	out := ns.NewWriter()
+	if err := out.WriteRawNBT(nil); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	if !bytes.Equal(out.Bytes(), []byte{0x00}) {
+		t.Errorf("empty RawNBT should encode as TAG_End, got %x", out.Bytes())
+	}
+}
@@ -138,12 +138,12 @@ func (s *Slot) Decode(buf *PacketBuffer, decode SlotDecoder) error {
 		return fmt.Errorf("failed to read slot item id: %w", err)
 	}
 
-	addCount, err := buf.ReadVarInt()
+	addCount, err := buf.ReadCount()
 	if err != nil {
 		return fmt.Errorf("failed to read slot add count: %w", err)
 	}
 
-	removeCount, err := buf.ReadVarInt()
+	removeCount, err := buf.ReadCount()
 	if err != nil {
 		return fmt.Errorf("failed to read slot remove count: %w", err)
 	}
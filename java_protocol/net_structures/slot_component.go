@@ -0,0 +1,380 @@
+package net_structures
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// SlotComponent is the typed data of a single data component.
+//
+// Component type IDs depend on the minecraft:data_component_type registry of the
+// protocol version, so typed components are registered by name and resolved to
+// IDs through SlotComponentTypes.
+type SlotComponent interface {
+	Decode(buf *PacketBuffer) error
+	Encode(buf *PacketBuffer) error
+}
+
+// NestedSlotComponent is implemented by components that embed slots or slot
+// components (e.g. container contents). Their nested data needs a SlotDecoder, which SlotComponentTypes
+// provides with a nesting depth limit.
+type NestedSlotComponent interface {
+	SlotComponent
+	DecodeWith(buf *PacketBuffer, decode SlotDecoder) error
+}
+
+// MaxSlotDepth limits how deeply slots may be nested inside slot components
+// (e.g. a shulker box inside a bundle inside a shulker box).
+const MaxSlotDepth = 8
+
+// Data component type names with a typed implementation in this package.
+const (
+	ComponentAttackRange              Identifier = "minecraft:attack_range"
+	ComponentAttributeModifiers       Identifier = "minecraft:attribute_modifiers"
+	ComponentAxolotlVariant           Identifier = "minecraft:axolotl/variant"
+	ComponentBannerPatterns           Identifier = "minecraft:banner_patterns"
+	ComponentBaseColor                Identifier = "minecraft:base_color"
+	ComponentBees                     Identifier = "minecraft:bees"
+	ComponentBlockEntityData          Identifier = "minecraft:block_entity_data"
+	ComponentBlockState               Identifier = "minecraft:block_state"
+	ComponentBlocksAttacks            Identifier = "minecraft:blocks_attacks"
+	ComponentBreakSound               Identifier = "minecraft:break_sound"
+	ComponentBucketEntityData         Identifier = "minecraft:bucket_entity_data"
+	ComponentBundleContents           Identifier = "minecraft:bundle_contents"
+	ComponentCanBreak                 Identifier = "minecraft:can_break"
+	ComponentCanPlaceOn               Identifier = "minecraft:can_place_on"
+	ComponentCatCollar                Identifier = "minecraft:cat/collar"
+	ComponentCatVariant               Identifier = "minecraft:cat/variant"
+	ComponentChargedProjectiles       Identifier = "minecraft:charged_projectiles"
+	ComponentChickenVariant           Identifier = "minecraft:chicken/variant"
+	ComponentConsumable               Identifier = "minecraft:consumable"
+	ComponentContainer                Identifier = "minecraft:container"
+	ComponentCowVariant               Identifier = "minecraft:cow/variant"
+	ComponentCreativeSlotLock         Identifier = "minecraft:creative_slot_lock"
+	ComponentCustomData               Identifier = "minecraft:custom_data"
+	ComponentCustomModelData          Identifier = "minecraft:custom_model_data"
+	ComponentCustomName               Identifier = "minecraft:custom_name"
+	ComponentDamage                   Identifier = "minecraft:damage"
+	ComponentDamageResistant          Identifier = "minecraft:damage_resistant"
+	ComponentDamageType               Identifier = "minecraft:damage_type"
+	ComponentDeathProtection          Identifier = "minecraft:death_protection"
+	ComponentDebugStickState          Identifier = "minecraft:debug_stick_state"
+	ComponentDyedColor                Identifier = "minecraft:dyed_color"
+	ComponentEnchantable              Identifier = "minecraft:enchantable"
+	ComponentEnchantmentGlintOverride Identifier = "minecraft:enchantment_glint_override"
+	ComponentEnchantments             Identifier = "minecraft:enchantments"
+	ComponentEntityData               Identifier = "minecraft:entity_data"
+	ComponentEquippable               Identifier = "minecraft:equippable"
+	ComponentFireworkExplosion        Identifier = "minecraft:firework_explosion"
+	ComponentFireworks                Identifier = "minecraft:fireworks"
+	ComponentFood                     Identifier = "minecraft:food"
+	ComponentFoxVariant               Identifier = "minecraft:fox/variant"
+	ComponentFrogVariant              Identifier = "minecraft:frog/variant"
+	ComponentGlider                   Identifier = "minecraft:glider"
+	ComponentHorseVariant             Identifier = "minecraft:horse/variant"
+	ComponentInstrument               Identifier = "minecraft:instrument"
+	ComponentIntangibleProjectile     Identifier = "minecraft:intangible_projectile"
+	ComponentItemModel                Identifier = "minecraft:item_model"
+	ComponentItemName                 Identifier = "minecraft:item_name"
+	ComponentJukeboxPlayable          Identifier = "minecraft:jukebox_playable"
+	ComponentKineticWeapon            Identifier = "minecraft:kinetic_weapon"
+	ComponentLlamaVariant             Identifier = "minecraft:llama/variant"
+	ComponentLodestoneTracker         Identifier = "minecraft:lodestone_tracker"
+	ComponentLore                     Identifier = "minecraft:lore"
+	ComponentMapColor                 Identifier = "minecraft:map_color"
+	ComponentMapDecorations           Identifier = "minecraft:map_decorations"
+	ComponentMapID                    Identifier = "minecraft:map_id"
+	ComponentMapPostProcessing        Identifier = "minecraft:map_post_processing"
+	ComponentMaxDamage                Identifier = "minecraft:max_damage"
+	ComponentMaxStackSize             Identifier = "minecraft:max_stack_size"
+	ComponentMinimumAttackCharge      Identifier = "minecraft:minimum_attack_charge"
+	ComponentMooshroomVariant         Identifier = "minecraft:mooshroom/variant"
+	ComponentNoteBlockSound           Identifier = "minecraft:note_block_sound"
+	ComponentOminousBottleAmplifier   Identifier = "minecraft:ominous_bottle_amplifier"
+	ComponentPaintingVariant          Identifier = "minecraft:painting/variant"
+	ComponentParrotVariant            Identifier = "minecraft:parrot/variant"
+	ComponentPiercingWeapon           Identifier = "minecraft:piercing_weapon"
+	ComponentPigVariant               Identifier = "minecraft:pig/variant"
+	ComponentPotDecorations           Identifier = "minecraft:pot_decorations"
+	ComponentPotionContents           Identifier = "minecraft:potion_contents"
+	ComponentPotionDurationScale      Identifier = "minecraft:potion_duration_scale"
+	ComponentProfile                  Identifier = "minecraft:profile"
+	ComponentProvidesBannerPatterns   Identifier = "minecraft:provides_banner_patterns"
+	ComponentProvidesTrimMaterial     Identifier = "minecraft:provides_trim_material"
+	ComponentRabbitVariant            Identifier = "minecraft:rabbit/variant"
+	ComponentRarity                   Identifier = "minecraft:rarity"
+	ComponentRepairCost               Identifier = "minecraft:repair_cost"
+	ComponentRepairable               Identifier = "minecraft:repairable"
+	ComponentSalmonSize               Identifier = "minecraft:salmon/size"
+	ComponentSheepColor               Identifier = "minecraft:sheep/color"
+	ComponentShulkerColor             Identifier = "minecraft:shulker/color"
+	ComponentStoredEnchantments       Identifier = "minecraft:stored_enchantments"
+	ComponentSuspiciousStewEffects    Identifier = "minecraft:suspicious_stew_effects"
+	ComponentSwingAnimation           Identifier = "minecraft:swing_animation"
+	ComponentTool                     Identifier = "minecraft:tool"
+	ComponentTooltipDisplay           Identifier = "minecraft:tooltip_display"
+	ComponentTooltipStyle             Identifier = "minecraft:tooltip_style"
+	ComponentTrim                     Identifier = "minecraft:trim"
+	ComponentTropicalFishBaseColor    Identifier = "minecraft:tropical_fish/base_color"
+	ComponentTropicalFishPattern      Identifier = "minecraft:tropical_fish/pattern"
+	ComponentTropicalFishPatternColor Identifier = "minecraft:tropical_fish/pattern_color"
+	ComponentUnbreakable              Identifier = "minecraft:unbreakable"
+	ComponentUseCooldown              Identifier = "minecraft:use_cooldown"
+	ComponentUseEffects               Identifier = "minecraft:use_effects"
+	ComponentUseRemainder             Identifier = "minecraft:use_remainder"
+	ComponentVillagerVariant          Identifier = "minecraft:villager/variant"
+	ComponentWeapon                   Identifier = "minecraft:weapon"
+	ComponentWolfCollar               Identifier = "minecraft:wolf/collar"
+	ComponentWolfSoundVariant         Identifier = "minecraft:wolf/sound_variant"
+	ComponentWolfVariant              Identifier = "minecraft:wolf/variant"
+	ComponentWritableBookContent      Identifier = "minecraft:writable_book_content"
+	ComponentWrittenBookContent       Identifier = "minecraft:written_book_content"
+	ComponentZombieNautilusVariant    Identifier = "minecraft:zombie_nautilus/variant"
+)
+
+var (
+	slotComponentsMu sync.RWMutex
+	slotComponents   = map[Identifier]func() SlotComponent{}
+)
+
+// RegisterSlotComponent registers (or replaces) the typed implementation of a
+// data component. Higher-level packages can register components that are not
+// implemented here.
+func RegisterSlotComponent(name Identifier, factory func() SlotComponent) {
+	slotComponentsMu.Lock()
+	defer slotComponentsMu.Unlock()
+	slotComponents[name] = factory
+}
+
+// NewSlotComponent returns a new, empty typed component for the given name.
+func NewSlotComponent(name Identifier) (SlotComponent, bool) {
+	slotComponentsMu.RLock()
+	factory, ok := slotComponents[name]
+	slotComponentsMu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return factory(), true
+}
+
+// SlotComponentTypes lists the data component type names in registry ID order,
+// i.e. SlotComponentTypes[id] is the name of component type id. The list comes
+// from the minecraft:data_component_type registry of the targeted version.
+type SlotComponentTypes []Identifier
+
+// Name returns the name of the component type with the given ID.
+func (t SlotComponentTypes) Name(id VarInt) (Identifier, bool) {
+	if id < 0 || int(id) >= len(t) {
+		return "", false
+	}
+	return t[id], true
+}
+
+// ID returns the registry ID of the named component type.
+func (t SlotComponentTypes) ID(name Identifier) (VarInt, bool) {
+	for i, n := range t {
+		if n == name {
+			return VarInt(i), true
+		}
+	}
+	return 0, false
+}
+
+// Decoder returns a SlotDecoder that finds component boundaries by decoding
+// each component with its registered typed implementation. Components without
+// one cannot be skipped and fail the decode.
+func (t SlotComponentTypes) Decoder() SlotDecoder {
+	return t.decoder(0)
+}
+
+func (t SlotComponentTypes) decoder(depth int) SlotDecoder {
+	return func(buf *PacketBuffer, componentID VarInt) ([]byte, error) {
+		c, name, err := t.newComponent(componentID)
+		if err != nil {
+			return nil, err
+		}
+		if buf.reader == nil {
+			return nil, fmt.Errorf("buffer not in read mode")
+		}
+		var raw bytes.Buffer
+		if err := t.decodeComponent(c, NewReaderFrom(teeReader(buf.reader, &raw)), depth); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", name, err)
+		}
+		return raw.Bytes(), nil
+	}
+}
+
+// teeReader is io.TeeReader that keeps reporting the unread length of r, so
+// counts read from the tee can still be checked against the remaining bytes.
+func teeReader(r io.Reader, w io.Writer) io.Reader {
+	if l, ok := r.(interface{ Len() int }); ok {
+		return lenTeeReader{io.TeeReader(r, w), l}
+	}
+	return io.TeeReader(r, w)
+}
+
+type lenTeeReader struct {
+	io.Reader
+	src interface{ Len() int }
+}
+
+func (r lenTeeReader) Len() int { return r.src.Len() }
+
+// Parse decodes the data of a raw component into its typed implementation.
+func (t SlotComponentTypes) Parse(raw RawSlotComponent) (SlotComponent, error) {
+	c, name, err := t.newComponent(raw.ID)
+	if err != nil {
+		return nil, err
+	}
+	r := bytes.NewReader(raw.Data)
+	if err := t.decodeComponent(c, NewReaderFrom(r), 0); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", name, err)
+	}
+	if r.Len() > 0 {
+		return nil, fmt.Errorf("failed to decode %s: %d trailing bytes", name, r.Len())
+	}
+	return c, nil
+}
+
+// Raw encodes a typed component into a RawSlotComponent with the ID of the given name.
+func (t SlotComponentTypes) Raw(name Identifier, c SlotComponent) (RawSlotComponent, error) {
+	id, ok := t.ID(name)
+	if !ok {
+		return RawSlotComponent{}, fmt.Errorf("unknown component type: %s", name)
+	}
+	buf := NewWriter()
+	if err := c.Encode(buf); err != nil {
+		return RawSlotComponent{}, fmt.Errorf("failed to encode %s: %w", name, err)
+	}
+	return RawSlotComponent{ID: id, Data: buf.Bytes()}, nil
+}
+
+// decodeComponent decodes c, passing a decoder for nested slots one level deeper.
+func (t SlotComponentTypes) decodeComponent(c SlotComponent, buf *PacketBuffer, depth int) error {
+	nested, ok := c.(NestedSlotComponent)
+	if !ok {
+		return c.Decode(buf)
+	}
+	if depth >= MaxSlotDepth {
+		return fmt.Errorf("slots nested deeper than %d", MaxSlotDepth)
+	}
+	return nested.DecodeWith(buf, t.decoder(depth+1))
+}
+
+func (t SlotComponentTypes) newComponent(id VarInt) (SlotComponent, Identifier, error) {
+	name, ok := t.Name(id)
+	if !ok {
+		return nil, "", fmt.Errorf("unknown component type id: %d", id)
+	}
+	c, ok := NewSlotComponent(name)
+	if !ok {
+		return nil, name, fmt.Errorf("unsupported component: %s", name)
+	}
+	return c, name, nil
+}
+
+func init() {
+	RegisterSlotComponent(ComponentAttackRange, func() SlotComponent { return &AttackRange{} })
+	RegisterSlotComponent(ComponentAttributeModifiers, func() SlotComponent { return &AttributeModifiers{} })
+	RegisterSlotComponent(ComponentAxolotlVariant, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentBannerPatterns, func() SlotComponent { return &BannerPatterns{} })
+	RegisterSlotComponent(ComponentBaseColor, func() SlotComponent { return &DyeColorComponent{} })
+	RegisterSlotComponent(ComponentBees, func() SlotComponent { return &Bees{} })
+	RegisterSlotComponent(ComponentBlockEntityData, func() SlotComponent { return &TypedEntityData{} })
+	RegisterSlotComponent(ComponentBlockState, func() SlotComponent { return &BlockStateProperties{} })
+	RegisterSlotComponent(ComponentBlocksAttacks, func() SlotComponent { return &BlocksAttacks{} })
+	RegisterSlotComponent(ComponentBreakSound, func() SlotComponent { return &BreakSound{} })
+	RegisterSlotComponent(ComponentBucketEntityData, func() SlotComponent { return &CustomData{} })
+	RegisterSlotComponent(ComponentBundleContents, func() SlotComponent { return &BundleContents{} })
+	RegisterSlotComponent(ComponentCanBreak, func() SlotComponent { return &AdventureModePredicate{} })
+	RegisterSlotComponent(ComponentCanPlaceOn, func() SlotComponent { return &AdventureModePredicate{} })
+	RegisterSlotComponent(ComponentCatCollar, func() SlotComponent { return &DyeColorComponent{} })
+	RegisterSlotComponent(ComponentCatVariant, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentChargedProjectiles, func() SlotComponent { return &ChargedProjectiles{} })
+	RegisterSlotComponent(ComponentChickenVariant, func() SlotComponent { return &ChickenVariant{} })
+	RegisterSlotComponent(ComponentConsumable, func() SlotComponent { return &Consumable{} })
+	RegisterSlotComponent(ComponentContainer, func() SlotComponent { return &ItemContainerContents{} })
+	RegisterSlotComponent(ComponentCowVariant, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentCreativeSlotLock, func() SlotComponent { return &UnitComponent{} })
+	RegisterSlotComponent(ComponentCustomData, func() SlotComponent { return &CustomData{} })
+	RegisterSlotComponent(ComponentCustomModelData, func() SlotComponent { return &CustomModelData{} })
+	RegisterSlotComponent(ComponentCustomName, func() SlotComponent { return &DisplayName{} })
+	RegisterSlotComponent(ComponentDamage, func() SlotComponent { return &Damage{} })
+	RegisterSlotComponent(ComponentDamageResistant, func() SlotComponent { return &IdentifierComponent{} })
+	RegisterSlotComponent(ComponentDamageType, func() SlotComponent { return &DamageTypeComponent{} })
+	RegisterSlotComponent(ComponentDeathProtection, func() SlotComponent { return &DeathProtection{} })
+	RegisterSlotComponent(ComponentDebugStickState, func() SlotComponent { return &CustomData{} })
+	RegisterSlotComponent(ComponentDyedColor, func() SlotComponent { return &DyedColor{} })
+	RegisterSlotComponent(ComponentEnchantable, func() SlotComponent { return &Enchantable{} })
+	RegisterSlotComponent(ComponentEnchantmentGlintOverride, func() SlotComponent { return &EnchantmentGlintOverride{} })
+	RegisterSlotComponent(ComponentEnchantments, func() SlotComponent { return &Enchantments{} })
+	RegisterSlotComponent(ComponentEntityData, func() SlotComponent { return &TypedEntityData{} })
+	RegisterSlotComponent(ComponentEquippable, func() SlotComponent { return &Equippable{} })
+	RegisterSlotComponent(ComponentFireworkExplosion, func() SlotComponent { return &FireworkExplosion{} })
+	RegisterSlotComponent(ComponentFireworks, func() SlotComponent { return &Fireworks{} })
+	RegisterSlotComponent(ComponentFood, func() SlotComponent { return &Food{} })
+	RegisterSlotComponent(ComponentFoxVariant, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentFrogVariant, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentGlider, func() SlotComponent { return &UnitComponent{} })
+	RegisterSlotComponent(ComponentHorseVariant, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentInstrument, func() SlotComponent { return &InstrumentComponent{} })
+	RegisterSlotComponent(ComponentIntangibleProjectile, func() SlotComponent { return &UnitComponent{} })
+	RegisterSlotComponent(ComponentItemModel, func() SlotComponent { return &ItemModel{} })
+	RegisterSlotComponent(ComponentItemName, func() SlotComponent { return &DisplayName{} })
+	RegisterSlotComponent(ComponentJukeboxPlayable, func() SlotComponent { return &JukeboxPlayable{} })
+	RegisterSlotComponent(ComponentKineticWeapon, func() SlotComponent { return &KineticWeapon{} })
+	RegisterSlotComponent(ComponentLlamaVariant, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentLodestoneTracker, func() SlotComponent { return &LodestoneTracker{} })
+	RegisterSlotComponent(ComponentLore, func() SlotComponent { return &Lore{} })
+	RegisterSlotComponent(ComponentMapColor, func() SlotComponent { return &MapColor{} })
+	RegisterSlotComponent(ComponentMapDecorations, func() SlotComponent { return &MapDecorations{} })
+	RegisterSlotComponent(ComponentMapID, func() SlotComponent { return &MapID{} })
+	RegisterSlotComponent(ComponentMapPostProcessing, func() SlotComponent { return &MapPostProcessingComponent{} })
+	RegisterSlotComponent(ComponentMaxDamage, func() SlotComponent { return &MaxDamage{} })
+	RegisterSlotComponent(ComponentMaxStackSize, func() SlotComponent { return &MaxStackSize{} })
+	RegisterSlotComponent(ComponentMinimumAttackCharge, func() SlotComponent { return &MinimumAttackCharge{} })
+	RegisterSlotComponent(ComponentMooshroomVariant, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentNoteBlockSound, func() SlotComponent { return &IdentifierComponent{} })
+	RegisterSlotComponent(ComponentOminousBottleAmplifier, func() SlotComponent { return &OminousBottleAmplifier{} })
+	RegisterSlotComponent(ComponentPaintingVariant, func() SlotComponent { return &PaintingVariantComponent{} })
+	RegisterSlotComponent(ComponentParrotVariant, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentPiercingWeapon, func() SlotComponent { return &PiercingWeapon{} })
+	RegisterSlotComponent(ComponentPigVariant, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentPotDecorations, func() SlotComponent { return &PotDecorations{} })
+	RegisterSlotComponent(ComponentPotionContents, func() SlotComponent { return &PotionContents{} })
+	RegisterSlotComponent(ComponentPotionDurationScale, func() SlotComponent { return &PotionDurationScale{} })
+	RegisterSlotComponent(ComponentProfile, func() SlotComponent { return &ResolvableProfile{} })
+	RegisterSlotComponent(ComponentProvidesBannerPatterns, func() SlotComponent { return &IdentifierComponent{} })
+	RegisterSlotComponent(ComponentProvidesTrimMaterial, func() SlotComponent { return &ProvidesTrimMaterial{} })
+	RegisterSlotComponent(ComponentRabbitVariant, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentRarity, func() SlotComponent { return &ItemRarity{} })
+	RegisterSlotComponent(ComponentRepairCost, func() SlotComponent { return &RepairCost{} })
+	RegisterSlotComponent(ComponentRepairable, func() SlotComponent { return &Repairable{} })
+	RegisterSlotComponent(ComponentSalmonSize, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentSheepColor, func() SlotComponent { return &DyeColorComponent{} })
+	RegisterSlotComponent(ComponentShulkerColor, func() SlotComponent { return &DyeColorComponent{} })
+	RegisterSlotComponent(ComponentStoredEnchantments, func() SlotComponent { return &Enchantments{} })
+	RegisterSlotComponent(ComponentSuspiciousStewEffects, func() SlotComponent { return &SuspiciousStewEffects{} })
+	RegisterSlotComponent(ComponentSwingAnimation, func() SlotComponent { return &SwingAnimation{} })
+	RegisterSlotComponent(ComponentTool, func() SlotComponent { return &Tool{} })
+	RegisterSlotComponent(ComponentTooltipDisplay, func() SlotComponent { return &TooltipDisplay{} })
+	RegisterSlotComponent(ComponentTooltipStyle, func() SlotComponent { return &IdentifierComponent{} })
+	RegisterSlotComponent(ComponentTrim, func() SlotComponent { return &ArmorTrim{} })
+	RegisterSlotComponent(ComponentTropicalFishBaseColor, func() SlotComponent { return &DyeColorComponent{} })
+	RegisterSlotComponent(ComponentTropicalFishPattern, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentTropicalFishPatternColor, func() SlotComponent { return &DyeColorComponent{} })
+	RegisterSlotComponent(ComponentUnbreakable, func() SlotComponent { return &UnitComponent{} })
+	RegisterSlotComponent(ComponentUseCooldown, func() SlotComponent { return &UseCooldown{} })
+	RegisterSlotComponent(ComponentUseEffects, func() SlotComponent { return &UseEffects{} })
+	RegisterSlotComponent(ComponentUseRemainder, func() SlotComponent { return &UseRemainder{} })
+	RegisterSlotComponent(ComponentVillagerVariant, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentWeapon, func() SlotComponent { return &Weapon{} })
+	RegisterSlotComponent(ComponentWolfCollar, func() SlotComponent { return &DyeColorComponent{} })
+	RegisterSlotComponent(ComponentWolfSoundVariant, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentWolfVariant, func() SlotComponent { return &EntityVariant{} })
+	RegisterSlotComponent(ComponentWritableBookContent, func() SlotComponent { return &WritableBookContent{} })
+	RegisterSlotComponent(ComponentWrittenBookContent, func() SlotComponent { return &WrittenBookContent{} })
+	RegisterSlotComponent(ComponentZombieNautilusVariant, func() SlotComponent { return &EntityVariant{} })
+}
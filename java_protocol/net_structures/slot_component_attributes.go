@@ -0,0 +1,299 @@
+package net_structures
+
+import "fmt"
+
+// AttributeModifierOperation is how a modifier's value is applied to an attribute.
+type AttributeModifierOperation VarInt
+
+const (
+	AttributeAddValue           AttributeModifierOperation = 0
+	AttributeAddMultipliedBase  AttributeModifierOperation = 1
+	AttributeAddMultipliedTotal AttributeModifierOperation = 2
+)
+
+var attributeModifierOperationNames = EnumNames{
+	int32(AttributeAddValue):           "add_value",
+	int32(AttributeAddMultipliedBase):  "add_multiplied_base",
+	int32(AttributeAddMultipliedTotal): "add_multiplied_total",
+}
+
+func (o AttributeModifierOperation) String() string {
+	return attributeModifierOperationNames.Name(int32(o))
+}
+
+// EquipmentSlotGroup is the group of equipment slots an item must be in for a
+// modifier (or an enchantment effect) to apply.
+type EquipmentSlotGroup VarInt
+
+const (
+	SlotGroupAny      EquipmentSlotGroup = 0
+	SlotGroupMainHand EquipmentSlotGroup = 1
+	SlotGroupOffHand  EquipmentSlotGroup = 2
+	SlotGroupHand     EquipmentSlotGroup = 3
+	SlotGroupFeet     EquipmentSlotGroup = 4
+	SlotGroupLegs     EquipmentSlotGroup = 5
+	SlotGroupChest    EquipmentSlotGroup = 6
+	SlotGroupHead     EquipmentSlotGroup = 7
+	SlotGroupArmor    EquipmentSlotGroup = 8
+	SlotGroupBody     EquipmentSlotGroup = 9
+	SlotGroupSaddle   EquipmentSlotGroup = 10
+)
+
+var equipmentSlotGroupNames = EnumNames{
+	int32(SlotGroupAny):      "any",
+	int32(SlotGroupMainHand): "mainhand",
+	int32(SlotGroupOffHand):  "offhand",
+	int32(SlotGroupHand):     "hand",
+	int32(SlotGroupFeet):     "feet",
+	int32(SlotGroupLegs):     "legs",
+	int32(SlotGroupChest):    "chest",
+	int32(SlotGroupHead):     "head",
+	int32(SlotGroupArmor):    "armor",
+	int32(SlotGroupBody):     "body",
+	int32(SlotGroupSaddle):   "saddle",
+}
+
+func (g EquipmentSlotGroup) String() string {
+	return equipmentSlotGroupNames.Name(int32(g))
+}
+
+// AttributeModifierDisplayType controls how a modifier is shown in the tooltip.
+type AttributeModifierDisplayType VarInt
+
+const (
+	AttributeDisplayDefault  AttributeModifierDisplayType = 0
+	AttributeDisplayHidden   AttributeModifierDisplayType = 1
+	AttributeDisplayOverride AttributeModifierDisplayType = 2
+)
+
+var attributeModifierDisplayTypeNames = EnumNames{
+	int32(AttributeDisplayDefault):  "default",
+	int32(AttributeDisplayHidden):   "hidden",
+	int32(AttributeDisplayOverride): "override",
+}
+
+func (d AttributeModifierDisplayType) String() string {
+	return attributeModifierDisplayTypeNames.Name(int32(d))
+}
+
+// AttributeModifier is a single entry of the minecraft:attribute_modifiers component.
+//
+// Wire format:
+//
+//	┌──────────────────────┬─────────────────────────┬────────────────┬───────────────────────┐
+//	│  Attribute (VarInt)  │  Modifier ID (Ident.)   │  Value (Double)│  Operation (VarInt)   │
+//	├──────────────────────┼─────────────────────────┴────────────────┴───────────────────────┤
+//	│  Slot (VarInt)       │  Display Type (VarInt) + Text Component (only if override)       │
+//	└──────────────────────┴──────────────────────────────────────────────────────────────────┘
+type AttributeModifier struct {
+	// Attribute is the registry ID from minecraft:attribute.
+	Attribute VarInt
+	// ID identifies the modifier; modifiers with the same ID on one attribute do not stack.
+	ID        Identifier
+	Value     Float64
+	Operation AttributeModifierOperation
+	Slot      EquipmentSlotGroup
+
+	DisplayType AttributeModifierDisplayType
+	// Display replaces the tooltip line (only if DisplayType is AttributeDisplayOverride).
+	Display TextComponent
+}
+
+// Decode reads an AttributeModifier from the buffer.
+func (m *AttributeModifier) Decode(buf *PacketBuffer) error {
+	var err error
+	if m.Attribute, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read attribute id: %w", err)
+	}
+	if m.ID, err = buf.ReadIdentifier(); err != nil {
+		return fmt.Errorf("failed to read modifier id: %w", err)
+	}
+	if m.Value, err = buf.ReadFloat64(); err != nil {
+		return fmt.Errorf("failed to read modifier value: %w", err)
+	}
+	op, err := buf.ReadVarInt()
+	if err != nil {
+		return fmt.Errorf("failed to read modifier operation: %w", err)
+	}
+	if _, ok := attributeModifierOperationNames[int32(op)]; !ok {
+		return InvalidEnumError("attribute modifier operation", int32(op))
+	}
+	m.Operation = AttributeModifierOperation(op)
+
+	slot, err := buf.ReadVarInt()
+	if err != nil {
+		return fmt.Errorf("failed to read modifier slot: %w", err)
+	}
+	if _, ok := equipmentSlotGroupNames[int32(slot)]; !ok {
+		return InvalidEnumError("equipment slot group", int32(slot))
+	}
+	m.Slot = EquipmentSlotGroup(slot)
+
+	display, err := buf.ReadVarInt()
+	if err != nil {
+		return fmt.Errorf("failed to read modifier display type: %w", err)
+	}
+	if _, ok := attributeModifierDisplayTypeNames[int32(display)]; !ok {
+		return InvalidEnumError("attribute modifier display", int32(display))
+	}
+	m.DisplayType = AttributeModifierDisplayType(display)
+	if m.DisplayType == AttributeDisplayOverride {
+		if m.Display, err = buf.ReadTextComponent(); err != nil {
+			return fmt.Errorf("failed to read modifier display: %w", err)
+		}
+	}
+	return nil
+}
+
+// Encode writes an AttributeModifier to the buffer.
+func (m *AttributeModifier) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(m.Attribute); err != nil {
+		return fmt.Errorf("failed to write attribute id: %w", err)
+	}
+	if err := buf.WriteIdentifier(m.ID); err != nil {
+		return fmt.Errorf("failed to write modifier id: %w", err)
+	}
+	if err := buf.WriteFloat64(m.Value); err != nil {
+		return fmt.Errorf("failed to write modifier value: %w", err)
+	}
+	if err := buf.WriteVarInt(VarInt(m.Operation)); err != nil {
+		return fmt.Errorf("failed to write modifier operation: %w", err)
+	}
+	if err := buf.WriteVarInt(VarInt(m.Slot)); err != nil {
+		return fmt.Errorf("failed to write modifier slot: %w", err)
+	}
+	if err := buf.WriteVarInt(VarInt(m.DisplayType)); err != nil {
+		return fmt.Errorf("failed to write modifier display type: %w", err)
+	}
+	if m.DisplayType == AttributeDisplayOverride {
+		if err := buf.WriteTextComponent(m.Display); err != nil {
+			return fmt.Errorf("failed to write modifier display: %w", err)
+		}
+	}
+	return nil
+}
+
+// AttributeModifiers is the data of the minecraft:attribute_modifiers component.
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────────────────┐
+//	│  Modifiers (Prefixed Array of AttributeModifier)│
+//	└─────────────────────────────────────────────────┘
+type AttributeModifiers struct {
+	Modifiers []AttributeModifier
+}
+
+// Decode reads AttributeModifiers from the buffer.
+func (a *AttributeModifiers) Decode(buf *PacketBuffer) error {
+	count, err := buf.ReadCount()
+	if err != nil {
+		return fmt.Errorf("failed to read modifier count: %w", err)
+	}
+	a.Modifiers = make([]AttributeModifier, count)
+	for i := range a.Modifiers {
+		if err := a.Modifiers[i].Decode(buf); err != nil {
+			return fmt.Errorf("failed to read modifier %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Encode writes AttributeModifiers to the buffer.
+func (a *AttributeModifiers) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(len(a.Modifiers))); err != nil {
+		return fmt.Errorf("failed to write modifier count: %w", err)
+	}
+	for i := range a.Modifiers {
+		if err := a.Modifiers[i].Encode(buf); err != nil {
+			return fmt.Errorf("failed to write modifier %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// TooltipDisplay is the data of the minecraft:tooltip_display component. It replaced
+// the hide_tooltip and hide_additional_tooltip components and the per-component
+// show_in_tooltip flags in 1.21.5.
+//
+// Wire format:
+//
+//	┌────────────────────────┬───────────────────────────────────────────────┐
+//	│  Hide Tooltip (Bool)   │  Hidden Components (Prefixed Array of VarInt) │
+//	└────────────────────────┴───────────────────────────────────────────────┘
+type TooltipDisplay struct {
+	// HideTooltip hides the whole tooltip.
+	HideTooltip Boolean
+	// HiddenComponents are the component type IDs whose tooltip lines are hidden.
+	HiddenComponents []VarInt
+}
+
+// Hides reports whether the tooltip lines of the given component type are hidden.
+func (t *TooltipDisplay) Hides(componentID VarInt) bool {
+	if t.HideTooltip {
+		return true
+	}
+	for _, id := range t.HiddenComponents {
+		if id == componentID {
+			return true
+		}
+	}
+	return false
+}
+
+// Decode reads a TooltipDisplay from the buffer.
+func (t *TooltipDisplay) Decode(buf *PacketBuffer) error {
+	var err error
+	if t.HideTooltip, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read hide tooltip: %w", err)
+	}
+	if t.HiddenComponents, err = readVarIntList(buf); err != nil {
+		return fmt.Errorf("failed to read hidden components: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a TooltipDisplay to the buffer.
+func (t *TooltipDisplay) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteBool(t.HideTooltip); err != nil {
+		return fmt.Errorf("failed to write hide tooltip: %w", err)
+	}
+	if err := writeVarIntList(buf, t.HiddenComponents); err != nil {
+		return fmt.Errorf("failed to write hidden components: %w", err)
+	}
+	return nil
+}
+
+// readVarIntList reads a VarInt-prefixed list of VarInts.
+func readVarIntList(buf *PacketBuffer) ([]VarInt, error) {
+	count, err := buf.ReadCount()
+	if err != nil {
+		return nil, err
+	}
+	list := make([]VarInt, count)
+	for i := range list {
+		if list[i], err = buf.ReadVarInt(); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// writeVarIntList writes a VarInt-prefixed list of VarInts.
+func writeVarIntList(buf *PacketBuffer, list []VarInt) error {
+	if err := buf.WriteVarInt(VarInt(len(list))); err != nil {
+		return err
+	}
+	for _, v := range list {
+		if err := buf.WriteVarInt(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func init() {
+	RegisterEnumNames("attribute modifier operation", attributeModifierOperationNames)
+	RegisterEnumNames("equipment slot group", equipmentSlotGroupNames)
+	RegisterEnumNames("attribute modifier display", attributeModifierDisplayTypeNames)
+}
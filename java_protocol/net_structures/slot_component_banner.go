@@ -0,0 +1,162 @@
+package net_structures
+
+import "fmt"
+
+// MaxPotDecorations is the number of sides of a decorated pot.
+const MaxPotDecorations = 4
+
+// BannerPattern is an inline minecraft:banner_pattern registry entry.
+//
+// Wire format:
+//
+//	┌──────────────────────────┬───────────────────────────┐
+//	│  Asset ID (Identifier)   │  Translation Key (String) │
+//	└──────────────────────────┴───────────────────────────┘
+//
+// The struct tags give its registry data form, as sent in the Registry Data
+// packet during configuration.
+type BannerPattern struct {
+	AssetID        Identifier `nbt:"asset_id"`
+	TranslationKey String     `nbt:"translation_key"`
+}
+
+// Decode reads a BannerPattern from the buffer.
+func (p *BannerPattern) Decode(buf *PacketBuffer) error {
+	var err error
+	if p.AssetID, err = buf.ReadIdentifier(); err != nil {
+		return fmt.Errorf("failed to read pattern asset id: %w", err)
+	}
+	if p.TranslationKey, err = buf.ReadString(32767); err != nil {
+		return fmt.Errorf("failed to read pattern translation key: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a BannerPattern to the buffer.
+func (p *BannerPattern) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteIdentifier(p.AssetID); err != nil {
+		return fmt.Errorf("failed to write pattern asset id: %w", err)
+	}
+	if err := buf.WriteString(p.TranslationKey); err != nil {
+		return fmt.Errorf("failed to write pattern translation key: %w", err)
+	}
+	return nil
+}
+
+// BannerLayer is a single pattern layer of a banner or shield.
+//
+// Wire format:
+//
+//	┌───────────────────────────────────┬──────────────────┐
+//	│  Pattern (ID or BannerPattern)    │  Color (VarInt)  │
+//	└───────────────────────────────────┴──────────────────┘
+type BannerLayer struct {
+	Pattern IDOrX[BannerPattern]
+	Color   DyeColor
+}
+
+// Decode reads a BannerLayer from the buffer.
+func (l *BannerLayer) Decode(buf *PacketBuffer) error {
+	if err := l.Pattern.DecodeWith(buf, func(b *PacketBuffer) (BannerPattern, error) {
+		var p BannerPattern
+		err := p.Decode(b)
+		return p, err
+	}); err != nil {
+		return fmt.Errorf("failed to read layer pattern: %w", err)
+	}
+	var err error
+	if l.Color, err = buf.ReadDyeColor(); err != nil {
+		return fmt.Errorf("failed to read layer color: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a BannerLayer to the buffer.
+func (l *BannerLayer) Encode(buf *PacketBuffer) error {
+	if err := l.Pattern.EncodeWith(buf, func(b *PacketBuffer, p BannerPattern) error {
+		return p.Encode(b)
+	}); err != nil {
+		return fmt.Errorf("failed to write layer pattern: %w", err)
+	}
+	if err := buf.WriteDyeColor(l.Color); err != nil {
+		return fmt.Errorf("failed to write layer color: %w", err)
+	}
+	return nil
+}
+
+// BannerPatterns is the data of the minecraft:banner_patterns component.
+// Layers are applied bottom to top, on top of the banner's base color.
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────────┐
+//	│  Layers (Prefixed Array of BannerLayer) │
+//	└─────────────────────────────────────────┘
+type BannerPatterns struct {
+	Layers []BannerLayer
+}
+
+// Decode reads BannerPatterns from the buffer.
+func (b *BannerPatterns) Decode(buf *PacketBuffer) error {
+	count, err := buf.ReadCount()
+	if err != nil {
+		return fmt.Errorf("failed to read layer count: %w", err)
+	}
+	b.Layers = make([]BannerLayer, count)
+	for i := range b.Layers {
+		if err := b.Layers[i].Decode(buf); err != nil {
+			return fmt.Errorf("failed to read layer %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Encode writes BannerPatterns to the buffer.
+func (b *BannerPatterns) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(len(b.Layers))); err != nil {
+		return fmt.Errorf("failed to write layer count: %w", err)
+	}
+	for i := range b.Layers {
+		if err := b.Layers[i].Encode(buf); err != nil {
+			return fmt.Errorf("failed to write layer %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// PotDecorations is the data of the minecraft:pot_decorations component.
+//
+// Wire format:
+//
+//	┌──────────────────────────────────────────────────┐
+//	│  Decorations (Prefixed Array of VarInt, max 4)   │
+//	└──────────────────────────────────────────────────┘
+//
+// Decorations are minecraft:item registry IDs (pottery sherds or brick) in the
+// order back, left, right, front. Missing trailing sides are plain brick.
+type PotDecorations struct {
+	Decorations []VarInt
+}
+
+// Decode reads PotDecorations from the buffer.
+func (p *PotDecorations) Decode(buf *PacketBuffer) error {
+	var err error
+	if p.Decorations, err = readVarIntList(buf); err != nil {
+		return fmt.Errorf("failed to read pot decorations: %w", err)
+	}
+	if len(p.Decorations) > MaxPotDecorations {
+		return fmt.Errorf("too many pot decorations: %d (max %d)", len(p.Decorations), MaxPotDecorations)
+	}
+	return nil
+}
+
+// Encode writes PotDecorations to the buffer.
+func (p *PotDecorations) Encode(buf *PacketBuffer) error {
+	if len(p.Decorations) > MaxPotDecorations {
+		return fmt.Errorf("too many pot decorations: %d (max %d)", len(p.Decorations), MaxPotDecorations)
+	}
+	if err := writeVarIntList(buf, p.Decorations); err != nil {
+		return fmt.Errorf("failed to write pot decorations: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package net_structures_test
+
+import (
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestBannerPatterns_RoundTrip(t *testing.T) {
+	in := &ns.BannerPatterns{Layers: []ns.BannerLayer{
+		{Pattern: ns.NewIDRef[ns.BannerPattern](3), Color: ns.DyeRed},
+		{Pattern: ns.NewInlineValue(ns.BannerPattern{
+			AssetID:        "example:dragon",
+			TranslationKey: "block.example.banner.dragon",
+		}), Color: ns.DyeBlack},
+	}}
+	var out ns.BannerPatterns
+	roundTripComponent(t, in, &out)
+
+	if len(out.Layers) != 2 {
+		t.Fatalf("expected 2 layers, got %d", len(out.Layers))
+	}
+	if id, _, inline := out.Layers[0].Pattern.Get(); inline || id != 3 || out.Layers[0].Color != ns.DyeRed {
+		t.Errorf("unexpected first layer: %+v", out.Layers[0])
+	}
+	if _, p, inline := out.Layers[1].Pattern.Get(); !inline || p.AssetID != "example:dragon" {
+		t.Errorf("unexpected inline pattern: %+v", p)
+	}
+	if out.Layers[1].Color.String() != "black" {
+		t.Errorf("unexpected color name: %s", out.Layers[1].Color)
+	}
+}
+
+func TestPotDecorations(t *testing.T) {
+	in := &ns.PotDecorations{Decorations: []ns.VarInt{10, 11, 12, 13}}
+	var out ns.PotDecorations
+	roundTripComponent(t, in, &out)
+
+	w := ns.NewWriter()
+	w.WriteVarInt(5)
+	for range 5 {
+		w.WriteVarInt(1)
+	}
+	if err := out.Decode(ns.NewReader(w.Bytes())); err == nil {
+		t.Error("expected error for more than 4 decorations")
+	}
+}
@@ -0,0 +1,532 @@
+package net_structures
+
+import "fmt"
+
+// MaxLoreLines is the maximum number of lines of the minecraft:lore component.
+const MaxLoreLines = 256
+
+// UnitComponent is the data of components without data, whose presence alone
+// has an effect (minecraft:unbreakable, minecraft:creative_slot_lock,
+// minecraft:intangible_projectile and minecraft:glider). It has no wire data.
+type UnitComponent struct{}
+
+// Decode reads nothing.
+func (*UnitComponent) Decode(*PacketBuffer) error { return nil }
+
+// Encode writes nothing.
+func (*UnitComponent) Encode(*PacketBuffer) error { return nil }
+
+// CustomData is the data of components holding an NBT compound that the client
+// does not interpret (minecraft:custom_data, minecraft:bucket_entity_data and
+// minecraft:debug_stick_state).
+//
+// Wire format:
+//
+//	┌──────────────────────┐
+//	│  Data (NBT)          │
+//	└──────────────────────┘
+type CustomData struct {
+	Data RawNBT
+}
+
+// Decode reads CustomData from the buffer.
+func (c *CustomData) Decode(buf *PacketBuffer) error {
+	var err error
+	if c.Data, err = buf.ReadRawNBT(); err != nil {
+		return fmt.Errorf("failed to read custom data: %w", err)
+	}
+	return nil
+}
+
+// Encode writes CustomData to the buffer.
+func (c *CustomData) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteRawNBT(c.Data); err != nil {
+		return fmt.Errorf("failed to write custom data: %w", err)
+	}
+	return nil
+}
+
+// MaxStackSize is the data of the minecraft:max_stack_size component (1-99).
+//
+// Wire format:
+//
+//	┌──────────────────────┐
+//	│  Value (VarInt)      │
+//	└──────────────────────┘
+type MaxStackSize struct {
+	Value VarInt
+}
+
+// Decode reads a MaxStackSize from the buffer.
+func (m *MaxStackSize) Decode(buf *PacketBuffer) error {
+	var err error
+	if m.Value, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read max stack size: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a MaxStackSize to the buffer.
+func (m *MaxStackSize) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(m.Value); err != nil {
+		return fmt.Errorf("failed to write max stack size: %w", err)
+	}
+	return nil
+}
+
+// MaxDamage is the data of the minecraft:max_damage component: the item's durability.
+//
+// Wire format:
+//
+//	┌──────────────────────┐
+//	│  Value (VarInt)      │
+//	└──────────────────────┘
+type MaxDamage struct {
+	Value VarInt
+}
+
+// Decode reads a MaxDamage from the buffer.
+func (m *MaxDamage) Decode(buf *PacketBuffer) error {
+	var err error
+	if m.Value, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read max damage: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a MaxDamage to the buffer.
+func (m *MaxDamage) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(m.Value); err != nil {
+		return fmt.Errorf("failed to write max damage: %w", err)
+	}
+	return nil
+}
+
+// Damage is the data of the minecraft:damage component: the durability used up.
+//
+// Wire format:
+//
+//	┌──────────────────────┐
+//	│  Value (VarInt)      │
+//	└──────────────────────┘
+type Damage struct {
+	Value VarInt
+}
+
+// Decode reads a Damage from the buffer.
+func (d *Damage) Decode(buf *PacketBuffer) error {
+	var err error
+	if d.Value, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read damage: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a Damage to the buffer.
+func (d *Damage) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(d.Value); err != nil {
+		return fmt.Errorf("failed to write damage: %w", err)
+	}
+	return nil
+}
+
+// RepairCost is the data of the minecraft:repair_cost component: the experience
+// levels added to the cost of working the item in an anvil.
+//
+// Wire format:
+//
+//	┌──────────────────────┐
+//	│  Value (VarInt)      │
+//	└──────────────────────┘
+type RepairCost struct {
+	Value VarInt
+}
+
+// Decode reads a RepairCost from the buffer.
+func (r *RepairCost) Decode(buf *PacketBuffer) error {
+	var err error
+	if r.Value, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read repair cost: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a RepairCost to the buffer.
+func (r *RepairCost) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(r.Value); err != nil {
+		return fmt.Errorf("failed to write repair cost: %w", err)
+	}
+	return nil
+}
+
+// OminousBottleAmplifier is the data of the minecraft:ominous_bottle_amplifier
+// component: the amplifier (0-4) of the Bad Omen effect given when drunk.
+//
+// Wire format:
+//
+//	┌──────────────────────┐
+//	│  Value (VarInt)      │
+//	└──────────────────────┘
+type OminousBottleAmplifier struct {
+	Value VarInt
+}
+
+// Decode reads an OminousBottleAmplifier from the buffer.
+func (o *OminousBottleAmplifier) Decode(buf *PacketBuffer) error {
+	var err error
+	if o.Value, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read ominous bottle amplifier: %w", err)
+	}
+	return nil
+}
+
+// Encode writes an OminousBottleAmplifier to the buffer.
+func (o *OminousBottleAmplifier) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(o.Value); err != nil {
+		return fmt.Errorf("failed to write ominous bottle amplifier: %w", err)
+	}
+	return nil
+}
+
+// EnchantmentGlintOverride is the data of the minecraft:enchantment_glint_override
+// component: forces the enchantment glint on or off.
+//
+// Wire format:
+//
+//	┌──────────────────────┐
+//	│  Value (Boolean)     │
+//	└──────────────────────┘
+type EnchantmentGlintOverride struct {
+	Value Boolean
+}
+
+// Decode reads an EnchantmentGlintOverride from the buffer.
+func (e *EnchantmentGlintOverride) Decode(buf *PacketBuffer) error {
+	var err error
+	if e.Value, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read enchantment glint override: %w", err)
+	}
+	return nil
+}
+
+// Encode writes an EnchantmentGlintOverride to the buffer.
+func (e *EnchantmentGlintOverride) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteBool(e.Value); err != nil {
+		return fmt.Errorf("failed to write enchantment glint override: %w", err)
+	}
+	return nil
+}
+
+// IdentifierComponent is the data of components holding a single identifier:
+// minecraft:tooltip_style (a sprite prefix), minecraft:note_block_sound (a sound
+// event) and the tag keys of minecraft:damage_resistant (a damage type tag) and
+// minecraft:provides_banner_patterns (a banner pattern tag).
+//
+// Wire format:
+//
+//	┌──────────────────────┐
+//	│  Value (Identifier)  │
+//	└──────────────────────┘
+//
+// Tag keys are sent without the leading '#'.
+type IdentifierComponent struct {
+	Value Identifier
+}
+
+// Decode reads an IdentifierComponent from the buffer.
+func (c *IdentifierComponent) Decode(buf *PacketBuffer) error {
+	var err error
+	if c.Value, err = buf.ReadIdentifier(); err != nil {
+		return fmt.Errorf("failed to read identifier: %w", err)
+	}
+	return nil
+}
+
+// Encode writes an IdentifierComponent to the buffer.
+func (c *IdentifierComponent) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteIdentifier(c.Value); err != nil {
+		return fmt.Errorf("failed to write identifier: %w", err)
+	}
+	return nil
+}
+
+// DisplayName is the data of the minecraft:custom_name (set by anvils, shown in
+// italics) and minecraft:item_name (the default name) components.
+//
+// Wire format:
+//
+//	┌──────────────────────────┐
+//	│  Name (Text Component)   │
+//	└──────────────────────────┘
+type DisplayName struct {
+	Name TextComponent
+}
+
+// Decode reads a DisplayName from the buffer.
+func (d *DisplayName) Decode(buf *PacketBuffer) error {
+	var err error
+	if d.Name, err = buf.ReadTextComponent(); err != nil {
+		return fmt.Errorf("failed to read name: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a DisplayName to the buffer.
+func (d *DisplayName) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteTextComponent(d.Name); err != nil {
+		return fmt.Errorf("failed to write name: %w", err)
+	}
+	return nil
+}
+
+// Lore is the data of the minecraft:lore component: extra tooltip lines.
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────────────────────┐
+//	│  Lines (Prefixed Array of Text Component, max 256)  │
+//	└─────────────────────────────────────────────────────┘
+type Lore struct {
+	Lines []TextComponent
+}
+
+// Decode reads a Lore from the buffer.
+func (l *Lore) Decode(buf *PacketBuffer) error {
+	count, err := buf.ReadVarInt()
+	if err != nil {
+		return fmt.Errorf("failed to read lore line count: %w", err)
+	}
+	if count < 0 || count > MaxLoreLines {
+		return fmt.Errorf("invalid lore line count: %d (max %d)", count, MaxLoreLines)
+	}
+	l.Lines = make([]TextComponent, count)
+	for i := range l.Lines {
+		if l.Lines[i], err = buf.ReadTextComponent(); err != nil {
+			return fmt.Errorf("failed to read lore line %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Encode writes a Lore to the buffer.
+func (l *Lore) Encode(buf *PacketBuffer) error {
+	if len(l.Lines) > MaxLoreLines {
+		return fmt.Errorf("too many lore lines: %d (max %d)", len(l.Lines), MaxLoreLines)
+	}
+	if err := buf.WriteVarInt(VarInt(len(l.Lines))); err != nil {
+		return fmt.Errorf("failed to write lore line count: %w", err)
+	}
+	for i := range l.Lines {
+		if err := buf.WriteTextComponent(l.Lines[i]); err != nil {
+			return fmt.Errorf("failed to write lore line %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Rarity is an item rarity, which sets the default name color.
+type Rarity VarInt
+
+const (
+	RarityCommon   Rarity = 0
+	RarityUncommon Rarity = 1
+	RarityRare     Rarity = 2
+	RarityEpic     Rarity = 3
+)
+
+var rarityNames = EnumNames{
+	int32(RarityCommon):   "common",
+	int32(RarityUncommon): "uncommon",
+	int32(RarityRare):     "rare",
+	int32(RarityEpic):     "epic",
+}
+
+func (r Rarity) String() string {
+	return rarityNames.Name(int32(r))
+}
+
+// ItemRarity is the data of the minecraft:rarity component.
+//
+// Wire format:
+//
+//	┌──────────────────────────┐
+//	│  Rarity (VarInt Enum)    │
+//	└──────────────────────────┘
+type ItemRarity struct {
+	Rarity Rarity
+}
+
+// Decode reads an ItemRarity from the buffer.
+func (r *ItemRarity) Decode(buf *PacketBuffer) error {
+	v, err := buf.ReadVarInt()
+	if err != nil {
+		return fmt.Errorf("failed to read rarity: %w", err)
+	}
+	if _, ok := rarityNames[int32(v)]; !ok {
+		return InvalidEnumError("rarity", int32(v))
+	}
+	r.Rarity = Rarity(v)
+	return nil
+}
+
+// Encode writes an ItemRarity to the buffer.
+func (r *ItemRarity) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(r.Rarity)); err != nil {
+		return fmt.Errorf("failed to write rarity: %w", err)
+	}
+	return nil
+}
+
+// DyedColor is the data of the minecraft:dyed_color component: the RGB color
+// (0xRRGGBB) of dyed leather armor and other dyeable items.
+//
+// Wire format:
+//
+//	┌──────────────────────┐
+//	│  Color (Int)         │
+//	└──────────────────────┘
+type DyedColor struct {
+	Color Int32
+}
+
+// Decode reads a DyedColor from the buffer.
+func (d *DyedColor) Decode(buf *PacketBuffer) error {
+	var err error
+	if d.Color, err = buf.ReadInt32(); err != nil {
+		return fmt.Errorf("failed to read dyed color: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a DyedColor to the buffer.
+func (d *DyedColor) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteInt32(d.Color); err != nil {
+		return fmt.Errorf("failed to write dyed color: %w", err)
+	}
+	return nil
+}
+
+// DyeColorComponent is the data of components holding a dye color:
+// minecraft:base_color (shields and banners) and the collar, body and pattern
+// colors of entity items (e.g. minecraft:sheep/color, minecraft:wolf/collar).
+//
+// Wire format:
+//
+//	┌──────────────────────────┐
+//	│  Color (VarInt Enum)     │
+//	└──────────────────────────┘
+type DyeColorComponent struct {
+	Color DyeColor
+}
+
+// Decode reads a DyeColorComponent from the buffer.
+func (d *DyeColorComponent) Decode(buf *PacketBuffer) error {
+	var err error
+	if d.Color, err = buf.ReadDyeColor(); err != nil {
+		return fmt.Errorf("failed to read dye color: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a DyeColorComponent to the buffer.
+func (d *DyeColorComponent) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteDyeColor(d.Color); err != nil {
+		return fmt.Errorf("failed to write dye color: %w", err)
+	}
+	return nil
+}
+
+// BlockStateProperty is a single block state property set by a block item.
+type BlockStateProperty struct {
+	Name  String
+	Value String
+}
+
+// BlockStateProperties is the data of the minecraft:block_state component: the
+// block state properties applied when the item is placed.
+//
+// Wire format:
+//
+//	┌────────────────────────────────────────────────────────┐
+//	│  Properties (Prefixed Array of (String Name + Value))  │
+//	└────────────────────────────────────────────────────────┘
+type BlockStateProperties struct {
+	Properties []BlockStateProperty
+}
+
+// Get returns the value of the named property.
+func (b *BlockStateProperties) Get(name String) (String, bool) {
+	for _, p := range b.Properties {
+		if p.Name == name {
+			return p.Value, true
+		}
+	}
+	return "", false
+}
+
+// Decode reads BlockStateProperties from the buffer.
+func (b *BlockStateProperties) Decode(buf *PacketBuffer) error {
+	count, err := buf.ReadCount()
+	if err != nil {
+		return fmt.Errorf("failed to read block state property count: %w", err)
+	}
+	b.Properties = make([]BlockStateProperty, count)
+	for i := range b.Properties {
+		if b.Properties[i].Name, err = buf.ReadString(32767); err != nil {
+			return fmt.Errorf("failed to read block state property %d name: %w", i, err)
+		}
+		if b.Properties[i].Value, err = buf.ReadString(32767); err != nil {
+			return fmt.Errorf("failed to read block state property %d value: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Encode writes BlockStateProperties to the buffer.
+func (b *BlockStateProperties) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(len(b.Properties))); err != nil {
+		return fmt.Errorf("failed to write block state property count: %w", err)
+	}
+	for i, p := range b.Properties {
+		if err := buf.WriteString(p.Name); err != nil {
+			return fmt.Errorf("failed to write block state property %d name: %w", i, err)
+		}
+		if err := buf.WriteString(p.Value); err != nil {
+			return fmt.Errorf("failed to write block state property %d value: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// BreakSound is the data of the minecraft:break_sound component: the sound
+// played when the item breaks.
+//
+// Wire format:
+//
+//	┌──────────────────────────────┐
+//	│  Sound (ID or SoundEvent)    │
+//	└──────────────────────────────┘
+type BreakSound struct {
+	Sound IDOrX[SoundEvent]
+}
+
+// Decode reads a BreakSound from the buffer.
+func (b *BreakSound) Decode(buf *PacketBuffer) error {
+	var err error
+	if b.Sound, err = buf.ReadSoundEventHolder(); err != nil {
+		return fmt.Errorf("failed to read break sound: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a BreakSound to the buffer.
+func (b *BreakSound) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteSoundEventHolder(b.Sound); err != nil {
+		return fmt.Errorf("failed to write break sound: %w", err)
+	}
+	return nil
+}
+
+func init() {
+	RegisterEnumNames("rarity", rarityNames)
+}
@@ -0,0 +1,130 @@
+package net_structures_test
+
+import (
+	"strings"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+)
+
+func TestUnitComponent_NoData(t *testing.T) {
+	buf := ns.NewWriter()
+	if err := (&ns.UnitComponent{}).Encode(buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no data, got %d bytes", buf.Len())
+	}
+}
+
+func TestValueComponents_RoundTrip(t *testing.T) {
+	var stack ns.MaxStackSize
+	roundTripComponent(t, &ns.MaxStackSize{Value: 16}, &stack)
+	if stack.Value != 16 {
+		t.Errorf("unexpected max stack size: %d", stack.Value)
+	}
+
+	var damage ns.Damage
+	roundTripComponent(t, &ns.Damage{Value: 300}, &damage)
+	if damage.Value != 300 {
+		t.Errorf("unexpected damage: %d", damage.Value)
+	}
+
+	var glint ns.EnchantmentGlintOverride
+	roundTripComponent(t, &ns.EnchantmentGlintOverride{Value: true}, &glint)
+	if !bool(glint.Value) {
+		t.Error("expected glint override to be true")
+	}
+
+	var style ns.IdentifierComponent
+	roundTripComponent(t, &ns.IdentifierComponent{Value: "minecraft:is_fire"}, &style)
+	if style.Value != "minecraft:is_fire" {
+		t.Errorf("unexpected identifier: %s", style.Value)
+	}
+
+	var dyed ns.DyedColor
+	roundTripComponent(t, &ns.DyedColor{Color: 0xA06540}, &dyed)
+	if dyed.Color != 0xA06540 {
+		t.Errorf("unexpected dyed color: %06x", dyed.Color)
+	}
+
+	var base ns.DyeColorComponent
+	roundTripComponent(t, &ns.DyeColorComponent{Color: ns.DyeLime}, &base)
+	if base.Color != ns.DyeLime {
+		t.Errorf("unexpected dye color: %v", base.Color)
+	}
+
+	data, err := nbt.EncodeNetwork(nbt.Compound{"level": nbt.Int(3)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var custom ns.CustomData
+	roundTripComponent(t, &ns.CustomData{Data: data}, &custom)
+	tag, err := custom.Data.Tag()
+	if err != nil || tag.(nbt.Compound).GetInt("level") != 3 {
+		t.Errorf("unexpected custom data: %v, %v", tag, err)
+	}
+}
+
+func TestItemRarity(t *testing.T) {
+	var rarity ns.ItemRarity
+	roundTripComponent(t, &ns.ItemRarity{Rarity: ns.RarityEpic}, &rarity)
+	if rarity.Rarity != ns.RarityEpic || rarity.Rarity.String() != "epic" {
+		t.Errorf("unexpected rarity: %v", rarity.Rarity)
+	}
+
+	w := ns.NewWriter()
+	w.WriteVarInt(4)
+	if err := rarity.Decode(ns.NewReader(w.Bytes())); err == nil || !strings.Contains(err.Error(), "rarity") {
+		t.Errorf("expected invalid rarity error, got: %v", err)
+	}
+}
+
+func TestLore_RoundTrip(t *testing.T) {
+	in := &ns.Lore{Lines: []ns.TextComponent{
+		ns.NewTextComponent("First line"),
+		{Text: "Second line", Color: "gray"},
+	}}
+	var out ns.Lore
+	roundTripComponent(t, in, &out)
+	if len(out.Lines) != 2 || out.Lines[1].Text != "Second line" || out.Lines[1].Color != "gray" {
+		t.Errorf("unexpected lore: %+v", out.Lines)
+	}
+
+	tooMany := &ns.Lore{Lines: make([]ns.TextComponent, ns.MaxLoreLines+1)}
+	if err := tooMany.Encode(ns.NewWriter()); err == nil {
+		t.Error("expected error for too many lore lines")
+	}
+}
+
+func TestDisplayName_RoundTrip(t *testing.T) {
+	var out ns.DisplayName
+	roundTripComponent(t, &ns.DisplayName{Name: ns.NewTranslateComponent("item.minecraft.diamond")}, &out)
+	if out.Name.Translate != "item.minecraft.diamond" {
+		t.Errorf("unexpected name: %+v", out.Name)
+	}
+}
+
+func TestBlockStateProperties_RoundTrip(t *testing.T) {
+	in := &ns.BlockStateProperties{Properties: []ns.BlockStateProperty{
+		{Name: "facing", Value: "north"},
+		{Name: "lit", Value: "true"},
+	}}
+	var out ns.BlockStateProperties
+	roundTripComponent(t, in, &out)
+	if v, ok := out.Get("lit"); !ok || v != "true" {
+		t.Errorf("Get(lit) = %q, %v", v, ok)
+	}
+	if _, ok := out.Get("waterlogged"); ok {
+		t.Error("expected missing property")
+	}
+}
+
+func TestBreakSound_RoundTrip(t *testing.T) {
+	var out ns.BreakSound
+	roundTripComponent(t, &ns.BreakSound{Sound: ns.NewIDRef[ns.SoundEvent](120)}, &out)
+	if id, _, inline := out.Sound.Get(); inline || id != 120 {
+		t.Errorf("unexpected break sound: %+v", out.Sound)
+	}
+}
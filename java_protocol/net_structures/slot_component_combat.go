@@ -0,0 +1,406 @@
+package net_structures
+
+import "fmt"
+
+// AttackRange is the data of the minecraft:attack_range component: the reach
+// of melee attacks made with the item, in blocks.
+//
+// Wire format:
+//
+//	┌────────────────────────────────┬────────────────────────────────┐
+//	│  Min Reach (Float)             │  Max Reach (Float)             │
+//	├────────────────────────────────┼────────────────────────────────┤
+//	│  Min Creative Reach (Float)    │  Max Creative Reach (Float)    │
+//	├────────────────────────────────┼────────────────────────────────┤
+//	│  Hitbox Margin (Float)         │  Mob Factor (Float)            │
+//	└────────────────────────────────┴────────────────────────────────┘
+//
+// Targets closer than the minimum reach cannot be hit. The hitbox margin grows
+// the target's hitbox, and mobs attacking with the item scale the reach by the
+// mob factor.
+type AttackRange struct {
+	MinReach         Float32
+	MaxReach         Float32
+	MinCreativeReach Float32
+	MaxCreativeReach Float32
+	HitboxMargin     Float32
+	MobFactor        Float32
+}
+
+// Decode reads an AttackRange from the buffer.
+func (a *AttackRange) Decode(buf *PacketBuffer) error {
+	var err error
+	if a.MinReach, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read attack range min reach: %w", err)
+	}
+	if a.MaxReach, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read attack range max reach: %w", err)
+	}
+	if a.MinCreativeReach, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read attack range min creative reach: %w", err)
+	}
+	if a.MaxCreativeReach, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read attack range max creative reach: %w", err)
+	}
+	if a.HitboxMargin, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read attack range hitbox margin: %w", err)
+	}
+	if a.MobFactor, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read attack range mob factor: %w", err)
+	}
+	return nil
+}
+
+// Encode writes an AttackRange to the buffer.
+func (a *AttackRange) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteFloat32(a.MinReach); err != nil {
+		return fmt.Errorf("failed to write attack range min reach: %w", err)
+	}
+	if err := buf.WriteFloat32(a.MaxReach); err != nil {
+		return fmt.Errorf("failed to write attack range max reach: %w", err)
+	}
+	if err := buf.WriteFloat32(a.MinCreativeReach); err != nil {
+		return fmt.Errorf("failed to write attack range min creative reach: %w", err)
+	}
+	if err := buf.WriteFloat32(a.MaxCreativeReach); err != nil {
+		return fmt.Errorf("failed to write attack range max creative reach: %w", err)
+	}
+	if err := buf.WriteFloat32(a.HitboxMargin); err != nil {
+		return fmt.Errorf("failed to write attack range hitbox margin: %w", err)
+	}
+	if err := buf.WriteFloat32(a.MobFactor); err != nil {
+		return fmt.Errorf("failed to write attack range mob factor: %w", err)
+	}
+	return nil
+}
+
+// SwingAnimationType is the arm animation played when attacking with an item.
+type SwingAnimationType VarInt
+
+const (
+	SwingAnimationNone  SwingAnimationType = 0
+	SwingAnimationWhack SwingAnimationType = 1
+	SwingAnimationStab  SwingAnimationType = 2
+)
+
+var swingAnimationTypeNames = EnumNames{
+	int32(SwingAnimationNone):  "none",
+	int32(SwingAnimationWhack): "whack",
+	int32(SwingAnimationStab):  "stab",
+}
+
+func (t SwingAnimationType) String() string {
+	return swingAnimationTypeNames.Name(int32(t))
+}
+
+// SwingAnimation is the data of the minecraft:swing_animation component.
+//
+// Wire format:
+//
+//	┌────────────────────────┬────────────────────────┐
+//	│  Type (VarInt Enum)    │  Duration (VarInt)     │
+//	└────────────────────────┴────────────────────────┘
+//
+// Duration is in ticks. Items without the component whack for 6 ticks.
+type SwingAnimation struct {
+	Type     SwingAnimationType
+	Duration VarInt
+}
+
+// Decode reads a SwingAnimation from the buffer.
+func (s *SwingAnimation) Decode(buf *PacketBuffer) error {
+	v, err := buf.ReadVarInt()
+	if err != nil {
+		return fmt.Errorf("failed to read swing animation type: %w", err)
+	}
+	if _, ok := swingAnimationTypeNames[int32(v)]; !ok {
+		return InvalidEnumError("swing animation type", int32(v))
+	}
+	s.Type = SwingAnimationType(v)
+	if s.Duration, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read swing animation duration: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a SwingAnimation to the buffer.
+func (s *SwingAnimation) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(s.Type)); err != nil {
+		return fmt.Errorf("failed to write swing animation type: %w", err)
+	}
+	if err := buf.WriteVarInt(s.Duration); err != nil {
+		return fmt.Errorf("failed to write swing animation duration: %w", err)
+	}
+	return nil
+}
+
+// MinimumAttackCharge is the data of the minecraft:minimum_attack_charge
+// component: the attack strength (0 to 1) the player needs to attack with the
+// item.
+//
+// Wire format:
+//
+//	┌──────────────────┐
+//	│  Charge (Float)  │
+//	└──────────────────┘
+type MinimumAttackCharge struct {
+	Charge Float32
+}
+
+// Decode reads a MinimumAttackCharge from the buffer.
+func (m *MinimumAttackCharge) Decode(buf *PacketBuffer) error {
+	var err error
+	if m.Charge, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read minimum attack charge: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a MinimumAttackCharge to the buffer.
+func (m *MinimumAttackCharge) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteFloat32(m.Charge); err != nil {
+		return fmt.Errorf("failed to write minimum attack charge: %w", err)
+	}
+	return nil
+}
+
+// KineticCondition limits when a charged (kinetic) spear attack has an effect.
+//
+// Wire format:
+//
+//	┌────────────────────────────────┬────────────────────────────────┐
+//	│  Max Duration Ticks (VarInt)   │  Min Speed (Float)             │
+//	├────────────────────────────────┴────────────────────────────────┤
+//	│  Min Relative Speed (Float)                                     │
+//	└─────────────────────────────────────────────────────────────────┘
+//
+// The effect applies for Max Duration Ticks after the charge starts, while the
+// attacker moves at least Min Speed and approaches the target at least Min
+// Relative Speed (in blocks per tick).
+type KineticCondition struct {
+	MaxDurationTicks VarInt
+	MinSpeed         Float32
+	MinRelativeSpeed Float32
+}
+
+// Decode reads a KineticCondition from the buffer.
+func (c *KineticCondition) Decode(buf *PacketBuffer) error {
+	var err error
+	if c.MaxDurationTicks, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read condition max duration: %w", err)
+	}
+	if c.MinSpeed, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read condition min speed: %w", err)
+	}
+	if c.MinRelativeSpeed, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read condition min relative speed: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a KineticCondition to the buffer.
+func (c *KineticCondition) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(c.MaxDurationTicks); err != nil {
+		return fmt.Errorf("failed to write condition max duration: %w", err)
+	}
+	if err := buf.WriteFloat32(c.MinSpeed); err != nil {
+		return fmt.Errorf("failed to write condition min speed: %w", err)
+	}
+	if err := buf.WriteFloat32(c.MinRelativeSpeed); err != nil {
+		return fmt.Errorf("failed to write condition min relative speed: %w", err)
+	}
+	return nil
+}
+
+func readKineticCondition(buf *PacketBuffer) (KineticCondition, error) {
+	var c KineticCondition
+	err := c.Decode(buf)
+	return c, err
+}
+
+func writeKineticCondition(buf *PacketBuffer, c KineticCondition) error {
+	return c.Encode(buf)
+}
+
+// KineticWeapon is the data of the minecraft:kinetic_weapon component: the
+// charged attack of spears, which deals damage based on the attacker's speed.
+//
+// Wire format:
+//
+//	┌──────────────────────────────────────────────────────┬──────────────────────────────────────────────────────┐
+//	│  Contact Cooldown Ticks (VarInt)                     │  Delay Ticks (VarInt)                                │
+//	├──────────────────────────────────────────────────────┼──────────────────────────────────────────────────────┤
+//	│  Dismount Conditions (Prefixed Optional Condition)   │  Knockback Conditions (Prefixed Optional Condition)  │
+//	├──────────────────────────────────────────────────────┼──────────────────────────────────────────────────────┤
+//	│  Damage Conditions (Prefixed Optional Condition)     │  Forward Movement (Float)                            │
+//	├──────────────────────────────────────────────────────┼──────────────────────────────────────────────────────┤
+//	│  Damage Multiplier (Float)                           │  Sound (Prefixed Optional ID or SoundEvent)          │
+//	├──────────────────────────────────────────────────────┴──────────────────────────────────────────────────────┤
+//	│  Hit Sound (Prefixed Optional ID or SoundEvent)                                                             │
+//	└─────────────────────────────────────────────────────────────────────────────────────────────────────────────┘
+//
+// Each effect (dismounting, knockback, damage) is only possible if its
+// condition is set.
+type KineticWeapon struct {
+	ContactCooldownTicks VarInt
+	DelayTicks           VarInt
+	DismountConditions   PrefixedOptional[KineticCondition]
+	KnockbackConditions  PrefixedOptional[KineticCondition]
+	DamageConditions     PrefixedOptional[KineticCondition]
+	ForwardMovement      Float32
+	DamageMultiplier     Float32
+	Sound                PrefixedOptional[IDOrX[SoundEvent]]
+	HitSound             PrefixedOptional[IDOrX[SoundEvent]]
+}
+
+// Decode reads a KineticWeapon from the buffer.
+func (k *KineticWeapon) Decode(buf *PacketBuffer) error {
+	var err error
+	if k.ContactCooldownTicks, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read contact cooldown: %w", err)
+	}
+	if k.DelayTicks, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read delay: %w", err)
+	}
+	if err := k.DismountConditions.DecodeWith(buf, readKineticCondition); err != nil {
+		return fmt.Errorf("failed to read dismount conditions: %w", err)
+	}
+	if err := k.KnockbackConditions.DecodeWith(buf, readKineticCondition); err != nil {
+		return fmt.Errorf("failed to read knockback conditions: %w", err)
+	}
+	if err := k.DamageConditions.DecodeWith(buf, readKineticCondition); err != nil {
+		return fmt.Errorf("failed to read damage conditions: %w", err)
+	}
+	if k.ForwardMovement, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read forward movement: %w", err)
+	}
+	if k.DamageMultiplier, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read damage multiplier: %w", err)
+	}
+	if err := k.Sound.DecodeWith(buf, (*PacketBuffer).ReadSoundEventHolder); err != nil {
+		return fmt.Errorf("failed to read kinetic sound: %w", err)
+	}
+	if err := k.HitSound.DecodeWith(buf, (*PacketBuffer).ReadSoundEventHolder); err != nil {
+		return fmt.Errorf("failed to read kinetic hit sound: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a KineticWeapon to the buffer.
+func (k *KineticWeapon) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(k.ContactCooldownTicks); err != nil {
+		return fmt.Errorf("failed to write contact cooldown: %w", err)
+	}
+	if err := buf.WriteVarInt(k.DelayTicks); err != nil {
+		return fmt.Errorf("failed to write delay: %w", err)
+	}
+	if err := k.DismountConditions.EncodeWith(buf, writeKineticCondition); err != nil {
+		return fmt.Errorf("failed to write dismount conditions: %w", err)
+	}
+	if err := k.KnockbackConditions.EncodeWith(buf, writeKineticCondition); err != nil {
+		return fmt.Errorf("failed to write knockback conditions: %w", err)
+	}
+	if err := k.DamageConditions.EncodeWith(buf, writeKineticCondition); err != nil {
+		return fmt.Errorf("failed to write damage conditions: %w", err)
+	}
+	if err := buf.WriteFloat32(k.ForwardMovement); err != nil {
+		return fmt.Errorf("failed to write forward movement: %w", err)
+	}
+	if err := buf.WriteFloat32(k.DamageMultiplier); err != nil {
+		return fmt.Errorf("failed to write damage multiplier: %w", err)
+	}
+	if err := k.Sound.EncodeWith(buf, (*PacketBuffer).WriteSoundEventHolder); err != nil {
+		return fmt.Errorf("failed to write kinetic sound: %w", err)
+	}
+	if err := k.HitSound.EncodeWith(buf, (*PacketBuffer).WriteSoundEventHolder); err != nil {
+		return fmt.Errorf("failed to write kinetic hit sound: %w", err)
+	}
+	return nil
+}
+
+// PiercingWeapon is the data of the minecraft:piercing_weapon component: the
+// stab attack of spears, which hits every entity along the attack ray.
+//
+// Wire format:
+//
+//	┌──────────────────────────────────────────────────┬──────────────────────────────────────────────────┐
+//	│  Deals Knockback (Boolean)                       │  Dismounts (Boolean)                             │
+//	├──────────────────────────────────────────────────┼──────────────────────────────────────────────────┤
+//	│  Sound (Prefixed Optional ID or SoundEvent)      │  Hit Sound (Prefixed Optional ID or SoundEvent)  │
+//	└──────────────────────────────────────────────────┴──────────────────────────────────────────────────┘
+type PiercingWeapon struct {
+	DealsKnockback Boolean
+	Dismounts      Boolean
+	Sound          PrefixedOptional[IDOrX[SoundEvent]]
+	HitSound       PrefixedOptional[IDOrX[SoundEvent]]
+}
+
+// Decode reads a PiercingWeapon from the buffer.
+func (p *PiercingWeapon) Decode(buf *PacketBuffer) error {
+	var err error
+	if p.DealsKnockback, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read deals knockback: %w", err)
+	}
+	if p.Dismounts, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read dismounts: %w", err)
+	}
+	if err := p.Sound.DecodeWith(buf, (*PacketBuffer).ReadSoundEventHolder); err != nil {
+		return fmt.Errorf("failed to read piercing sound: %w", err)
+	}
+	if err := p.HitSound.DecodeWith(buf, (*PacketBuffer).ReadSoundEventHolder); err != nil {
+		return fmt.Errorf("failed to read piercing hit sound: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a PiercingWeapon to the buffer.
+func (p *PiercingWeapon) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteBool(p.DealsKnockback); err != nil {
+		return fmt.Errorf("failed to write deals knockback: %w", err)
+	}
+	if err := buf.WriteBool(p.Dismounts); err != nil {
+		return fmt.Errorf("failed to write dismounts: %w", err)
+	}
+	if err := p.Sound.EncodeWith(buf, (*PacketBuffer).WriteSoundEventHolder); err != nil {
+		return fmt.Errorf("failed to write piercing sound: %w", err)
+	}
+	if err := p.HitSound.EncodeWith(buf, (*PacketBuffer).WriteSoundEventHolder); err != nil {
+		return fmt.Errorf("failed to write piercing hit sound: %w", err)
+	}
+	return nil
+}
+
+// DamageTypeComponent is the data of the minecraft:damage_type component: the
+// damage type of attacks made with the item.
+//
+// Wire format:
+//
+//	┌────────────────────┬──────────────────────────────────────────────────┐
+//	│  Direct (Boolean)  │  Damage Type (VarInt if direct, else Identifier) │
+//	└────────────────────┴──────────────────────────────────────────────────┘
+//
+// A damage type given by name (resource key) does not need to be known to the
+// client's registry when the item is sent.
+type DamageTypeComponent struct {
+	DamageType XOrY[VarInt, Identifier]
+}
+
+// Decode reads a DamageTypeComponent from the buffer.
+func (d *DamageTypeComponent) Decode(buf *PacketBuffer) error {
+	if err := d.DamageType.DecodeWith(buf, (*PacketBuffer).ReadVarInt, (*PacketBuffer).ReadIdentifier); err != nil {
+		return fmt.Errorf("failed to read damage type: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a DamageTypeComponent to the buffer.
+func (d *DamageTypeComponent) Encode(buf *PacketBuffer) error {
+	if err := d.DamageType.EncodeWith(buf, (*PacketBuffer).WriteVarInt, (*PacketBuffer).WriteIdentifier); err != nil {
+		return fmt.Errorf("failed to write damage type: %w", err)
+	}
+	return nil
+}
+
+func init() {
+	RegisterEnumNames("swing animation type", swingAnimationTypeNames)
+}
@@ -0,0 +1,81 @@
+package net_structures_test
+
+import (
+	"reflect"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// spearComponents mirror the prototype components of vanilla's iron spear.
+var spearComponents = []struct {
+	name ns.Identifier
+	c    ns.SlotComponent
+}{
+	{ns.ComponentAttackRange, &ns.AttackRange{MinReach: 2, MaxReach: 4.5, MaxCreativeReach: 6.5, HitboxMargin: 0.125, MobFactor: 0.5}},
+	{ns.ComponentSwingAnimation, &ns.SwingAnimation{Type: ns.SwingAnimationStab, Duration: 19}},
+	{ns.ComponentMinimumAttackCharge, &ns.MinimumAttackCharge{Charge: 1}},
+	{ns.ComponentKineticWeapon, &ns.KineticWeapon{
+		ContactCooldownTicks: 10,
+		DelayTicks:           12,
+		DismountConditions:   ns.Some(ns.KineticCondition{MaxDurationTicks: 95, MinSpeed: 12}),
+		KnockbackConditions:  ns.Some(ns.KineticCondition{MaxDurationTicks: 195, MinSpeed: 5.1}),
+		DamageConditions:     ns.Some(ns.KineticCondition{MaxDurationTicks: 275, MinRelativeSpeed: 4.6}),
+		ForwardMovement:      0.38,
+		DamageMultiplier:     0.95,
+		Sound:                ns.Some(ns.NewIDRef[ns.SoundEvent](1500)),
+		HitSound:             ns.Some(ns.NewInlineValue(ns.SoundEvent{Name: "minecraft:item.spear.hit"})),
+	}},
+	{ns.ComponentPiercingWeapon, &ns.PiercingWeapon{DealsKnockback: true, Sound: ns.Some(ns.NewIDRef[ns.SoundEvent](1501))}},
+	{ns.ComponentUseEffects, &ns.UseEffects{CanSprint: true, SpeedMultiplier: 1}},
+	{ns.ComponentDamageType, &ns.DamageTypeComponent{DamageType: ns.NewY[ns.VarInt, ns.Identifier]("minecraft:spear")}},
+	{ns.ComponentZombieNautilusVariant, &ns.EntityVariant{ID: 1}},
+}
+
+func TestSpearComponents_Decoder(t *testing.T) {
+	var types ns.SlotComponentTypes
+	for _, sc := range spearComponents {
+		types = append(types, sc.name)
+	}
+
+	slot := ns.NewSlot(1200, 1)
+	for _, sc := range spearComponents {
+		raw, err := types.Raw(sc.name, sc.c)
+		if err != nil {
+			t.Fatalf("raw %s: %v", sc.name, err)
+		}
+		slot.Components.Add = append(slot.Components.Add, raw)
+	}
+	buf := ns.NewWriter()
+	if err := slot.Encode(buf); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+
+	decoded, err := ns.NewReader(buf.Bytes()).ReadSlot(types.Decoder())
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	for id, sc := range spearComponents {
+		raw := decoded.GetComponent(ns.VarInt(id))
+		if raw == nil {
+			t.Fatalf("%s missing", sc.name)
+		}
+		typed, err := types.Parse(*raw)
+		if err != nil {
+			t.Fatalf("parse %s: %v", sc.name, err)
+		}
+		if !reflect.DeepEqual(typed, sc.c) {
+			t.Errorf("%s = %+v, want %+v", sc.name, typed, sc.c)
+		}
+	}
+}
+
+func TestSwingAnimation_InvalidType(t *testing.T) {
+	buf := ns.NewWriter()
+	buf.WriteVarInt(3)
+	buf.WriteVarInt(6)
+	var out ns.SwingAnimation
+	if err := out.Decode(ns.NewReader(buf.Bytes())); err == nil {
+		t.Error("expected error for unknown swing animation type")
+	}
+}
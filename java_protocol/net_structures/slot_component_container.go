@@ -0,0 +1,193 @@
+package net_structures
+
+import "fmt"
+
+// MaxContainerSlots is the maximum number of slots in container contents
+// (vanilla limit) and bundle contents (safety limit).
+const MaxContainerSlots = 256
+
+// ItemContainerContents is the data of the minecraft:container component
+// (shulker boxes, chests and other block items that keep their inventory).
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────────────┐
+//	│  Slots (Prefixed Array of Slot, max 256)    │
+//	└─────────────────────────────────────────────┘
+//
+// Slots are stored by index; empty slots between items are sent as empty slots.
+type ItemContainerContents struct {
+	Slots []Slot
+}
+
+// Decode reads ItemContainerContents whose nested slots have no components.
+// Use DecodeWith (or SlotComponentTypes) for slots with components.
+func (c *ItemContainerContents) Decode(buf *PacketBuffer) error {
+	return c.DecodeWith(buf, rejectNestedComponents)
+}
+
+// DecodeWith reads ItemContainerContents using decode for the nested slots' components.
+func (c *ItemContainerContents) DecodeWith(buf *PacketBuffer, decode SlotDecoder) error {
+	var err error
+	if c.Slots, err = readSlotList(buf, decode); err != nil {
+		return fmt.Errorf("failed to read container contents: %w", err)
+	}
+	return nil
+}
+
+// Encode writes ItemContainerContents to the buffer.
+func (c *ItemContainerContents) Encode(buf *PacketBuffer) error {
+	if err := writeSlotList(buf, c.Slots); err != nil {
+		return fmt.Errorf("failed to write container contents: %w", err)
+	}
+	return nil
+}
+
+// BundleContents is the data of the minecraft:bundle_contents component.
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────────────┐
+//	│  Items (Prefixed Array of Slot)             │
+//	└─────────────────────────────────────────────┘
+//
+// Items are never empty; the most recently inserted item comes first.
+type BundleContents struct {
+	Items []Slot
+}
+
+// Decode reads BundleContents whose nested slots have no components.
+// Use DecodeWith (or SlotComponentTypes) for slots with components.
+func (b *BundleContents) Decode(buf *PacketBuffer) error {
+	return b.DecodeWith(buf, rejectNestedComponents)
+}
+
+// DecodeWith reads BundleContents using decode for the nested slots' components.
+func (b *BundleContents) DecodeWith(buf *PacketBuffer, decode SlotDecoder) error {
+	var err error
+	if b.Items, err = readSlotList(buf, decode); err != nil {
+		return fmt.Errorf("failed to read bundle contents: %w", err)
+	}
+	for i := range b.Items {
+		if b.Items[i].IsEmpty() {
+			return fmt.Errorf("empty item %d in bundle contents", i)
+		}
+	}
+	return nil
+}
+
+// Encode writes BundleContents to the buffer.
+func (b *BundleContents) Encode(buf *PacketBuffer) error {
+	if err := writeSlotList(buf, b.Items); err != nil {
+		return fmt.Errorf("failed to write bundle contents: %w", err)
+	}
+	return nil
+}
+
+// ChargedProjectiles is the data of the minecraft:charged_projectiles component:
+// the projectiles loaded into a crossbow.
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────────────┐
+//	│  Items (Prefixed Array of Slot)             │
+//	└─────────────────────────────────────────────┘
+type ChargedProjectiles struct {
+	Items []Slot
+}
+
+// Decode reads ChargedProjectiles whose nested slots have no components.
+// Use DecodeWith (or SlotComponentTypes) for slots with components.
+func (c *ChargedProjectiles) Decode(buf *PacketBuffer) error {
+	return c.DecodeWith(buf, rejectNestedComponents)
+}
+
+// DecodeWith reads ChargedProjectiles using decode for the nested slots' components.
+func (c *ChargedProjectiles) DecodeWith(buf *PacketBuffer, decode SlotDecoder) error {
+	var err error
+	if c.Items, err = readSlotList(buf, decode); err != nil {
+		return fmt.Errorf("failed to read charged projectiles: %w", err)
+	}
+	return nil
+}
+
+// Encode writes ChargedProjectiles to the buffer.
+func (c *ChargedProjectiles) Encode(buf *PacketBuffer) error {
+	if err := writeSlotList(buf, c.Items); err != nil {
+		return fmt.Errorf("failed to write charged projectiles: %w", err)
+	}
+	return nil
+}
+
+// UseRemainder is the data of the minecraft:use_remainder component: the item
+// left behind after the item is used up (e.g. a bowl after eating stew).
+//
+// Wire format:
+//
+//	┌──────────────────┐
+//	│  Item (Slot)     │
+//	└──────────────────┘
+type UseRemainder struct {
+	Item Slot
+}
+
+// Decode reads a UseRemainder whose nested slot has no components.
+// Use DecodeWith (or SlotComponentTypes) for a slot with components.
+func (u *UseRemainder) Decode(buf *PacketBuffer) error {
+	return u.DecodeWith(buf, rejectNestedComponents)
+}
+
+// DecodeWith reads a UseRemainder using decode for the nested slot's components.
+func (u *UseRemainder) DecodeWith(buf *PacketBuffer, decode SlotDecoder) error {
+	if err := u.Item.Decode(buf, decode); err != nil {
+		return fmt.Errorf("failed to read use remainder: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a UseRemainder to the buffer.
+func (u *UseRemainder) Encode(buf *PacketBuffer) error {
+	if err := u.Item.Encode(buf); err != nil {
+		return fmt.Errorf("failed to write use remainder: %w", err)
+	}
+	return nil
+}
+
+// rejectNestedComponents is the SlotDecoder used when no component types are known.
+func rejectNestedComponents(_ *PacketBuffer, componentID VarInt) ([]byte, error) {
+	return nil, fmt.Errorf("cannot decode nested component %d without SlotComponentTypes", componentID)
+}
+
+// readSlotList reads a VarInt-prefixed list of at most MaxContainerSlots slots.
+func readSlotList(buf *PacketBuffer, decode SlotDecoder) ([]Slot, error) {
+	count, err := buf.ReadVarInt()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read slot count: %w", err)
+	}
+	if count < 0 || count > MaxContainerSlots {
+		return nil, fmt.Errorf("invalid slot count: %d (max %d)", count, MaxContainerSlots)
+	}
+	slots := make([]Slot, count)
+	for i := range slots {
+		if err := slots[i].Decode(buf, decode); err != nil {
+			return nil, fmt.Errorf("failed to read slot %d: %w", i, err)
+		}
+	}
+	return slots, nil
+}
+
+// writeSlotList writes a VarInt-prefixed list of at most MaxContainerSlots slots.
+func writeSlotList(buf *PacketBuffer, slots []Slot) error {
+	if len(slots) > MaxContainerSlots {
+		return fmt.Errorf("too many slots: %d (max %d)", len(slots), MaxContainerSlots)
+	}
+	if err := buf.WriteVarInt(VarInt(len(slots))); err != nil {
+		return fmt.Errorf("failed to write slot count: %w", err)
+	}
+	for i := range slots {
+		if err := slots[i].Encode(buf); err != nil {
+			return fmt.Errorf("failed to write slot %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package net_structures_test
+
+import (
+	"strings"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+var containerComponentTypes = ns.SlotComponentTypes{
+	ns.ComponentContainer,
+	ns.ComponentBundleContents,
+	ns.ComponentFood,
+}
+
+// nestedContainerSlot returns a shulker box slot nested depth levels deep.
+func nestedContainerSlot(t *testing.T, depth int) ns.Slot {
+	t.Helper()
+	slot := ns.NewSlot(1, 1)
+	for range depth {
+		raw, err := containerComponentTypes.Raw(ns.ComponentContainer, &ns.ItemContainerContents{Slots: []ns.Slot{slot}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		slot = ns.NewSlot(2, 1)
+		slot.Components.Add = []ns.RawSlotComponent{raw}
+	}
+	return slot
+}
+
+func TestItemContainerContents_Nested(t *testing.T) {
+	food, err := containerComponentTypes.Raw(ns.ComponentFood, &ns.Food{Nutrition: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	apple := ns.NewSlot(7, 3)
+	apple.Components.Add = []ns.RawSlotComponent{food}
+
+	bundle, err := containerComponentTypes.Raw(ns.ComponentBundleContents, &ns.BundleContents{Items: []ns.Slot{apple}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundleSlot := ns.NewSlot(8, 1)
+	bundleSlot.Components.Add = []ns.RawSlotComponent{bundle}
+
+	in := &ns.ItemContainerContents{Slots: []ns.Slot{ns.EmptySlot(), bundleSlot}}
+	raw, err := containerComponentTypes.Raw(ns.ComponentContainer, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// standalone Decode cannot skip the nested food component
+	var plain ns.ItemContainerContents
+	if err := plain.Decode(ns.NewReader(raw.Data)); err == nil || !strings.Contains(err.Error(), "without SlotComponentTypes") {
+		t.Fatalf("expected nested component error, got: %v", err)
+	}
+
+	parsed, err := containerComponentTypes.Parse(raw)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	contents := parsed.(*ns.ItemContainerContents)
+	if len(contents.Slots) != 2 || !contents.Slots[0].IsEmpty() || contents.Slots[1].ItemID != 8 {
+		t.Fatalf("unexpected container contents: %+v", contents.Slots)
+	}
+
+	inner, err := containerComponentTypes.Parse(*contents.Slots[1].GetComponent(1))
+	if err != nil {
+		t.Fatalf("parse bundle error: %v", err)
+	}
+	items := inner.(*ns.BundleContents).Items
+	if len(items) != 1 || items[0].Count != 3 || items[0].GetComponent(2) == nil {
+		t.Errorf("unexpected bundle contents: %+v", items)
+	}
+}
+
+func TestItemContainerContents_DepthLimit(t *testing.T) {
+	decode := containerComponentTypes.Decoder()
+
+	ok := nestedContainerSlot(t, ns.MaxSlotDepth)
+	buf := ns.NewWriter()
+	if err := ok.Encode(buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ns.NewReader(buf.Bytes()).ReadSlot(decode); err != nil {
+		t.Fatalf("nesting at the limit should decode, got: %v", err)
+	}
+
+	tooDeep := nestedContainerSlot(t, ns.MaxSlotDepth+1)
+	buf = ns.NewWriter()
+	if err := tooDeep.Encode(buf); err != nil {
+		t.Fatal(err)
+	}
+	_, err := ns.NewReader(buf.Bytes()).ReadSlot(decode)
+	if err == nil || !strings.Contains(err.Error(), "nested deeper") {
+		t.Fatalf("expected depth limit error, got: %v", err)
+	}
+}
+
+func TestBundleContents_RejectsEmpty(t *testing.T) {
+	in := &ns.BundleContents{Items: []ns.Slot{ns.EmptySlot()}}
+	buf := ns.NewWriter()
+	if err := in.Encode(buf); err != nil {
+		t.Fatal(err)
+	}
+	var out ns.BundleContents
+	if err := out.Decode(ns.NewReader(buf.Bytes())); err == nil || !strings.Contains(err.Error(), "empty item") {
+		t.Fatalf("expected empty item error, got: %v", err)
+	}
+}
+
+func TestItemContainerContents_TooManySlots(t *testing.T) {
+	w := ns.NewWriter()
+	w.WriteVarInt(ns.MaxContainerSlots + 1)
+	var out ns.ItemContainerContents
+	if err := out.Decode(ns.NewReader(w.Bytes())); err == nil {
+		t.Fatal("expected error for too many slots")
+	}
+}
+
+func TestChargedProjectiles_RoundTrip(t *testing.T) {
+	in := &ns.ChargedProjectiles{Items: []ns.Slot{ns.NewSlot(3, 1), ns.NewSlot(4, 1)}}
+	var out ns.ChargedProjectiles
+	roundTripComponent(t, in, &out)
+	if len(out.Items) != 2 || out.Items[1].ItemID != 4 {
+		t.Errorf("unexpected projectiles: %+v", out.Items)
+	}
+}
+
+func TestUseRemainder_Nested(t *testing.T) {
+	food, err := containerComponentTypes.Raw(ns.ComponentFood, &ns.Food{Nutrition: 6})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bowl := ns.NewSlot(9, 1)
+	bowl.Components.Add = []ns.RawSlotComponent{food}
+
+	buf := ns.NewWriter()
+	if err := (&ns.UseRemainder{Item: bowl}).Encode(buf); err != nil {
+		t.Fatal(err)
+	}
+	var plain ns.UseRemainder
+	if err := plain.Decode(ns.NewReader(buf.Bytes())); err == nil {
+		t.Fatal("expected nested component error")
+	}
+	var out ns.UseRemainder
+	if err := out.DecodeWith(ns.NewReader(buf.Bytes()), containerComponentTypes.Decoder()); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if out.Item.ItemID != 9 || out.Item.GetComponent(2) == nil {
+		t.Errorf("unexpected remainder: %+v", out.Item)
+	}
+}
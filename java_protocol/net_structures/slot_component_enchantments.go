@@ -0,0 +1,69 @@
+package net_structures
+
+import "fmt"
+
+// EnchantmentLevel is a single enchantment applied to an item.
+type EnchantmentLevel struct {
+	// Enchantment is the registry ID from minecraft:enchantment.
+	Enchantment VarInt
+	Level       VarInt
+}
+
+// Enchantments is the data of the minecraft:enchantments (applied enchantments)
+// and minecraft:stored_enchantments (enchanted books) components.
+//
+// Wire format:
+//
+//	┌────────────────────────────────────────────────────────────────────────┐
+//	│  Enchantments (Prefixed Array of (Enchantment VarInt + Level VarInt))  │
+//	└────────────────────────────────────────────────────────────────────────┘
+//
+// Since 1.21.5 the tooltip lines are hidden through TooltipDisplay instead of a
+// show_in_tooltip flag.
+type Enchantments struct {
+	Enchantments []EnchantmentLevel
+}
+
+// Level returns the level of the given enchantment, or 0 if it is not present.
+func (e *Enchantments) Level(enchantment VarInt) VarInt {
+	for _, l := range e.Enchantments {
+		if l.Enchantment == enchantment {
+			return l.Level
+		}
+	}
+	return 0
+}
+
+// Decode reads Enchantments from the buffer.
+func (e *Enchantments) Decode(buf *PacketBuffer) error {
+	count, err := buf.ReadCount()
+	if err != nil {
+		return fmt.Errorf("failed to read enchantment count: %w", err)
+	}
+	e.Enchantments = make([]EnchantmentLevel, count)
+	for i := range e.Enchantments {
+		if e.Enchantments[i].Enchantment, err = buf.ReadVarInt(); err != nil {
+			return fmt.Errorf("failed to read enchantment %d: %w", i, err)
+		}
+		if e.Enchantments[i].Level, err = buf.ReadVarInt(); err != nil {
+			return fmt.Errorf("failed to read enchantment %d level: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Encode writes Enchantments to the buffer.
+func (e *Enchantments) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(len(e.Enchantments))); err != nil {
+		return fmt.Errorf("failed to write enchantment count: %w", err)
+	}
+	for i, l := range e.Enchantments {
+		if err := buf.WriteVarInt(l.Enchantment); err != nil {
+			return fmt.Errorf("failed to write enchantment %d: %w", i, err)
+		}
+		if err := buf.WriteVarInt(l.Level); err != nil {
+			return fmt.Errorf("failed to write enchantment %d level: %w", i, err)
+		}
+	}
+	return nil
+}
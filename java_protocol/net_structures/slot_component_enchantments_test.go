@@ -0,0 +1,22 @@
+package net_structures_test
+
+import (
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestEnchantments_RoundTrip(t *testing.T) {
+	in := &ns.Enchantments{Enchantments: []ns.EnchantmentLevel{
+		{Enchantment: 12, Level: 5},
+		{Enchantment: 30, Level: 1},
+	}}
+	var out ns.Enchantments
+	roundTripComponent(t, in, &out)
+	if out.Level(12) != 5 || out.Level(30) != 1 {
+		t.Errorf("unexpected enchantments: %+v", out.Enchantments)
+	}
+	if out.Level(7) != 0 {
+		t.Errorf("expected level 0 for missing enchantment, got %d", out.Level(7))
+	}
+}
@@ -0,0 +1,304 @@
+package net_structures
+
+import (
+	"fmt"
+
+	"github.com/go-mclib/protocol/nbt"
+)
+
+// TypedEntityData is the data of the minecraft:entity_data and
+// minecraft:block_entity_data components: the NBT of the entity or block entity
+// created from the item, together with its type.
+//
+// Wire format:
+//
+//	┌──────────────────┬─────────────────┐
+//	│  Type (VarInt)   │  Data (NBT)     │
+//	└──────────────────┴─────────────────┘
+//
+// Type is a registry ID from minecraft:entity_type or minecraft:block_entity_type.
+// Since 1.21.9 the type is no longer stored as an "id" field of the NBT.
+type TypedEntityData struct {
+	Type VarInt
+	Data RawNBT
+}
+
+// Decode reads TypedEntityData from the buffer.
+func (d *TypedEntityData) Decode(buf *PacketBuffer) error {
+	var err error
+	if d.Type, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read entity data type: %w", err)
+	}
+	if d.Data, err = buf.ReadRawNBT(); err != nil {
+		return fmt.Errorf("failed to read entity data: %w", err)
+	}
+	return nil
+}
+
+// Encode writes TypedEntityData to the buffer.
+func (d *TypedEntityData) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(d.Type); err != nil {
+		return fmt.Errorf("failed to write entity data type: %w", err)
+	}
+	if err := buf.WriteRawNBT(d.Data); err != nil {
+		return fmt.Errorf("failed to write entity data: %w", err)
+	}
+	return nil
+}
+
+// BeeOccupant is a bee stored in a beehive or bee nest item.
+type BeeOccupant struct {
+	EntityData     TypedEntityData
+	TicksInHive    VarInt
+	MinTicksInHive VarInt
+}
+
+// Bees is the data of the minecraft:bees component.
+//
+// Wire format:
+//
+//	┌──────────────────────────────────────────────────────────────────────────────┐
+//	│  Bees (Prefixed Array of (TypedEntityData + Ticks In Hive VarInt +           │
+//	│  Min Ticks In Hive VarInt))                                                  │
+//	└──────────────────────────────────────────────────────────────────────────────┘
+type Bees struct {
+	Bees []BeeOccupant
+}
+
+// Decode reads Bees from the buffer.
+func (b *Bees) Decode(buf *PacketBuffer) error {
+	count, err := buf.ReadCount()
+	if err != nil {
+		return fmt.Errorf("failed to read bee count: %w", err)
+	}
+	b.Bees = make([]BeeOccupant, count)
+	for i := range b.Bees {
+		if err := b.Bees[i].EntityData.Decode(buf); err != nil {
+			return fmt.Errorf("failed to read bee %d: %w", i, err)
+		}
+		if b.Bees[i].TicksInHive, err = buf.ReadVarInt(); err != nil {
+			return fmt.Errorf("failed to read bee %d ticks in hive: %w", i, err)
+		}
+		if b.Bees[i].MinTicksInHive, err = buf.ReadVarInt(); err != nil {
+			return fmt.Errorf("failed to read bee %d min ticks in hive: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Encode writes Bees to the buffer.
+func (b *Bees) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(len(b.Bees))); err != nil {
+		return fmt.Errorf("failed to write bee count: %w", err)
+	}
+	for i := range b.Bees {
+		if err := b.Bees[i].EntityData.Encode(buf); err != nil {
+			return fmt.Errorf("failed to write bee %d: %w", i, err)
+		}
+		if err := buf.WriteVarInt(b.Bees[i].TicksInHive); err != nil {
+			return fmt.Errorf("failed to write bee %d ticks in hive: %w", i, err)
+		}
+		if err := buf.WriteVarInt(b.Bees[i].MinTicksInHive); err != nil {
+			return fmt.Errorf("failed to write bee %d min ticks in hive: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// EntityVariant is the data of the variant components of entity items (e.g.
+// minecraft:cat/variant, minecraft:fox/variant, minecraft:salmon/size).
+//
+// Wire format:
+//
+//	┌──────────────────┐
+//	│  ID (VarInt)     │
+//	└──────────────────┘
+//
+// ID is a registry ID for data-driven variants (e.g. minecraft:cat_variant) and
+// an enum ordinal for built-in ones (e.g. fox types).
+type EntityVariant struct {
+	ID VarInt
+}
+
+// Decode reads an EntityVariant from the buffer.
+func (v *EntityVariant) Decode(buf *PacketBuffer) error {
+	var err error
+	if v.ID, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read entity variant: %w", err)
+	}
+	return nil
+}
+
+// Encode writes an EntityVariant to the buffer.
+func (v *EntityVariant) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(v.ID); err != nil {
+		return fmt.Errorf("failed to write entity variant: %w", err)
+	}
+	return nil
+}
+
+// ChickenVariant is the data of the minecraft:chicken/variant component.
+//
+// Wire format:
+//
+//	┌───────────────────┬─────────────────────────────────────────────┐
+//	│  Direct (Boolean) │  Variant (VarInt if direct, else Identifier)│
+//	└───────────────────┴─────────────────────────────────────────────┘
+//
+// A variant given by name (resource key) does not need to be known to the
+// client's registry when the item is sent.
+type ChickenVariant struct {
+	Variant XOrY[VarInt, Identifier]
+}
+
+// Decode reads a ChickenVariant from the buffer.
+func (c *ChickenVariant) Decode(buf *PacketBuffer) error {
+	if err := c.Variant.DecodeWith(buf, (*PacketBuffer).ReadVarInt, (*PacketBuffer).ReadIdentifier); err != nil {
+		return fmt.Errorf("failed to read chicken variant: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a ChickenVariant to the buffer.
+func (c *ChickenVariant) Encode(buf *PacketBuffer) error {
+	if err := c.Variant.EncodeWith(buf, (*PacketBuffer).WriteVarInt, (*PacketBuffer).WriteIdentifier); err != nil {
+		return fmt.Errorf("failed to write chicken variant: %w", err)
+	}
+	return nil
+}
+
+// PaintingVariant is an inline minecraft:painting_variant registry entry.
+//
+// Wire format:
+//
+//	┌──────────────────────────┬──────────────────────────────────────────────┐
+//	│  Width (VarInt)          │  Height (VarInt)                             │
+//	├──────────────────────────┼──────────────────────────────────────────────┤
+//	│  Asset ID (Identifier)   │  Title (Prefixed Optional Text Component)    │
+//	├──────────────────────────┴──────────────────────────────────────────────┤
+//	│  Author (Prefixed Optional Text Component)                              │
+//	└─────────────────────────────────────────────────────────────────────────┘
+//
+// Width and height are in blocks.
+type PaintingVariant struct {
+	Width   VarInt
+	Height  VarInt
+	AssetID Identifier
+	Title   PrefixedOptional[TextComponent]
+	Author  PrefixedOptional[TextComponent]
+}
+
+// Decode reads a PaintingVariant from the buffer.
+func (p *PaintingVariant) Decode(buf *PacketBuffer) error {
+	var err error
+	if p.Width, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read painting width: %w", err)
+	}
+	if p.Height, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read painting height: %w", err)
+	}
+	if p.AssetID, err = buf.ReadIdentifier(); err != nil {
+		return fmt.Errorf("failed to read painting asset id: %w", err)
+	}
+	if err := p.Title.DecodeWith(buf, (*PacketBuffer).ReadTextComponent); err != nil {
+		return fmt.Errorf("failed to read painting title: %w", err)
+	}
+	if err := p.Author.DecodeWith(buf, (*PacketBuffer).ReadTextComponent); err != nil {
+		return fmt.Errorf("failed to read painting author: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a PaintingVariant to the buffer.
+func (p *PaintingVariant) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(p.Width); err != nil {
+		return fmt.Errorf("failed to write painting width: %w", err)
+	}
+	if err := buf.WriteVarInt(p.Height); err != nil {
+		return fmt.Errorf("failed to write painting height: %w", err)
+	}
+	if err := buf.WriteIdentifier(p.AssetID); err != nil {
+		return fmt.Errorf("failed to write painting asset id: %w", err)
+	}
+	if err := p.Title.EncodeWith(buf, (*PacketBuffer).WriteTextComponent); err != nil {
+		return fmt.Errorf("failed to write painting title: %w", err)
+	}
+	if err := p.Author.EncodeWith(buf, (*PacketBuffer).WriteTextComponent); err != nil {
+		return fmt.Errorf("failed to write painting author: %w", err)
+	}
+	return nil
+}
+
+// UnmarshalNBT reads a painting variant from its registry data form:
+//
+//	{asset_id: "minecraft:kebab", width: 1, height: 1, title: {...}, author: {...}}
+func (p *PaintingVariant) UnmarshalNBT(tag nbt.Tag) error {
+	c, ok := tag.(nbt.Compound)
+	if !ok {
+		return fmt.Errorf("painting variant is %s, expected Compound", nbt.TagName(tag.ID()))
+	}
+	*p = PaintingVariant{
+		Width:   VarInt(c.GetInt("width")),
+		Height:  VarInt(c.GetInt("height")),
+		AssetID: Identifier(c.GetString("asset_id")),
+	}
+	if title := c.Get("title"); title != nil {
+		var tc TextComponent
+		if err := tc.UnmarshalNBT(title); err != nil {
+			return fmt.Errorf("failed to read painting title: %w", err)
+		}
+		p.Title = Some(tc)
+	}
+	if author := c.Get("author"); author != nil {
+		var tc TextComponent
+		if err := tc.UnmarshalNBT(author); err != nil {
+			return fmt.Errorf("failed to read painting author: %w", err)
+		}
+		p.Author = Some(tc)
+	}
+	return nil
+}
+
+// PaintingVariantComponent is the data of the minecraft:painting/variant component.
+//
+// Wire format:
+//
+//	┌──────────────────────────────────┐
+//	│  Variant (ID or PaintingVariant) │
+//	└──────────────────────────────────┘
+type PaintingVariantComponent struct {
+	Variant IDOrX[PaintingVariant]
+}
+
+// Decode reads a PaintingVariantComponent from the buffer.
+func (p *PaintingVariantComponent) Decode(buf *PacketBuffer) error {
+	var err error
+	if p.Variant, err = readPaintingVariantHolder(buf); err != nil {
+		return fmt.Errorf("failed to read painting variant: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a PaintingVariantComponent to the buffer.
+func (p *PaintingVariantComponent) Encode(buf *PacketBuffer) error {
+	if err := writePaintingVariantHolder(buf, p.Variant); err != nil {
+		return fmt.Errorf("failed to write painting variant: %w", err)
+	}
+	return nil
+}
+
+func readPaintingVariantHolder(buf *PacketBuffer) (IDOrX[PaintingVariant], error) {
+	var v IDOrX[PaintingVariant]
+	err := v.DecodeWith(buf, func(buf *PacketBuffer) (PaintingVariant, error) {
+		var variant PaintingVariant
+		err := variant.Decode(buf)
+		return variant, err
+	})
+	return v, err
+}
+
+func writePaintingVariantHolder(buf *PacketBuffer, v IDOrX[PaintingVariant]) error {
+	return v.EncodeWith(buf, func(buf *PacketBuffer, variant PaintingVariant) error {
+		return variant.Encode(buf)
+	})
+}
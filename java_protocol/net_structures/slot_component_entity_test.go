@@ -0,0 +1,67 @@
+package net_structures_test
+
+import (
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+)
+
+func TestBees_RoundTrip(t *testing.T) {
+	data, err := nbt.EncodeNetwork(nbt.Compound{"CannotEnterHiveTicks": nbt.Int(0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := &ns.Bees{Bees: []ns.BeeOccupant{
+		{EntityData: ns.TypedEntityData{Type: 8, Data: data}, TicksInHive: 100, MinTicksInHive: 600},
+		{EntityData: ns.TypedEntityData{Type: 8, Data: data}, MinTicksInHive: 2400},
+	}}
+	var out ns.Bees
+	roundTripComponent(t, in, &out)
+	if len(out.Bees) != 2 || out.Bees[0].TicksInHive != 100 || out.Bees[1].MinTicksInHive != 2400 {
+		t.Errorf("unexpected bees: %+v", out.Bees)
+	}
+	if out.Bees[0].EntityData.Type != 8 {
+		t.Errorf("unexpected entity type: %d", out.Bees[0].EntityData.Type)
+	}
+}
+
+func TestChickenVariant_RoundTrip(t *testing.T) {
+	var byID ns.ChickenVariant
+	roundTripComponent(t, &ns.ChickenVariant{Variant: ns.NewX[ns.VarInt, ns.Identifier](2)}, &byID)
+	if id, _, isID := byID.Variant.Get(); !isID || id != 2 {
+		t.Errorf("unexpected variant: %+v", byID.Variant)
+	}
+
+	var byName ns.ChickenVariant
+	roundTripComponent(t, &ns.ChickenVariant{Variant: ns.NewY[ns.VarInt, ns.Identifier]("minecraft:cold")}, &byName)
+	if _, name, isID := byName.Variant.Get(); isID || name != "minecraft:cold" {
+		t.Errorf("unexpected variant: %+v", byName.Variant)
+	}
+}
+
+func TestPaintingVariant_RoundTrip(t *testing.T) {
+	in := &ns.PaintingVariantComponent{Variant: ns.NewInlineValue(ns.PaintingVariant{
+		Width:   4,
+		Height:  2,
+		AssetID: "example:sunset",
+		Title:   ns.Some(ns.NewTextComponent("Sunset")),
+	})}
+	var out ns.PaintingVariantComponent
+	roundTripComponent(t, in, &out)
+	_, v, inline := out.Variant.Get()
+	if !inline || v.Width != 4 || v.AssetID != "example:sunset" || v.Author.Present {
+		t.Errorf("unexpected painting: %+v", v)
+	}
+	if title, ok := v.Title.Get(); !ok || title.Text != "Sunset" {
+		t.Errorf("unexpected title: %+v", v.Title)
+	}
+}
+
+func TestEntityVariant_RoundTrip(t *testing.T) {
+	var out ns.EntityVariant
+	roundTripComponent(t, &ns.EntityVariant{ID: 5}, &out)
+	if out.ID != 5 {
+		t.Errorf("unexpected variant: %d", out.ID)
+	}
+}
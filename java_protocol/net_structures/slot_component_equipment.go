@@ -0,0 +1,215 @@
+package net_structures
+
+import "fmt"
+
+// EquipmentSlot is a single equipment slot of an entity.
+type EquipmentSlot VarInt
+
+const (
+	EquipmentMainHand EquipmentSlot = 0
+	EquipmentFeet     EquipmentSlot = 1
+	EquipmentLegs     EquipmentSlot = 2
+	EquipmentChest    EquipmentSlot = 3
+	EquipmentHead     EquipmentSlot = 4
+	EquipmentOffHand  EquipmentSlot = 5
+	EquipmentBody     EquipmentSlot = 6
+	EquipmentSaddle   EquipmentSlot = 7
+)
+
+var equipmentSlotNames = EnumNames{
+	int32(EquipmentMainHand): "mainhand",
+	int32(EquipmentFeet):     "feet",
+	int32(EquipmentLegs):     "legs",
+	int32(EquipmentChest):    "chest",
+	int32(EquipmentHead):     "head",
+	int32(EquipmentOffHand):  "offhand",
+	int32(EquipmentBody):     "body",
+	int32(EquipmentSaddle):   "saddle",
+}
+
+func (s EquipmentSlot) String() string {
+	return equipmentSlotNames.Name(int32(s))
+}
+
+// Enchantable is the data of the minecraft:enchantable component: the item's
+// enchantability in the enchanting table.
+//
+// Wire format:
+//
+//	┌─────────────────┐
+//	│  Value (VarInt) │
+//	└─────────────────┘
+type Enchantable struct {
+	Value VarInt
+}
+
+// Decode reads an Enchantable from the buffer.
+func (e *Enchantable) Decode(buf *PacketBuffer) error {
+	var err error
+	if e.Value, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read enchantability: %w", err)
+	}
+	return nil
+}
+
+// Encode writes an Enchantable to the buffer.
+func (e *Enchantable) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(e.Value); err != nil {
+		return fmt.Errorf("failed to write enchantability: %w", err)
+	}
+	return nil
+}
+
+// Repairable is the data of the minecraft:repairable component: the items that
+// can repair this one in an anvil.
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────┐
+//	│  Items (ID Set of minecraft:item)   │
+//	└─────────────────────────────────────┘
+type Repairable struct {
+	Items IDSet
+}
+
+// Decode reads a Repairable from the buffer.
+func (r *Repairable) Decode(buf *PacketBuffer) error {
+	if err := r.Items.Decode(buf); err != nil {
+		return fmt.Errorf("failed to read repair items: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a Repairable to the buffer.
+func (r *Repairable) Encode(buf *PacketBuffer) error {
+	if err := r.Items.Encode(buf); err != nil {
+		return fmt.Errorf("failed to write repair items: %w", err)
+	}
+	return nil
+}
+
+// Equippable is the data of the minecraft:equippable component.
+//
+// Wire format:
+//
+//	┌──────────────────────────────────────┬──────────────────────────────────────────────┐
+//	│  Slot (VarInt Enum)                  │  Equip Sound (ID or SoundEvent)              │
+//	├──────────────────────────────────────┼──────────────────────────────────────────────┤
+//	│  Asset ID (Prefixed Optional Ident.) │  Camera Overlay (Prefixed Optional Ident.)   │
+//	├──────────────────────────────────────┴──────────────────────────────────────────────┤
+//	│  Allowed Entities (Prefixed Optional ID Set of minecraft:entity_type)               │
+//	├──────────────────────┬──────────────────────┬───────────────────────────────────────┤
+//	│  Dispensable (Bool)  │  Swappable (Bool)    │  Damage On Hurt (Bool)                │
+//	├──────────────────────┼──────────────────────┼───────────────────────────────────────┤
+//	│  Equip On Interact   │  Can Be Sheared      │  Shearing Sound (ID or SoundEvent)    │
+//	│  (Bool)              │  (Bool)              │                                       │
+//	└──────────────────────┴──────────────────────┴───────────────────────────────────────┘
+//
+// Asset ID is the equipment model (assets/<namespace>/equipment/<path>.json) and
+// Camera Overlay a texture shown over the screen while worn (e.g. carved pumpkin).
+// Without Allowed Entities, any entity can wear the item.
+type Equippable struct {
+	Slot            EquipmentSlot
+	EquipSound      IDOrX[SoundEvent]
+	AssetID         PrefixedOptional[Identifier]
+	CameraOverlay   PrefixedOptional[Identifier]
+	AllowedEntities PrefixedOptional[IDSet]
+	Dispensable     Boolean
+	Swappable       Boolean
+	DamageOnHurt    Boolean
+	EquipOnInteract Boolean
+	CanBeSheared    Boolean
+	ShearingSound   IDOrX[SoundEvent]
+}
+
+// Decode reads an Equippable from the buffer.
+func (e *Equippable) Decode(buf *PacketBuffer) error {
+	slot, err := buf.ReadVarInt()
+	if err != nil {
+		return fmt.Errorf("failed to read equippable slot: %w", err)
+	}
+	if _, ok := equipmentSlotNames[int32(slot)]; !ok {
+		return InvalidEnumError("equipment slot", int32(slot))
+	}
+	e.Slot = EquipmentSlot(slot)
+
+	if e.EquipSound, err = buf.ReadSoundEventHolder(); err != nil {
+		return fmt.Errorf("failed to read equip sound: %w", err)
+	}
+	if err := e.AssetID.DecodeWith(buf, (*PacketBuffer).ReadIdentifier); err != nil {
+		return fmt.Errorf("failed to read equipment asset id: %w", err)
+	}
+	if err := e.CameraOverlay.DecodeWith(buf, (*PacketBuffer).ReadIdentifier); err != nil {
+		return fmt.Errorf("failed to read camera overlay: %w", err)
+	}
+	if err := e.AllowedEntities.DecodeWith(buf, func(buf *PacketBuffer) (IDSet, error) {
+		var s IDSet
+		err := s.Decode(buf)
+		return s, err
+	}); err != nil {
+		return fmt.Errorf("failed to read allowed entities: %w", err)
+	}
+	if e.Dispensable, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read dispensable: %w", err)
+	}
+	if e.Swappable, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read swappable: %w", err)
+	}
+	if e.DamageOnHurt, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read damage on hurt: %w", err)
+	}
+	if e.EquipOnInteract, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read equip on interact: %w", err)
+	}
+	if e.CanBeSheared, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read can be sheared: %w", err)
+	}
+	if e.ShearingSound, err = buf.ReadSoundEventHolder(); err != nil {
+		return fmt.Errorf("failed to read shearing sound: %w", err)
+	}
+	return nil
+}
+
+// Encode writes an Equippable to the buffer.
+func (e *Equippable) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(e.Slot)); err != nil {
+		return fmt.Errorf("failed to write equippable slot: %w", err)
+	}
+	if err := buf.WriteSoundEventHolder(e.EquipSound); err != nil {
+		return fmt.Errorf("failed to write equip sound: %w", err)
+	}
+	if err := e.AssetID.EncodeWith(buf, (*PacketBuffer).WriteIdentifier); err != nil {
+		return fmt.Errorf("failed to write equipment asset id: %w", err)
+	}
+	if err := e.CameraOverlay.EncodeWith(buf, (*PacketBuffer).WriteIdentifier); err != nil {
+		return fmt.Errorf("failed to write camera overlay: %w", err)
+	}
+	if err := e.AllowedEntities.EncodeWith(buf, func(buf *PacketBuffer, s IDSet) error {
+		return s.Encode(buf)
+	}); err != nil {
+		return fmt.Errorf("failed to write allowed entities: %w", err)
+	}
+	if err := buf.WriteBool(e.Dispensable); err != nil {
+		return fmt.Errorf("failed to write dispensable: %w", err)
+	}
+	if err := buf.WriteBool(e.Swappable); err != nil {
+		return fmt.Errorf("failed to write swappable: %w", err)
+	}
+	if err := buf.WriteBool(e.DamageOnHurt); err != nil {
+		return fmt.Errorf("failed to write damage on hurt: %w", err)
+	}
+	if err := buf.WriteBool(e.EquipOnInteract); err != nil {
+		return fmt.Errorf("failed to write equip on interact: %w", err)
+	}
+	if err := buf.WriteBool(e.CanBeSheared); err != nil {
+		return fmt.Errorf("failed to write can be sheared: %w", err)
+	}
+	if err := buf.WriteSoundEventHolder(e.ShearingSound); err != nil {
+		return fmt.Errorf("failed to write shearing sound: %w", err)
+	}
+	return nil
+}
+
+func init() {
+	RegisterEnumNames("equipment slot", equipmentSlotNames)
+}
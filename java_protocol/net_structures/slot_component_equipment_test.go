@@ -0,0 +1,118 @@
+package net_structures_test
+
+import (
+	"strings"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestEquippable_RoundTrip(t *testing.T) {
+	in := &ns.Equippable{
+		Slot:            ns.EquipmentBody,
+		EquipSound:      ns.NewIDRef[ns.SoundEvent](120),
+		AssetID:         ns.Some[ns.Identifier]("minecraft:diamond"),
+		AllowedEntities: ns.Some(*ns.NewTagIDSet("minecraft:can_wear_horse_armor")),
+		Dispensable:     true,
+		DamageOnHurt:    true,
+		CanBeSheared:    true,
+		ShearingSound:   ns.NewInlineValue(ns.SoundEvent{Name: "example:item.armor.unequip"}),
+	}
+	var out ns.Equippable
+	roundTripComponent(t, in, &out)
+
+	if out.Slot != ns.EquipmentBody || out.Slot.String() != "body" {
+		t.Errorf("unexpected slot: %v", out.Slot)
+	}
+	if entities, ok := out.AllowedEntities.Get(); !ok || entities.TagName != "minecraft:can_wear_horse_armor" {
+		t.Errorf("unexpected allowed entities: %+v", out.AllowedEntities)
+	}
+	if out.CameraOverlay.Present || bool(out.Swappable) || !bool(out.CanBeSheared) {
+		t.Errorf("unexpected flags: %+v", out)
+	}
+	if !out.ShearingSound.IsInline || out.ShearingSound.Value.Name != "example:item.armor.unequip" {
+		t.Errorf("unexpected shearing sound: %+v", out.ShearingSound)
+	}
+}
+
+func TestEquippable_Wire(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *ns.Equippable
+		raw  []byte
+	}{
+		{
+			name: "carved pumpkin",
+			in: &ns.Equippable{
+				Slot:          ns.EquipmentHead,
+				EquipSound:    ns.NewIDRef[ns.SoundEvent](200),
+				CameraOverlay: ns.Some[ns.Identifier]("minecraft:misc/pumpkinblur"),
+				Dispensable:   true,
+				ShearingSound: ns.NewIDRef[ns.SoundEvent](0),
+			},
+			raw: wire(
+				[]byte{0x04, 0xc9, 0x01}, // slot=head, equip sound id 200+1
+				[]byte{0x00},             // no asset id
+				[]byte{0x01, 0x1a}, "minecraft:misc/pumpkinblur",
+				[]byte{0x00}, // no allowed entities
+				// dispensable, swappable, damage on hurt, equip on interact, can be sheared
+				[]byte{0x01, 0x00, 0x00, 0x00, 0x00},
+				[]byte{0x01}, // shearing sound id 0+1
+			),
+		},
+		{
+			name: "body armor",
+			in: &ns.Equippable{
+				Slot:            ns.EquipmentBody,
+				EquipSound:      ns.NewInlineValue(ns.SoundEvent{Name: "minecraft:item.armor.equip_generic"}),
+				AssetID:         ns.Some[ns.Identifier]("minecraft:diamond"),
+				AllowedEntities: ns.Some(*ns.NewInlineIDSet([]ns.VarInt{40, 41})),
+				Dispensable:     true,
+				DamageOnHurt:    true,
+				EquipOnInteract: true,
+				ShearingSound:   ns.NewInlineValue(ns.SoundEvent{Name: "example:snip", FixedRange: ns.Some[ns.Float32](16)}),
+			},
+			raw: wire(
+				[]byte{0x06}, // slot=body
+				// inline equip sound without a fixed range
+				[]byte{0x00, 0x22}, "minecraft:item.armor.equip_generic", []byte{0x00},
+				[]byte{0x01, 0x11}, "minecraft:diamond",
+				[]byte{0x00},                   // no camera overlay
+				[]byte{0x01, 0x03, 0x28, 0x29}, // allowed entities [40, 41]
+				// dispensable, swappable, damage on hurt, equip on interact, can be sheared
+				[]byte{0x01, 0x00, 0x01, 0x01, 0x00},
+				// inline shearing sound, fixed range=16.0
+				[]byte{0x00, 0x0c}, "example:snip", []byte{0x01, 0x41, 0x80, 0x00, 0x00},
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkComponentWire(t, tt.in, tt.raw, &ns.Equippable{})
+		})
+	}
+}
+
+func TestEquippable_InvalidSlot(t *testing.T) {
+	w := ns.NewWriter()
+	w.WriteVarInt(9)
+	var out ns.Equippable
+	err := out.Decode(ns.NewReader(w.Bytes()))
+	if err == nil || !strings.Contains(err.Error(), "invalid equipment slot: 9") {
+		t.Fatalf("expected invalid slot error, got: %v", err)
+	}
+}
+
+func TestEnchantableRepairable_RoundTrip(t *testing.T) {
+	var enchantable ns.Enchantable
+	roundTripComponent(t, &ns.Enchantable{Value: 15}, &enchantable)
+	if enchantable.Value != 15 {
+		t.Errorf("unexpected enchantability: %d", enchantable.Value)
+	}
+
+	var repairable ns.Repairable
+	roundTripComponent(t, &ns.Repairable{Items: *ns.NewInlineIDSet([]ns.VarInt{805})}, &repairable)
+	if repairable.Items.IsTag || len(repairable.Items.IDs) != 1 || repairable.Items.IDs[0] != 805 {
+		t.Errorf("unexpected repair items: %+v", repairable.Items)
+	}
+}
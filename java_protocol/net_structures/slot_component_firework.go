@@ -0,0 +1,208 @@
+package net_structures
+
+import "fmt"
+
+// MaxFireworkExplosions is the maximum number of explosions in a Fireworks component.
+const MaxFireworkExplosions = 256
+
+// FireworkShape is the shape of a firework explosion.
+type FireworkShape VarInt
+
+const (
+	FireworkSmallBall FireworkShape = 0
+	FireworkLargeBall FireworkShape = 1
+	FireworkStar      FireworkShape = 2
+	FireworkCreeper   FireworkShape = 3
+	FireworkBurst     FireworkShape = 4
+)
+
+var fireworkShapeNames = EnumNames{
+	int32(FireworkSmallBall): "small_ball",
+	int32(FireworkLargeBall): "large_ball",
+	int32(FireworkStar):      "star",
+	int32(FireworkCreeper):   "creeper",
+	int32(FireworkBurst):     "burst",
+}
+
+func (s FireworkShape) String() string {
+	return fireworkShapeNames.Name(int32(s))
+}
+
+// FireworkExplosion is the data of the minecraft:firework_explosion component
+// (firework star), and a single explosion of a firework rocket.
+//
+// Wire format:
+//
+//	┌──────────────────┬──────────────────────────────┬───────────────────────────────────┐
+//	│  Shape (VarInt)  │  Colors (Prefixed Array Int) │  Fade Colors (Prefixed Array Int) │
+//	├──────────────────┼──────────────────────────────┼───────────────────────────────────┘
+//	│  Has Trail (Bool)│  Has Twinkle (Bool)          │
+//	└──────────────────┴──────────────────────────────┘
+//
+// Colors are RGB values (0xRRGGBB).
+type FireworkExplosion struct {
+	Shape      FireworkShape
+	Colors     []Int32
+	FadeColors []Int32
+	HasTrail   Boolean
+	HasTwinkle Boolean
+}
+
+// NewFireworkExplosion creates an explosion of the given shape and colors.
+func NewFireworkExplosion(shape FireworkShape, colors ...Int32) FireworkExplosion {
+	return FireworkExplosion{Shape: shape, Colors: colors}
+}
+
+// WithFade returns a copy of the explosion fading to the given colors.
+func (e FireworkExplosion) WithFade(colors ...Int32) FireworkExplosion {
+	e.FadeColors = colors
+	return e
+}
+
+// WithTrail returns a copy of the explosion with a trail.
+func (e FireworkExplosion) WithTrail() FireworkExplosion {
+	e.HasTrail = true
+	return e
+}
+
+// WithTwinkle returns a copy of the explosion with twinkling particles.
+func (e FireworkExplosion) WithTwinkle() FireworkExplosion {
+	e.HasTwinkle = true
+	return e
+}
+
+// Decode reads a FireworkExplosion from the buffer.
+func (e *FireworkExplosion) Decode(buf *PacketBuffer) error {
+	shape, err := buf.ReadVarInt()
+	if err != nil {
+		return fmt.Errorf("failed to read firework shape: %w", err)
+	}
+	if _, ok := fireworkShapeNames[int32(shape)]; !ok {
+		return InvalidEnumError("firework shape", int32(shape))
+	}
+	e.Shape = FireworkShape(shape)
+	if e.Colors, err = readInt32List(buf); err != nil {
+		return fmt.Errorf("failed to read firework colors: %w", err)
+	}
+	if e.FadeColors, err = readInt32List(buf); err != nil {
+		return fmt.Errorf("failed to read firework fade colors: %w", err)
+	}
+	if e.HasTrail, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read firework trail: %w", err)
+	}
+	if e.HasTwinkle, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read firework twinkle: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a FireworkExplosion to the buffer.
+func (e *FireworkExplosion) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(e.Shape)); err != nil {
+		return fmt.Errorf("failed to write firework shape: %w", err)
+	}
+	if err := writeInt32List(buf, e.Colors); err != nil {
+		return fmt.Errorf("failed to write firework colors: %w", err)
+	}
+	if err := writeInt32List(buf, e.FadeColors); err != nil {
+		return fmt.Errorf("failed to write firework fade colors: %w", err)
+	}
+	if err := buf.WriteBool(e.HasTrail); err != nil {
+		return fmt.Errorf("failed to write firework trail: %w", err)
+	}
+	if err := buf.WriteBool(e.HasTwinkle); err != nil {
+		return fmt.Errorf("failed to write firework twinkle: %w", err)
+	}
+	return nil
+}
+
+// Fireworks is the data of the minecraft:fireworks component (firework rocket).
+//
+// Wire format:
+//
+//	┌───────────────────────────┬───────────────────────────────────────────────────┐
+//	│  Flight Duration (VarInt) │  Explosions (Prefixed Array of FireworkExplosion) │
+//	└───────────────────────────┴───────────────────────────────────────────────────┘
+type Fireworks struct {
+	// FlightDuration is the rocket's flight duration in units of gunpowder (1-3 when crafted).
+	FlightDuration VarInt
+	Explosions     []FireworkExplosion
+}
+
+// NewFireworks creates a rocket with the given flight duration and explosions.
+func NewFireworks(flightDuration VarInt, explosions ...FireworkExplosion) Fireworks {
+	return Fireworks{FlightDuration: flightDuration, Explosions: explosions}
+}
+
+// Decode reads a Fireworks component from the buffer.
+func (f *Fireworks) Decode(buf *PacketBuffer) error {
+	var err error
+	if f.FlightDuration, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read flight duration: %w", err)
+	}
+	count, err := buf.ReadVarInt()
+	if err != nil {
+		return fmt.Errorf("failed to read explosion count: %w", err)
+	}
+	if count < 0 || count > MaxFireworkExplosions {
+		return fmt.Errorf("invalid explosion count: %d (max %d)", count, MaxFireworkExplosions)
+	}
+	f.Explosions = make([]FireworkExplosion, count)
+	for i := range f.Explosions {
+		if err := f.Explosions[i].Decode(buf); err != nil {
+			return fmt.Errorf("failed to read explosion %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Encode writes a Fireworks component to the buffer.
+func (f *Fireworks) Encode(buf *PacketBuffer) error {
+	if len(f.Explosions) > MaxFireworkExplosions {
+		return fmt.Errorf("too many explosions: %d (max %d)", len(f.Explosions), MaxFireworkExplosions)
+	}
+	if err := buf.WriteVarInt(f.FlightDuration); err != nil {
+		return fmt.Errorf("failed to write flight duration: %w", err)
+	}
+	if err := buf.WriteVarInt(VarInt(len(f.Explosions))); err != nil {
+		return fmt.Errorf("failed to write explosion count: %w", err)
+	}
+	for i := range f.Explosions {
+		if err := f.Explosions[i].Encode(buf); err != nil {
+			return fmt.Errorf("failed to write explosion %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// readInt32List reads a VarInt-prefixed list of Ints.
+func readInt32List(buf *PacketBuffer) ([]Int32, error) {
+	count, err := buf.ReadCount()
+	if err != nil {
+		return nil, err
+	}
+	list := make([]Int32, count)
+	for i := range list {
+		if list[i], err = buf.ReadInt32(); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// writeInt32List writes a VarInt-prefixed list of Ints.
+func writeInt32List(buf *PacketBuffer, list []Int32) error {
+	if err := buf.WriteVarInt(VarInt(len(list))); err != nil {
+		return err
+	}
+	for _, v := range list {
+		if err := buf.WriteInt32(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func init() {
+	RegisterEnumNames("firework shape", fireworkShapeNames)
+}
@@ -0,0 +1,88 @@
+package net_structures_test
+
+import (
+	"strings"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestFireworks_RoundTrip(t *testing.T) {
+	in := ns.NewFireworks(2,
+		ns.NewFireworkExplosion(ns.FireworkStar, 0xFF0000, 0x00FF00).WithFade(0xFFFFFF).WithTrail(),
+		ns.NewFireworkExplosion(ns.FireworkCreeper).WithTwinkle(),
+	)
+	var out ns.Fireworks
+	roundTripComponent(t, &in, &out)
+
+	if out.FlightDuration != 2 || len(out.Explosions) != 2 {
+		t.Fatalf("unexpected fireworks: %+v", out)
+	}
+	first := out.Explosions[0]
+	if first.Shape != ns.FireworkStar || len(first.Colors) != 2 || first.Colors[1] != 0x00FF00 ||
+		len(first.FadeColors) != 1 || !bool(first.HasTrail) || bool(first.HasTwinkle) {
+		t.Errorf("unexpected first explosion: %+v", first)
+	}
+	if !bool(out.Explosions[1].HasTwinkle) || out.Explosions[1].Shape.String() != "creeper" {
+		t.Errorf("unexpected second explosion: %+v", out.Explosions[1])
+	}
+}
+
+func TestFireworkExplosion_Wire(t *testing.T) {
+	tests := []struct {
+		name string
+		in   ns.FireworkExplosion
+		raw  []byte
+	}{
+		{
+			name: "star with fade and trail",
+			in:   ns.NewFireworkExplosion(ns.FireworkStar, 0xFF0000, 0x00FF00).WithFade(0xFFFFFF).WithTrail(),
+			// shape=star, 2 colors, 1 fade color, trail=true, twinkle=false
+			raw: []byte{
+				0x02,
+				0x02, 0x00, 0xff, 0x00, 0x00, 0x00, 0x00, 0xff, 0x00,
+				0x01, 0x00, 0xff, 0xff, 0xff,
+				0x01, 0x00,
+			},
+		},
+		{
+			name: "creeper without fade",
+			in: ns.FireworkExplosion{
+				Shape:      ns.FireworkCreeper,
+				Colors:     []ns.Int32{0x123456},
+				FadeColors: []ns.Int32{},
+				HasTwinkle: true,
+			},
+			// shape=creeper, 1 color, no fade colors, trail=false, twinkle=true
+			raw: []byte{
+				0x03,
+				0x01, 0x00, 0x12, 0x34, 0x56,
+				0x00,
+				0x00, 0x01,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkComponentWire(t, &tt.in, tt.raw, &ns.FireworkExplosion{})
+		})
+	}
+}
+
+func TestFireworkExplosion_InvalidShape(t *testing.T) {
+	w := ns.NewWriter()
+	w.WriteVarInt(5)
+
+	var out ns.FireworkExplosion
+	err := out.Decode(ns.NewReader(w.Bytes()))
+	if err == nil || !strings.Contains(err.Error(), "invalid firework shape: 5") {
+		t.Fatalf("expected invalid shape error, got: %v", err)
+	}
+}
+
+func TestFireworks_TooManyExplosions(t *testing.T) {
+	in := ns.Fireworks{Explosions: make([]ns.FireworkExplosion, ns.MaxFireworkExplosions+1)}
+	if err := in.Encode(ns.NewWriter()); err == nil {
+		t.Fatal("expected error encoding too many explosions")
+	}
+}
@@ -0,0 +1,410 @@
+package net_structures
+
+import "fmt"
+
+// Food is the data of the minecraft:food component. Eating behavior (duration,
+// animation, effects) is described by the separate Consumable component.
+//
+// Wire format:
+//
+//	┌─────────────────────┬──────────────────────────────┬────────────────────────┐
+//	│  Nutrition (VarInt) │  Saturation Modifier (Float) │  Can Always Eat (Bool) │
+//	└─────────────────────┴──────────────────────────────┴────────────────────────┘
+type Food struct {
+	Nutrition          VarInt
+	SaturationModifier Float32
+	// CanAlwaysEat allows eating with a full hunger bar.
+	CanAlwaysEat Boolean
+}
+
+// Saturation returns the saturation restored by eating, as computed by vanilla
+// (nutrition × modifier × 2).
+func (f *Food) Saturation() float32 {
+	return float32(f.Nutrition) * float32(f.SaturationModifier) * 2
+}
+
+// Decode reads a Food component from the buffer.
+func (f *Food) Decode(buf *PacketBuffer) error {
+	var err error
+	if f.Nutrition, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read nutrition: %w", err)
+	}
+	if f.SaturationModifier, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read saturation modifier: %w", err)
+	}
+	if f.CanAlwaysEat, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read can always eat: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a Food component to the buffer.
+func (f *Food) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(f.Nutrition); err != nil {
+		return fmt.Errorf("failed to write nutrition: %w", err)
+	}
+	if err := buf.WriteFloat32(f.SaturationModifier); err != nil {
+		return fmt.Errorf("failed to write saturation modifier: %w", err)
+	}
+	if err := buf.WriteBool(f.CanAlwaysEat); err != nil {
+		return fmt.Errorf("failed to write can always eat: %w", err)
+	}
+	return nil
+}
+
+// ItemUseAnimation is the animation played while using an item.
+type ItemUseAnimation VarInt
+
+const (
+	UseAnimationNone     ItemUseAnimation = 0
+	UseAnimationEat      ItemUseAnimation = 1
+	UseAnimationDrink    ItemUseAnimation = 2
+	UseAnimationBlock    ItemUseAnimation = 3
+	UseAnimationBow      ItemUseAnimation = 4
+	UseAnimationTrident  ItemUseAnimation = 5
+	UseAnimationCrossbow ItemUseAnimation = 6
+	UseAnimationSpyglass ItemUseAnimation = 7
+	UseAnimationTootHorn ItemUseAnimation = 8
+	UseAnimationBrush    ItemUseAnimation = 9
+	UseAnimationBundle   ItemUseAnimation = 10
+	UseAnimationSpear    ItemUseAnimation = 11
+)
+
+var itemUseAnimationNames = EnumNames{
+	int32(UseAnimationNone):     "none",
+	int32(UseAnimationEat):      "eat",
+	int32(UseAnimationDrink):    "drink",
+	int32(UseAnimationBlock):    "block",
+	int32(UseAnimationBow):      "bow",
+	int32(UseAnimationTrident):  "trident",
+	int32(UseAnimationCrossbow): "crossbow",
+	int32(UseAnimationSpyglass): "spyglass",
+	int32(UseAnimationTootHorn): "toot_horn",
+	int32(UseAnimationBrush):    "brush",
+	int32(UseAnimationBundle):   "bundle",
+	int32(UseAnimationSpear):    "spear",
+}
+
+func (a ItemUseAnimation) String() string {
+	return itemUseAnimationNames.Name(int32(a))
+}
+
+// ConsumeEffectType is the registry ID from minecraft:consume_effect_type.
+type ConsumeEffectType VarInt
+
+const (
+	ConsumeApplyEffects     ConsumeEffectType = 0
+	ConsumeRemoveEffects    ConsumeEffectType = 1
+	ConsumeClearAllEffects  ConsumeEffectType = 2
+	ConsumeTeleportRandomly ConsumeEffectType = 3
+	ConsumePlaySound        ConsumeEffectType = 4
+)
+
+var consumeEffectTypeNames = EnumNames{
+	int32(ConsumeApplyEffects):     "apply_effects",
+	int32(ConsumeRemoveEffects):    "remove_effects",
+	int32(ConsumeClearAllEffects):  "clear_all_effects",
+	int32(ConsumeTeleportRandomly): "teleport_randomly",
+	int32(ConsumePlaySound):        "play_sound",
+}
+
+func (t ConsumeEffectType) String() string {
+	return consumeEffectTypeNames.Name(int32(t))
+}
+
+// ConsumeEffect is an effect applied when an item is consumed. Only the fields
+// of its Type are encoded:
+//
+//	apply_effects:     Effects (Prefixed Array of PotionEffect) + Probability (Float)
+//	remove_effects:    RemoveEffects (ID Set of minecraft:mob_effect)
+//	clear_all_effects: no fields
+//	teleport_randomly: Diameter (Float)
+//	play_sound:        Sound (ID or SoundEvent)
+type ConsumeEffect struct {
+	Type ConsumeEffectType
+
+	Effects       []PotionEffect
+	Probability   Float32
+	RemoveEffects IDSet
+	Diameter      Float32
+	Sound         IDOrX[SoundEvent]
+}
+
+// Decode reads a ConsumeEffect from the buffer.
+func (e *ConsumeEffect) Decode(buf *PacketBuffer) error {
+	t, err := buf.ReadVarInt()
+	if err != nil {
+		return fmt.Errorf("failed to read consume effect type: %w", err)
+	}
+	e.Type = ConsumeEffectType(t)
+
+	switch e.Type {
+	case ConsumeApplyEffects:
+		if e.Effects, err = readPotionEffects(buf); err != nil {
+			return err
+		}
+		if e.Probability, err = buf.ReadFloat32(); err != nil {
+			return fmt.Errorf("failed to read probability: %w", err)
+		}
+	case ConsumeRemoveEffects:
+		if err := e.RemoveEffects.Decode(buf); err != nil {
+			return fmt.Errorf("failed to read removed effects: %w", err)
+		}
+	case ConsumeClearAllEffects:
+	case ConsumeTeleportRandomly:
+		if e.Diameter, err = buf.ReadFloat32(); err != nil {
+			return fmt.Errorf("failed to read diameter: %w", err)
+		}
+	case ConsumePlaySound:
+		if e.Sound, err = buf.ReadSoundEventHolder(); err != nil {
+			return fmt.Errorf("failed to read sound: %w", err)
+		}
+	default:
+		return InvalidEnumError("consume effect type", int32(t))
+	}
+	return nil
+}
+
+// Encode writes a ConsumeEffect to the buffer.
+func (e *ConsumeEffect) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(e.Type)); err != nil {
+		return fmt.Errorf("failed to write consume effect type: %w", err)
+	}
+
+	switch e.Type {
+	case ConsumeApplyEffects:
+		if err := writePotionEffects(buf, e.Effects); err != nil {
+			return err
+		}
+		if err := buf.WriteFloat32(e.Probability); err != nil {
+			return fmt.Errorf("failed to write probability: %w", err)
+		}
+	case ConsumeRemoveEffects:
+		if err := e.RemoveEffects.Encode(buf); err != nil {
+			return fmt.Errorf("failed to write removed effects: %w", err)
+		}
+	case ConsumeClearAllEffects:
+	case ConsumeTeleportRandomly:
+		if err := buf.WriteFloat32(e.Diameter); err != nil {
+			return fmt.Errorf("failed to write diameter: %w", err)
+		}
+	case ConsumePlaySound:
+		if err := buf.WriteSoundEventHolder(e.Sound); err != nil {
+			return fmt.Errorf("failed to write sound: %w", err)
+		}
+	default:
+		return InvalidEnumError("consume effect type", int32(e.Type))
+	}
+	return nil
+}
+
+// Consumable is the data of the minecraft:consumable component.
+//
+// Wire format:
+//
+//	┌──────────────────────────┬──────────────────────┬──────────────────────────────────────────────┐
+//	│  Consume Seconds (Float) │  Animation (VarInt)  │  Sound (ID or SoundEvent)                    │
+//	├──────────────────────────┴──────────────────────┼──────────────────────────────────────────────┤
+//	│  Has Consume Particles (Bool)                   │  Effects (Prefixed Array of ConsumeEffect)   │
+//	└─────────────────────────────────────────────────┴──────────────────────────────────────────────┘
+type Consumable struct {
+	ConsumeSeconds      Float32
+	Animation           ItemUseAnimation
+	Sound               IDOrX[SoundEvent]
+	HasConsumeParticles Boolean
+	Effects             []ConsumeEffect
+}
+
+// ConsumeTicks returns the time it takes to consume the item in game ticks.
+func (c *Consumable) ConsumeTicks() int {
+	return int(float32(c.ConsumeSeconds) * TicksPerSecond)
+}
+
+// Decode reads a Consumable component from the buffer.
+func (c *Consumable) Decode(buf *PacketBuffer) error {
+	var err error
+	if c.ConsumeSeconds, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read consume seconds: %w", err)
+	}
+	anim, err := buf.ReadVarInt()
+	if err != nil {
+		return fmt.Errorf("failed to read animation: %w", err)
+	}
+	if _, ok := itemUseAnimationNames[int32(anim)]; !ok {
+		return InvalidEnumError("item use animation", int32(anim))
+	}
+	c.Animation = ItemUseAnimation(anim)
+	if c.Sound, err = buf.ReadSoundEventHolder(); err != nil {
+		return fmt.Errorf("failed to read sound: %w", err)
+	}
+	if c.HasConsumeParticles, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read has consume particles: %w", err)
+	}
+
+	count, err := buf.ReadCount()
+	if err != nil {
+		return fmt.Errorf("failed to read consume effect count: %w", err)
+	}
+	c.Effects = make([]ConsumeEffect, count)
+	for i := range c.Effects {
+		if err := c.Effects[i].Decode(buf); err != nil {
+			return fmt.Errorf("failed to read consume effect %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Encode writes a Consumable component to the buffer.
+func (c *Consumable) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteFloat32(c.ConsumeSeconds); err != nil {
+		return fmt.Errorf("failed to write consume seconds: %w", err)
+	}
+	if err := buf.WriteVarInt(VarInt(c.Animation)); err != nil {
+		return fmt.Errorf("failed to write animation: %w", err)
+	}
+	if err := buf.WriteSoundEventHolder(c.Sound); err != nil {
+		return fmt.Errorf("failed to write sound: %w", err)
+	}
+	if err := buf.WriteBool(c.HasConsumeParticles); err != nil {
+		return fmt.Errorf("failed to write has consume particles: %w", err)
+	}
+	if err := buf.WriteVarInt(VarInt(len(c.Effects))); err != nil {
+		return fmt.Errorf("failed to write consume effect count: %w", err)
+	}
+	for i := range c.Effects {
+		if err := c.Effects[i].Encode(buf); err != nil {
+			return fmt.Errorf("failed to write consume effect %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// DeathProtection is the data of the minecraft:death_protection component: the
+// item saves its holder from dying (as totems do) and applies the effects.
+//
+// Wire format:
+//
+//	┌──────────────────────────────────────────────┐
+//	│  Effects (Prefixed Array of ConsumeEffect)   │
+//	└──────────────────────────────────────────────┘
+type DeathProtection struct {
+	Effects []ConsumeEffect
+}
+
+// Decode reads a DeathProtection component from the buffer.
+func (d *DeathProtection) Decode(buf *PacketBuffer) error {
+	count, err := buf.ReadCount()
+	if err != nil {
+		return fmt.Errorf("failed to read death protection effect count: %w", err)
+	}
+	d.Effects = make([]ConsumeEffect, count)
+	for i := range d.Effects {
+		if err := d.Effects[i].Decode(buf); err != nil {
+			return fmt.Errorf("failed to read death protection effect %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Encode writes a DeathProtection component to the buffer.
+func (d *DeathProtection) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(len(d.Effects))); err != nil {
+		return fmt.Errorf("failed to write death protection effect count: %w", err)
+	}
+	for i := range d.Effects {
+		if err := d.Effects[i].Encode(buf); err != nil {
+			return fmt.Errorf("failed to write death protection effect %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// UseCooldown is the data of the minecraft:use_cooldown component.
+//
+// Wire format:
+//
+//	┌───────────────────┬───────────────────────────────────────────────┐
+//	│  Seconds (Float)  │  Cooldown Group (Prefixed Optional Identifier)│
+//	└───────────────────┴───────────────────────────────────────────────┘
+//
+// Items sharing a cooldown group go on cooldown together; without a group the
+// item's own ID is used.
+type UseCooldown struct {
+	Seconds       Float32
+	CooldownGroup PrefixedOptional[Identifier]
+}
+
+// Decode reads a UseCooldown component from the buffer.
+func (u *UseCooldown) Decode(buf *PacketBuffer) error {
+	var err error
+	if u.Seconds, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read cooldown seconds: %w", err)
+	}
+	if err := u.CooldownGroup.DecodeWith(buf, (*PacketBuffer).ReadIdentifier); err != nil {
+		return fmt.Errorf("failed to read cooldown group: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a UseCooldown component to the buffer.
+func (u *UseCooldown) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteFloat32(u.Seconds); err != nil {
+		return fmt.Errorf("failed to write cooldown seconds: %w", err)
+	}
+	if err := u.CooldownGroup.EncodeWith(buf, (*PacketBuffer).WriteIdentifier); err != nil {
+		return fmt.Errorf("failed to write cooldown group: %w", err)
+	}
+	return nil
+}
+
+// UseEffects is the data of the minecraft:use_effects component: how using
+// the item (eating, drawing a bow, charging a spear, ...) affects the player.
+//
+// Wire format:
+//
+//	┌────────────────────────┬─────────────────────────────────┬────────────────────────────┐
+//	│  Can Sprint (Boolean)  │  Interact Vibrations (Boolean)  │  Speed Multiplier (Float)  │
+//	└────────────────────────┴─────────────────────────────────┴────────────────────────────┘
+//
+// Speed Multiplier scales the player's movement while using the item (0.2 for
+// items without the component). Interact Vibrations makes use emit a game
+// event that sculk sensors detect.
+type UseEffects struct {
+	CanSprint          Boolean
+	InteractVibrations Boolean
+	SpeedMultiplier    Float32
+}
+
+// Decode reads a UseEffects component from the buffer.
+func (u *UseEffects) Decode(buf *PacketBuffer) error {
+	var err error
+	if u.CanSprint, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read can sprint: %w", err)
+	}
+	if u.InteractVibrations, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read interact vibrations: %w", err)
+	}
+	if u.SpeedMultiplier, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read use speed multiplier: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a UseEffects component to the buffer.
+func (u *UseEffects) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteBool(u.CanSprint); err != nil {
+		return fmt.Errorf("failed to write can sprint: %w", err)
+	}
+	if err := buf.WriteBool(u.InteractVibrations); err != nil {
+		return fmt.Errorf("failed to write interact vibrations: %w", err)
+	}
+	if err := buf.WriteFloat32(u.SpeedMultiplier); err != nil {
+		return fmt.Errorf("failed to write use speed multiplier: %w", err)
+	}
+	return nil
+}
+
+func init() {
+	RegisterEnumNames("item use animation", itemUseAnimationNames)
+	RegisterEnumNames("consume effect type", consumeEffectTypeNames)
+}
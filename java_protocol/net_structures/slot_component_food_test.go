@@ -0,0 +1,117 @@
+package net_structures_test
+
+import (
+	"strings"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestFood_RoundTrip(t *testing.T) {
+	in := &ns.Food{Nutrition: 4, SaturationModifier: 0.3, CanAlwaysEat: true}
+	var out ns.Food
+	roundTripComponent(t, in, &out)
+
+	if out != *in {
+		t.Errorf("got %+v, want %+v", out, *in)
+	}
+	if got := out.Saturation(); got < 2.39 || got > 2.41 {
+		t.Errorf("Saturation() = %v, want 2.4", got)
+	}
+}
+
+func TestConsumable_RoundTrip(t *testing.T) {
+	in := &ns.Consumable{
+		ConsumeSeconds:      1.6,
+		Animation:           ns.UseAnimationEat,
+		Sound:               ns.NewIDRef[ns.SoundEvent](42),
+		HasConsumeParticles: true,
+		Effects: []ns.ConsumeEffect{
+			{
+				Type: ns.ConsumeApplyEffects,
+				Effects: []ns.PotionEffect{{
+					Effect: 9,
+					Details: ns.MobEffectDetail{
+						Amplifier: 1, Duration: 100, ShowIcon: true,
+						HiddenEffect: &ns.MobEffectDetail{Duration: 600},
+					},
+				}},
+				Probability: 0.8,
+			},
+			{Type: ns.ConsumeRemoveEffects, RemoveEffects: *ns.NewTagIDSet("minecraft:harmful")},
+			{Type: ns.ConsumeClearAllEffects},
+			{Type: ns.ConsumeTeleportRandomly, Diameter: 16},
+			{Type: ns.ConsumePlaySound, Sound: ns.NewInlineValue(ns.SoundEvent{
+				Name:       "minecraft:entity.generic.burp",
+				FixedRange: ns.Some[ns.Float32](8),
+			})},
+		},
+	}
+	var out ns.Consumable
+	roundTripComponent(t, in, &out)
+
+	if out.ConsumeTicks() != 32 {
+		t.Errorf("ConsumeTicks() = %d, want 32", out.ConsumeTicks())
+	}
+	if len(out.Effects) != 5 {
+		t.Fatalf("expected 5 effects, got %d", len(out.Effects))
+	}
+	hidden := out.Effects[0].Effects[0].Details.HiddenEffect
+	if hidden == nil || hidden.Duration != 600 {
+		t.Errorf("hidden effect not preserved: %+v", hidden)
+	}
+	if _, sound, inline := out.Effects[4].Sound.Get(); !inline || sound.Name != "minecraft:entity.generic.burp" {
+		t.Errorf("unexpected inline sound: %+v", sound)
+	}
+}
+
+func TestConsumable_InvalidEffectType(t *testing.T) {
+	w := ns.NewWriter()
+	w.WriteVarInt(99)
+
+	var out ns.ConsumeEffect
+	err := out.Decode(ns.NewReader(w.Bytes()))
+	if err == nil || !strings.Contains(err.Error(), "invalid consume effect type: 99") {
+		t.Fatalf("expected invalid type error, got: %v", err)
+	}
+}
+
+func TestMobEffectDetail_DepthLimit(t *testing.T) {
+	w := ns.NewWriter()
+	for range ns.MaxHiddenEffectDepth + 2 {
+		w.WriteVarInt(0)
+		w.WriteVarInt(1)
+		w.WriteBool(false)
+		w.WriteBool(false)
+		w.WriteBool(false)
+		w.WriteBool(true) // another hidden effect follows
+	}
+
+	var out ns.MobEffectDetail
+	err := out.Decode(ns.NewReader(w.Bytes()))
+	if err == nil || !strings.Contains(err.Error(), "nested deeper") {
+		t.Fatalf("expected depth limit error, got: %v", err)
+	}
+}
+
+func TestUseCooldown_RoundTrip(t *testing.T) {
+	in := &ns.UseCooldown{Seconds: 1.5, CooldownGroup: ns.Some[ns.Identifier]("example:pearls")}
+	var out ns.UseCooldown
+	roundTripComponent(t, in, &out)
+
+	if group, ok := out.CooldownGroup.Get(); !ok || group != "example:pearls" {
+		t.Errorf("unexpected cooldown group: %q, %v", group, ok)
+	}
+}
+
+func TestDeathProtection_RoundTrip(t *testing.T) {
+	in := &ns.DeathProtection{Effects: []ns.ConsumeEffect{
+		{Type: ns.ConsumeClearAllEffects},
+		{Type: ns.ConsumeApplyEffects, Effects: []ns.PotionEffect{{Effect: 10, Details: ns.MobEffectDetail{Amplifier: 1, Duration: 900}}}, Probability: 1},
+	}}
+	var out ns.DeathProtection
+	roundTripComponent(t, in, &out)
+	if len(out.Effects) != 2 || out.Effects[1].Effects[0].Details.Duration != 900 {
+		t.Errorf("unexpected death protection: %+v", out.Effects)
+	}
+}
@@ -0,0 +1,217 @@
+package net_structures
+
+import "fmt"
+
+// JukeboxSong is an inline minecraft:jukebox_song registry entry.
+//
+// Wire format:
+//
+//	┌────────────────────────────────┬─────────────────────────────────┐
+//	│  Sound (ID or SoundEvent)      │  Description (Text Component)   │
+//	├────────────────────────────────┼─────────────────────────────────┤
+//	│  Length In Seconds (Float)     │  Comparator Output (VarInt)     │
+//	└────────────────────────────────┴─────────────────────────────────┘
+type JukeboxSong struct {
+	Sound            IDOrX[SoundEvent]
+	Description      TextComponent
+	LengthInSeconds  Float32
+	ComparatorOutput VarInt
+}
+
+// Decode reads a JukeboxSong from the buffer.
+func (s *JukeboxSong) Decode(buf *PacketBuffer) error {
+	var err error
+	if s.Sound, err = buf.ReadSoundEventHolder(); err != nil {
+		return fmt.Errorf("failed to read song sound: %w", err)
+	}
+	if s.Description, err = buf.ReadTextComponent(); err != nil {
+		return fmt.Errorf("failed to read song description: %w", err)
+	}
+	if s.LengthInSeconds, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read song length: %w", err)
+	}
+	if s.ComparatorOutput, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read song comparator output: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a JukeboxSong to the buffer.
+func (s *JukeboxSong) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteSoundEventHolder(s.Sound); err != nil {
+		return fmt.Errorf("failed to write song sound: %w", err)
+	}
+	if err := buf.WriteTextComponent(s.Description); err != nil {
+		return fmt.Errorf("failed to write song description: %w", err)
+	}
+	if err := buf.WriteFloat32(s.LengthInSeconds); err != nil {
+		return fmt.Errorf("failed to write song length: %w", err)
+	}
+	if err := buf.WriteVarInt(s.ComparatorOutput); err != nil {
+		return fmt.Errorf("failed to write song comparator output: %w", err)
+	}
+	return nil
+}
+
+// JukeboxPlayable is the data of the minecraft:jukebox_playable component.
+//
+// Wire format:
+//
+//	┌───────────────────┬─────────────────────────────────────────────────────┐
+//	│  Direct (Boolean) │  Song (ID or JukeboxSong if direct, else Identifier)│
+//	└───────────────────┴─────────────────────────────────────────────────────┘
+//
+// A song given by name (resource key) does not need to be known to the client's
+// registry when the item is sent.
+type JukeboxPlayable struct {
+	Song XOrY[IDOrX[JukeboxSong], Identifier]
+}
+
+// NewJukeboxPlayableByName references a song by its registry name.
+func NewJukeboxPlayableByName(name Identifier) *JukeboxPlayable {
+	return &JukeboxPlayable{Song: NewY[IDOrX[JukeboxSong]](name)}
+}
+
+// NewJukeboxPlayable references a song by registry ID or defines it inline.
+func NewJukeboxPlayable(song IDOrX[JukeboxSong]) *JukeboxPlayable {
+	return &JukeboxPlayable{Song: NewX[IDOrX[JukeboxSong], Identifier](song)}
+}
+
+// Decode reads a JukeboxPlayable from the buffer.
+func (j *JukeboxPlayable) Decode(buf *PacketBuffer) error {
+	if err := j.Song.DecodeWith(buf, readJukeboxSongHolder, (*PacketBuffer).ReadIdentifier); err != nil {
+		return fmt.Errorf("failed to read jukebox song: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a JukeboxPlayable to the buffer.
+func (j *JukeboxPlayable) Encode(buf *PacketBuffer) error {
+	if err := j.Song.EncodeWith(buf, writeJukeboxSongHolder, (*PacketBuffer).WriteIdentifier); err != nil {
+		return fmt.Errorf("failed to write jukebox song: %w", err)
+	}
+	return nil
+}
+
+func readJukeboxSongHolder(buf *PacketBuffer) (IDOrX[JukeboxSong], error) {
+	var s IDOrX[JukeboxSong]
+	err := s.DecodeWith(buf, func(buf *PacketBuffer) (JukeboxSong, error) {
+		var song JukeboxSong
+		err := song.Decode(buf)
+		return song, err
+	})
+	return s, err
+}
+
+func writeJukeboxSongHolder(buf *PacketBuffer, s IDOrX[JukeboxSong]) error {
+	return s.EncodeWith(buf, func(buf *PacketBuffer, song JukeboxSong) error {
+		return song.Encode(buf)
+	})
+}
+
+// Instrument is an inline minecraft:instrument registry entry (goat horn sounds).
+//
+// Wire format:
+//
+//	┌────────────────────────────────┬─────────────────────────────────┐
+//	│  Sound (ID or SoundEvent)      │  Use Duration (Float)           │
+//	├────────────────────────────────┼─────────────────────────────────┤
+//	│  Range (Float)                 │  Description (Text Component)   │
+//	└────────────────────────────────┴─────────────────────────────────┘
+//
+// Use duration is in seconds, range in blocks.
+type Instrument struct {
+	Sound       IDOrX[SoundEvent]
+	UseDuration Float32
+	Range       Float32
+	Description TextComponent
+}
+
+// Decode reads an Instrument from the buffer.
+func (i *Instrument) Decode(buf *PacketBuffer) error {
+	var err error
+	if i.Sound, err = buf.ReadSoundEventHolder(); err != nil {
+		return fmt.Errorf("failed to read instrument sound: %w", err)
+	}
+	if i.UseDuration, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read instrument use duration: %w", err)
+	}
+	if i.Range, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read instrument range: %w", err)
+	}
+	if i.Description, err = buf.ReadTextComponent(); err != nil {
+		return fmt.Errorf("failed to read instrument description: %w", err)
+	}
+	return nil
+}
+
+// Encode writes an Instrument to the buffer.
+func (i *Instrument) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteSoundEventHolder(i.Sound); err != nil {
+		return fmt.Errorf("failed to write instrument sound: %w", err)
+	}
+	if err := buf.WriteFloat32(i.UseDuration); err != nil {
+		return fmt.Errorf("failed to write instrument use duration: %w", err)
+	}
+	if err := buf.WriteFloat32(i.Range); err != nil {
+		return fmt.Errorf("failed to write instrument range: %w", err)
+	}
+	if err := buf.WriteTextComponent(i.Description); err != nil {
+		return fmt.Errorf("failed to write instrument description: %w", err)
+	}
+	return nil
+}
+
+// InstrumentComponent is the data of the minecraft:instrument component.
+//
+// Wire format:
+//
+//	┌───────────────────┬─────────────────────────────────────────────────────┐
+//	│  Direct (Boolean) │  Instrument (ID or Instrument if direct, else       │
+//	│                   │  Identifier)                                        │
+//	└───────────────────┴─────────────────────────────────────────────────────┘
+type InstrumentComponent struct {
+	Instrument XOrY[IDOrX[Instrument], Identifier]
+}
+
+// NewInstrumentComponentByName references an instrument by its registry name.
+func NewInstrumentComponentByName(name Identifier) *InstrumentComponent {
+	return &InstrumentComponent{Instrument: NewY[IDOrX[Instrument]](name)}
+}
+
+// NewInstrumentComponent references an instrument by registry ID or defines it inline.
+func NewInstrumentComponent(instrument IDOrX[Instrument]) *InstrumentComponent {
+	return &InstrumentComponent{Instrument: NewX[IDOrX[Instrument], Identifier](instrument)}
+}
+
+// Decode reads an InstrumentComponent from the buffer.
+func (c *InstrumentComponent) Decode(buf *PacketBuffer) error {
+	if err := c.Instrument.DecodeWith(buf, readInstrumentHolder, (*PacketBuffer).ReadIdentifier); err != nil {
+		return fmt.Errorf("failed to read instrument: %w", err)
+	}
+	return nil
+}
+
+// Encode writes an InstrumentComponent to the buffer.
+func (c *InstrumentComponent) Encode(buf *PacketBuffer) error {
+	if err := c.Instrument.EncodeWith(buf, writeInstrumentHolder, (*PacketBuffer).WriteIdentifier); err != nil {
+		return fmt.Errorf("failed to write instrument: %w", err)
+	}
+	return nil
+}
+
+func readInstrumentHolder(buf *PacketBuffer) (IDOrX[Instrument], error) {
+	var i IDOrX[Instrument]
+	err := i.DecodeWith(buf, func(buf *PacketBuffer) (Instrument, error) {
+		var instrument Instrument
+		err := instrument.Decode(buf)
+		return instrument, err
+	})
+	return i, err
+}
+
+func writeInstrumentHolder(buf *PacketBuffer, i IDOrX[Instrument]) error {
+	return i.EncodeWith(buf, func(buf *PacketBuffer, instrument Instrument) error {
+		return instrument.Encode(buf)
+	})
+}
@@ -0,0 +1,144 @@
+package net_structures_test
+
+import (
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestJukeboxPlayable_RoundTrip(t *testing.T) {
+	byName := ns.NewJukeboxPlayableByName("minecraft:pigstep")
+	var out ns.JukeboxPlayable
+	roundTripComponent(t, byName, &out)
+	if _, name, direct := out.Song.Get(); direct || name != "minecraft:pigstep" {
+		t.Errorf("unexpected song: %+v", out.Song)
+	}
+
+	inline := ns.NewJukeboxPlayable(ns.NewInlineValue(ns.JukeboxSong{
+		Sound:            ns.NewInlineValue(ns.SoundEvent{Name: "example:music_disc.tune"}),
+		Description:      ns.NewTextComponent("Tune"),
+		LengthInSeconds:  93.5,
+		ComparatorOutput: 7,
+	}))
+	out = ns.JukeboxPlayable{}
+	roundTripComponent(t, inline, &out)
+	holder, _, direct := out.Song.Get()
+	if !direct || !holder.IsInline {
+		t.Fatalf("expected inline song, got %+v", out.Song)
+	}
+	if song := holder.Value; song.LengthInSeconds != 93.5 || song.ComparatorOutput != 7 || song.Description.Text != "Tune" {
+		t.Errorf("unexpected song: %+v", song)
+	}
+
+	ref := ns.NewJukeboxPlayable(ns.NewIDRef[ns.JukeboxSong](3))
+	out = ns.JukeboxPlayable{}
+	roundTripComponent(t, ref, &out)
+	if id, _, _ := out.Song.X.Get(); id != 3 {
+		t.Errorf("expected song id 3, got %d", id)
+	}
+}
+
+func TestInstrumentComponent_RoundTrip(t *testing.T) {
+	in := ns.NewInstrumentComponent(ns.NewInlineValue(ns.Instrument{
+		Sound:       ns.NewIDRef[ns.SoundEvent](812),
+		UseDuration: 7,
+		Range:       256,
+		Description: ns.NewTextComponent("Ponder"),
+	}))
+	var out ns.InstrumentComponent
+	roundTripComponent(t, in, &out)
+	holder, _, _ := out.Instrument.Get()
+	if i := holder.Value; i.Sound.ID != 812 || i.Range != 256 || i.Description.Text != "Ponder" {
+		t.Errorf("unexpected instrument: %+v", i)
+	}
+
+	out = ns.InstrumentComponent{}
+	roundTripComponent(t, ns.NewInstrumentComponentByName("minecraft:seek_goat_horn"), &out)
+	if out.Instrument.IsX || out.Instrument.Y != "minecraft:seek_goat_horn" {
+		t.Errorf("unexpected instrument: %+v", out.Instrument)
+	}
+}
+
+func TestJukeboxPlayable_Wire(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *ns.JukeboxPlayable
+		raw  []byte
+	}{
+		{
+			name: "by name",
+			in:   ns.NewJukeboxPlayableByName("minecraft:pigstep"),
+			// direct=false, identifier
+			raw: wire([]byte{0x00, 0x11}, "minecraft:pigstep"),
+		},
+		{
+			name: "by id",
+			in:   ns.NewJukeboxPlayable(ns.NewIDRef[ns.JukeboxSong](3)),
+			// direct=true, id+1
+			raw: []byte{0x01, 0x04},
+		},
+		{
+			name: "inline",
+			in: ns.NewJukeboxPlayable(ns.NewInlineValue(ns.JukeboxSong{
+				Sound:            ns.NewInlineValue(ns.SoundEvent{Name: "example:music_disc.tune", FixedRange: ns.Some[ns.Float32](16)}),
+				Description:      ns.NewTextComponent("Tune"),
+				LengthInSeconds:  93.5,
+				ComparatorOutput: 7,
+			})),
+			raw: wire(
+				[]byte{0x01, 0x00}, // direct=true, inline song
+				// inline sound: identifier, fixed range=16.0
+				[]byte{0x00, 0x17}, "example:music_disc.tune", []byte{0x01, 0x41, 0x80, 0x00, 0x00},
+				[]byte{0x08, 0x00, 0x04}, "Tune",
+				// length=93.5, comparator output=7
+				[]byte{0x42, 0xbb, 0x00, 0x00, 0x07},
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkComponentWire(t, tt.in, tt.raw, &ns.JukeboxPlayable{})
+		})
+	}
+}
+
+func TestInstrumentComponent_Wire(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *ns.InstrumentComponent
+		raw  []byte
+	}{
+		{
+			name: "by name",
+			in:   ns.NewInstrumentComponentByName("minecraft:seek_goat_horn"),
+			// direct=false, identifier
+			raw: wire([]byte{0x00, 0x18}, "minecraft:seek_goat_horn"),
+		},
+		{
+			name: "by id",
+			in:   ns.NewInstrumentComponent(ns.NewIDRef[ns.Instrument](0)),
+			// direct=true, id+1
+			raw: []byte{0x01, 0x01},
+		},
+		{
+			name: "inline",
+			in: ns.NewInstrumentComponent(ns.NewInlineValue(ns.Instrument{
+				Sound:       ns.NewIDRef[ns.SoundEvent](812),
+				UseDuration: 7,
+				Range:       256,
+				Description: ns.NewTextComponent("Ponder"),
+			})),
+			raw: wire(
+				[]byte{0x01, 0x00}, // direct=true, inline instrument
+				// sound id 812+1, use duration=7.0, range=256.0
+				[]byte{0xad, 0x06, 0x40, 0xe0, 0x00, 0x00, 0x43, 0x80, 0x00, 0x00},
+				[]byte{0x08, 0x00, 0x06}, "Ponder",
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkComponentWire(t, tt.in, tt.raw, &ns.InstrumentComponent{})
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package net_structures
+
+import "fmt"
+
+// LodestoneTracker is the data of the minecraft:lodestone_tracker component
+// (lodestone compass).
+//
+// Wire format:
+//
+//	┌──────────────────────────────────────────┬────────────────────┐
+//	│  Target (Prefixed Optional GlobalPos)    │  Tracked (Boolean) │
+//	└──────────────────────────────────────────┴────────────────────┘
+//
+// Without a target the compass spins randomly. If Tracked is set, the server
+// removes the target once the lodestone is gone.
+type LodestoneTracker struct {
+	Target  PrefixedOptional[GlobalPos]
+	Tracked Boolean
+}
+
+// Decode reads a LodestoneTracker from the buffer.
+func (l *LodestoneTracker) Decode(buf *PacketBuffer) error {
+	var err error
+	if l.Target, err = buf.ReadOptionalGlobalPos(); err != nil {
+		return fmt.Errorf("failed to read lodestone target: %w", err)
+	}
+	if l.Tracked, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read lodestone tracked: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a LodestoneTracker to the buffer.
+func (l *LodestoneTracker) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteOptionalGlobalPos(l.Target); err != nil {
+		return fmt.Errorf("failed to write lodestone target: %w", err)
+	}
+	if err := buf.WriteBool(l.Tracked); err != nil {
+		return fmt.Errorf("failed to write lodestone tracked: %w", err)
+	}
+	return nil
+}
+
+// MapID is the data of the minecraft:map_id component: the ID of the map whose
+// contents are sent with the Map Data packet.
+//
+// Wire format:
+//
+//	┌─────────────────┐
+//	│  ID (VarInt)    │
+//	└─────────────────┘
+type MapID struct {
+	ID VarInt
+}
+
+// Decode reads a MapID from the buffer.
+func (m *MapID) Decode(buf *PacketBuffer) error {
+	var err error
+	if m.ID, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read map id: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a MapID to the buffer.
+func (m *MapID) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(m.ID); err != nil {
+		return fmt.Errorf("failed to write map id: %w", err)
+	}
+	return nil
+}
+
+// DefaultMapColor is the map item's color when it has no minecraft:map_color component.
+const DefaultMapColor Int32 = 0x46402E
+
+// MapColor is the data of the minecraft:map_color component: the RGB color
+// (0xRRGGBB) of the map item's markings.
+//
+// Wire format:
+//
+//	┌─────────────────┐
+//	│  Color (Int)    │
+//	└─────────────────┘
+type MapColor struct {
+	Color Int32
+}
+
+// Decode reads a MapColor from the buffer.
+func (m *MapColor) Decode(buf *PacketBuffer) error {
+	var err error
+	if m.Color, err = buf.ReadInt32(); err != nil {
+		return fmt.Errorf("failed to read map color: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a MapColor to the buffer.
+func (m *MapColor) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteInt32(m.Color); err != nil {
+		return fmt.Errorf("failed to write map color: %w", err)
+	}
+	return nil
+}
+
+// MapDecorations is the data of the minecraft:map_decorations component: icons
+// (e.g. explorer map targets) drawn on the map regardless of its contents.
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────────┐
+//	│  Decorations (NBT Compound)             │
+//	└─────────────────────────────────────────┘
+//
+// The compound maps a unique key to {type, x, z, rotation}. The component has no
+// dedicated network format, so it is kept as raw NBT; use Data.Tag() to inspect it.
+type MapDecorations struct {
+	Data RawNBT
+}
+
+// Decode reads MapDecorations from the buffer.
+func (m *MapDecorations) Decode(buf *PacketBuffer) error {
+	var err error
+	if m.Data, err = buf.ReadRawNBT(); err != nil {
+		return fmt.Errorf("failed to read map decorations: %w", err)
+	}
+	return nil
+}
+
+// Encode writes MapDecorations to the buffer.
+func (m *MapDecorations) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteRawNBT(m.Data); err != nil {
+		return fmt.Errorf("failed to write map decorations: %w", err)
+	}
+	return nil
+}
+
+// MapPostProcessing is the pending change to a map applied when it is taken out
+// of a cartography table.
+type MapPostProcessing VarInt
+
+const (
+	MapPostProcessingLock  MapPostProcessing = 0
+	MapPostProcessingScale MapPostProcessing = 1
+)
+
+var mapPostProcessingNames = EnumNames{
+	int32(MapPostProcessingLock):  "lock",
+	int32(MapPostProcessingScale): "scale",
+}
+
+func (m MapPostProcessing) String() string {
+	return mapPostProcessingNames.Name(int32(m))
+}
+
+// MapPostProcessingComponent is the data of the minecraft:map_post_processing component.
+//
+// Wire format:
+//
+//	┌──────────────────────────┐
+//	│  Type (VarInt Enum)      │
+//	└──────────────────────────┘
+type MapPostProcessingComponent struct {
+	Type MapPostProcessing
+}
+
+// Decode reads a MapPostProcessingComponent from the buffer.
+func (m *MapPostProcessingComponent) Decode(buf *PacketBuffer) error {
+	v, err := buf.ReadVarInt()
+	if err != nil {
+		return fmt.Errorf("failed to read map post processing: %w", err)
+	}
+	if _, ok := mapPostProcessingNames[int32(v)]; !ok {
+		return InvalidEnumError("map post processing", int32(v))
+	}
+	m.Type = MapPostProcessing(v)
+	return nil
+}
+
+// Encode writes a MapPostProcessingComponent to the buffer.
+func (m *MapPostProcessingComponent) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(m.Type)); err != nil {
+		return fmt.Errorf("failed to write map post processing: %w", err)
+	}
+	return nil
+}
+
+func init() {
+	RegisterEnumNames("map post processing", mapPostProcessingNames)
+}
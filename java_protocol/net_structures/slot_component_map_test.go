@@ -0,0 +1,79 @@
+package net_structures_test
+
+import (
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+)
+
+func TestLodestoneTracker_RoundTrip(t *testing.T) {
+	in := &ns.LodestoneTracker{
+		Target: ns.Some(ns.GlobalPos{
+			Dimension: "minecraft:the_nether",
+			Pos:       ns.Position{X: 100, Y: -20, Z: -3000},
+		}),
+		Tracked: true,
+	}
+	var out ns.LodestoneTracker
+	roundTripComponent(t, in, &out)
+	if target, ok := out.Target.Get(); !ok || target != in.Target.Value || !bool(out.Tracked) {
+		t.Errorf("unexpected tracker: %+v", out)
+	}
+
+	out = ns.LodestoneTracker{}
+	roundTripComponent(t, &ns.LodestoneTracker{}, &out)
+	if out.Target.Present {
+		t.Errorf("expected no target, got %+v", out.Target)
+	}
+}
+
+func TestMapComponents_RoundTrip(t *testing.T) {
+	var id ns.MapID
+	roundTripComponent(t, &ns.MapID{ID: 42}, &id)
+	if id.ID != 42 {
+		t.Errorf("expected map id 42, got %d", id.ID)
+	}
+
+	var color ns.MapColor
+	roundTripComponent(t, &ns.MapColor{Color: ns.DefaultMapColor}, &color)
+	if color.Color != 0x46402E {
+		t.Errorf("unexpected map color: %06x", color.Color)
+	}
+
+	data, err := nbt.EncodeNetwork(nbt.Compound{
+		"+": nbt.Compound{
+			"type":     nbt.String("minecraft:red_x"),
+			"x":        nbt.Double(128),
+			"z":        nbt.Double(-64),
+			"rotation": nbt.Float(180),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decorations ns.MapDecorations
+	roundTripComponent(t, &ns.MapDecorations{Data: data}, &decorations)
+	tag, err := decorations.Data.Tag()
+	if err != nil {
+		t.Fatalf("tag error: %v", err)
+	}
+	marker, ok := tag.(nbt.Compound)["+"].(nbt.Compound)
+	if !ok || marker.GetString("type") != "minecraft:red_x" {
+		t.Errorf("unexpected decorations: %v", tag)
+	}
+}
+
+func TestMapPostProcessing(t *testing.T) {
+	var out ns.MapPostProcessingComponent
+	roundTripComponent(t, &ns.MapPostProcessingComponent{Type: ns.MapPostProcessingScale}, &out)
+	if out.Type != ns.MapPostProcessingScale || out.Type.String() != "scale" {
+		t.Errorf("unexpected post processing: %v", out.Type)
+	}
+
+	w := ns.NewWriter()
+	w.WriteVarInt(2)
+	if err := out.Decode(ns.NewReader(w.Bytes())); err == nil {
+		t.Error("expected error for invalid post processing")
+	}
+}
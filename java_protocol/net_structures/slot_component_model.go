@@ -0,0 +1,124 @@
+package net_structures
+
+import "fmt"
+
+// CustomModelData is the data of the minecraft:custom_model_data component: values
+// that item model definitions can select on (range_dispatch, condition, select
+// and tints).
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────────────────────────────┐
+//	│  Floats (Prefixed Array of Float)                           │
+//	├─────────────────────────────────────────────────────────────┤
+//	│  Flags (Prefixed Array of Boolean)                          │
+//	├─────────────────────────────────────────────────────────────┤
+//	│  Strings (Prefixed Array of String)                         │
+//	├─────────────────────────────────────────────────────────────┤
+//	│  Colors (Prefixed Array of Int)                             │
+//	└─────────────────────────────────────────────────────────────┘
+//
+// Colors are RGB values (0xRRGGBB). Model definitions reference entries by index.
+type CustomModelData struct {
+	Floats  PrefixedArray[Float32]
+	Flags   PrefixedArray[Boolean]
+	Strings PrefixedArray[String]
+	Colors  PrefixedArray[Int32]
+}
+
+// FloatAt returns the float at index i, if present.
+func (c *CustomModelData) FloatAt(i int) (Float32, bool) {
+	if i < 0 || i >= len(c.Floats) {
+		return 0, false
+	}
+	return c.Floats[i], true
+}
+
+// FlagAt returns the flag at index i, if present.
+func (c *CustomModelData) FlagAt(i int) (Boolean, bool) {
+	if i < 0 || i >= len(c.Flags) {
+		return false, false
+	}
+	return c.Flags[i], true
+}
+
+// StringAt returns the string at index i, if present.
+func (c *CustomModelData) StringAt(i int) (String, bool) {
+	if i < 0 || i >= len(c.Strings) {
+		return "", false
+	}
+	return c.Strings[i], true
+}
+
+// ColorAt returns the color at index i, if present.
+func (c *CustomModelData) ColorAt(i int) (Int32, bool) {
+	if i < 0 || i >= len(c.Colors) {
+		return 0, false
+	}
+	return c.Colors[i], true
+}
+
+// Decode reads CustomModelData from the buffer.
+func (c *CustomModelData) Decode(buf *PacketBuffer) error {
+	if err := c.Floats.DecodeWith(buf, (*PacketBuffer).ReadFloat32); err != nil {
+		return fmt.Errorf("failed to read custom model data floats: %w", err)
+	}
+	if err := c.Flags.DecodeWith(buf, (*PacketBuffer).ReadBool); err != nil {
+		return fmt.Errorf("failed to read custom model data flags: %w", err)
+	}
+	if err := c.Strings.DecodeWith(buf, func(buf *PacketBuffer) (String, error) {
+		return buf.ReadString(32767)
+	}); err != nil {
+		return fmt.Errorf("failed to read custom model data strings: %w", err)
+	}
+	if err := c.Colors.DecodeWith(buf, (*PacketBuffer).ReadInt32); err != nil {
+		return fmt.Errorf("failed to read custom model data colors: %w", err)
+	}
+	return nil
+}
+
+// Encode writes CustomModelData to the buffer.
+func (c *CustomModelData) Encode(buf *PacketBuffer) error {
+	if err := c.Floats.EncodeWith(buf, (*PacketBuffer).WriteFloat32); err != nil {
+		return fmt.Errorf("failed to write custom model data floats: %w", err)
+	}
+	if err := c.Flags.EncodeWith(buf, (*PacketBuffer).WriteBool); err != nil {
+		return fmt.Errorf("failed to write custom model data flags: %w", err)
+	}
+	if err := c.Strings.EncodeWith(buf, (*PacketBuffer).WriteString); err != nil {
+		return fmt.Errorf("failed to write custom model data strings: %w", err)
+	}
+	if err := c.Colors.EncodeWith(buf, (*PacketBuffer).WriteInt32); err != nil {
+		return fmt.Errorf("failed to write custom model data colors: %w", err)
+	}
+	return nil
+}
+
+// ItemModel is the data of the minecraft:item_model component: the item model
+// definition (assets/<namespace>/items/<path>.json) used to render the item.
+//
+// Wire format:
+//
+//	┌─────────────────────────┐
+//	│  Model (Identifier)     │
+//	└─────────────────────────┘
+type ItemModel struct {
+	Model Identifier
+}
+
+// Decode reads an ItemModel from the buffer.
+func (m *ItemModel) Decode(buf *PacketBuffer) error {
+	var err error
+	if m.Model, err = buf.ReadIdentifier(); err != nil {
+		return fmt.Errorf("failed to read item model: %w", err)
+	}
+	return nil
+}
+
+// Encode writes an ItemModel to the buffer.
+func (m *ItemModel) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteIdentifier(m.Model); err != nil {
+		return fmt.Errorf("failed to write item model: %w", err)
+	}
+	return nil
+}
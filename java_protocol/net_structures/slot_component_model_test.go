@@ -0,0 +1,51 @@
+package net_structures_test
+
+import (
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestCustomModelData_RoundTrip(t *testing.T) {
+	in := &ns.CustomModelData{
+		Floats:  ns.PrefixedArray[ns.Float32]{1.5, -2},
+		Flags:   ns.PrefixedArray[ns.Boolean]{true},
+		Strings: ns.PrefixedArray[ns.String]{"rusty", "shiny"},
+		Colors:  ns.PrefixedArray[ns.Int32]{0xFF0000},
+	}
+	var out ns.CustomModelData
+	roundTripComponent(t, in, &out)
+
+	if f, ok := out.FloatAt(1); !ok || f != -2 {
+		t.Errorf("FloatAt(1) = %v, %v", f, ok)
+	}
+	if flag, ok := out.FlagAt(0); !ok || !bool(flag) {
+		t.Errorf("FlagAt(0) = %v, %v", flag, ok)
+	}
+	if s, ok := out.StringAt(1); !ok || s != "shiny" {
+		t.Errorf("StringAt(1) = %q, %v", s, ok)
+	}
+	if c, ok := out.ColorAt(0); !ok || c != 0xFF0000 {
+		t.Errorf("ColorAt(0) = %06x, %v", c, ok)
+	}
+	if _, ok := out.StringAt(2); ok {
+		t.Error("StringAt(2) should be out of range")
+	}
+
+	// all lists empty is a single zero byte each
+	buf := ns.NewWriter()
+	if err := (&ns.CustomModelData{}).Encode(buf); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.Bytes(); len(got) != 4 {
+		t.Errorf("expected 4 bytes for empty data, got %x", got)
+	}
+}
+
+func TestItemModel_RoundTrip(t *testing.T) {
+	var out ns.ItemModel
+	roundTripComponent(t, &ns.ItemModel{Model: "example:ruby_sword"}, &out)
+	if out.Model != "example:ruby_sword" {
+		t.Errorf("unexpected model: %s", out.Model)
+	}
+}
@@ -0,0 +1,304 @@
+package net_structures
+
+import "fmt"
+
+// MaxHiddenEffectDepth limits the nesting of MobEffectDetail.HiddenEffect.
+// Vanilla never nests more than a few levels; the limit guards against
+// malicious input exhausting the stack.
+const MaxHiddenEffectDepth = 16
+
+// MobEffectDetail is the state of a status effect instance.
+//
+// Wire format:
+//
+//	┌────────────────────┬───────────────────┬──────────────────┬─────────────────────────┐
+//	│  Amplifier (VarInt)│  Duration (VarInt)│  Ambient (Bool)  │  Show Particles (Bool)  │
+//	├────────────────────┼───────────────────┴──────────────────┴─────────────────────────┤
+//	│  Show Icon (Bool)  │  Hidden Effect (Prefixed Optional MobEffectDetail)             │
+//	└────────────────────┴────────────────────────────────────────────────────────────────┘
+//
+// Duration is in ticks, -1 means infinite. The hidden effect is a weaker (or
+// shorter) instance of the same effect that resumes when this one expires.
+type MobEffectDetail struct {
+	Amplifier     VarInt
+	Duration      VarInt
+	Ambient       Boolean
+	ShowParticles Boolean
+	ShowIcon      Boolean
+	HiddenEffect  *MobEffectDetail
+}
+
+// Decode reads a MobEffectDetail from the buffer.
+func (d *MobEffectDetail) Decode(buf *PacketBuffer) error {
+	return d.decode(buf, 0)
+}
+
+func (d *MobEffectDetail) decode(buf *PacketBuffer, depth int) error {
+	if depth > MaxHiddenEffectDepth {
+		return fmt.Errorf("hidden effects nested deeper than %d", MaxHiddenEffectDepth)
+	}
+	var err error
+	if d.Amplifier, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read effect amplifier: %w", err)
+	}
+	if d.Duration, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read effect duration: %w", err)
+	}
+	if d.Ambient, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read effect ambient: %w", err)
+	}
+	if d.ShowParticles, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read effect show particles: %w", err)
+	}
+	if d.ShowIcon, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read effect show icon: %w", err)
+	}
+	hasHidden, err := buf.ReadBool()
+	if err != nil {
+		return fmt.Errorf("failed to read hidden effect presence: %w", err)
+	}
+	d.HiddenEffect = nil
+	if hasHidden {
+		d.HiddenEffect = &MobEffectDetail{}
+		if err := d.HiddenEffect.decode(buf, depth+1); err != nil {
+			return fmt.Errorf("failed to read hidden effect: %w", err)
+		}
+	}
+	return nil
+}
+
+// Encode writes a MobEffectDetail to the buffer.
+func (d *MobEffectDetail) Encode(buf *PacketBuffer) error {
+	return d.encode(buf, 0)
+}
+
+func (d *MobEffectDetail) encode(buf *PacketBuffer, depth int) error {
+	if depth > MaxHiddenEffectDepth {
+		return fmt.Errorf("hidden effects nested deeper than %d", MaxHiddenEffectDepth)
+	}
+	if err := buf.WriteVarInt(d.Amplifier); err != nil {
+		return fmt.Errorf("failed to write effect amplifier: %w", err)
+	}
+	if err := buf.WriteVarInt(d.Duration); err != nil {
+		return fmt.Errorf("failed to write effect duration: %w", err)
+	}
+	if err := buf.WriteBool(d.Ambient); err != nil {
+		return fmt.Errorf("failed to write effect ambient: %w", err)
+	}
+	if err := buf.WriteBool(d.ShowParticles); err != nil {
+		return fmt.Errorf("failed to write effect show particles: %w", err)
+	}
+	if err := buf.WriteBool(d.ShowIcon); err != nil {
+		return fmt.Errorf("failed to write effect show icon: %w", err)
+	}
+	if err := buf.WriteBool(d.HiddenEffect != nil); err != nil {
+		return fmt.Errorf("failed to write hidden effect presence: %w", err)
+	}
+	if d.HiddenEffect != nil {
+		if err := d.HiddenEffect.encode(buf, depth+1); err != nil {
+			return fmt.Errorf("failed to write hidden effect: %w", err)
+		}
+	}
+	return nil
+}
+
+// PotionEffect is a status effect with its details.
+//
+// Wire format:
+//
+//	┌───────────────────┬────────────────────────────┐
+//	│  Effect (VarInt)  │  Details (MobEffectDetail) │
+//	└───────────────────┴────────────────────────────┘
+type PotionEffect struct {
+	// Effect is the registry ID from minecraft:mob_effect.
+	Effect  VarInt
+	Details MobEffectDetail
+}
+
+// Decode reads a PotionEffect from the buffer.
+func (e *PotionEffect) Decode(buf *PacketBuffer) error {
+	var err error
+	if e.Effect, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read effect type: %w", err)
+	}
+	return e.Details.Decode(buf)
+}
+
+// Encode writes a PotionEffect to the buffer.
+func (e *PotionEffect) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(e.Effect); err != nil {
+		return fmt.Errorf("failed to write effect type: %w", err)
+	}
+	return e.Details.Encode(buf)
+}
+
+// readPotionEffects reads a VarInt-prefixed list of PotionEffects.
+func readPotionEffects(buf *PacketBuffer) ([]PotionEffect, error) {
+	count, err := buf.ReadCount()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read effect count: %w", err)
+	}
+	effects := make([]PotionEffect, count)
+	for i := range effects {
+		if err := effects[i].Decode(buf); err != nil {
+			return nil, fmt.Errorf("failed to read effect %d: %w", i, err)
+		}
+	}
+	return effects, nil
+}
+
+// writePotionEffects writes a VarInt-prefixed list of PotionEffects.
+func writePotionEffects(buf *PacketBuffer, effects []PotionEffect) error {
+	if err := buf.WriteVarInt(VarInt(len(effects))); err != nil {
+		return fmt.Errorf("failed to write effect count: %w", err)
+	}
+	for i := range effects {
+		if err := effects[i].Encode(buf); err != nil {
+			return fmt.Errorf("failed to write effect %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// PotionContents is the data of the minecraft:potion_contents component
+// (potions, splash/lingering potions, tipped arrows).
+//
+// Wire format:
+//
+//	┌───────────────────────────────────────────┬───────────────────────────────────────────┐
+//	│  Potion (Prefixed Optional VarInt)        │  Custom Color (Prefixed Optional Int)     │
+//	├───────────────────────────────────────────┼───────────────────────────────────────────┤
+//	│  Custom Effects (Prefixed Array of        │  Custom Name (Prefixed Optional String)   │
+//	│  PotionEffect)                            │                                           │
+//	└───────────────────────────────────────────┴───────────────────────────────────────────┘
+//
+// Potion is a registry ID from minecraft:potion; its effects apply in addition to
+// the custom effects. Custom Color is RGB (0xRRGGBB) and overrides the color mixed
+// from the effects. Custom Name replaces the potion part of the item's translation
+// key (item.minecraft.potion.effect.<name>).
+type PotionContents struct {
+	Potion        PrefixedOptional[VarInt]
+	CustomColor   PrefixedOptional[Int32]
+	CustomEffects []PotionEffect
+	CustomName    PrefixedOptional[String]
+}
+
+// Decode reads PotionContents from the buffer.
+func (p *PotionContents) Decode(buf *PacketBuffer) error {
+	if err := p.Potion.DecodeWith(buf, (*PacketBuffer).ReadVarInt); err != nil {
+		return fmt.Errorf("failed to read potion: %w", err)
+	}
+	if err := p.CustomColor.DecodeWith(buf, (*PacketBuffer).ReadInt32); err != nil {
+		return fmt.Errorf("failed to read potion custom color: %w", err)
+	}
+	var err error
+	if p.CustomEffects, err = readPotionEffects(buf); err != nil {
+		return fmt.Errorf("failed to read potion custom effects: %w", err)
+	}
+	if err := p.CustomName.DecodeWith(buf, func(buf *PacketBuffer) (String, error) {
+		return buf.ReadString(32767)
+	}); err != nil {
+		return fmt.Errorf("failed to read potion custom name: %w", err)
+	}
+	return nil
+}
+
+// Encode writes PotionContents to the buffer.
+func (p *PotionContents) Encode(buf *PacketBuffer) error {
+	if err := p.Potion.EncodeWith(buf, (*PacketBuffer).WriteVarInt); err != nil {
+		return fmt.Errorf("failed to write potion: %w", err)
+	}
+	if err := p.CustomColor.EncodeWith(buf, (*PacketBuffer).WriteInt32); err != nil {
+		return fmt.Errorf("failed to write potion custom color: %w", err)
+	}
+	if err := writePotionEffects(buf, p.CustomEffects); err != nil {
+		return fmt.Errorf("failed to write potion custom effects: %w", err)
+	}
+	if err := p.CustomName.EncodeWith(buf, (*PacketBuffer).WriteString); err != nil {
+		return fmt.Errorf("failed to write potion custom name: %w", err)
+	}
+	return nil
+}
+
+// PotionDurationScale is the data of the minecraft:potion_duration_scale
+// component: the factor applied to the durations of the item's potion effects
+// (e.g. 0.25 for lingering potions).
+//
+// Wire format:
+//
+//	┌─────────────────┐
+//	│  Scale (Float)  │
+//	└─────────────────┘
+type PotionDurationScale struct {
+	Scale Float32
+}
+
+// Decode reads a PotionDurationScale from the buffer.
+func (p *PotionDurationScale) Decode(buf *PacketBuffer) error {
+	var err error
+	if p.Scale, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read potion duration scale: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a PotionDurationScale to the buffer.
+func (p *PotionDurationScale) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteFloat32(p.Scale); err != nil {
+		return fmt.Errorf("failed to write potion duration scale: %w", err)
+	}
+	return nil
+}
+
+// SuspiciousStewEffect is an effect applied when eating suspicious stew.
+type SuspiciousStewEffect struct {
+	// Effect is the registry ID from minecraft:mob_effect.
+	Effect VarInt
+	// Duration is in ticks.
+	Duration VarInt
+}
+
+// SuspiciousStewEffects is the data of the minecraft:suspicious_stew_effects component.
+//
+// Wire format:
+//
+//	┌───────────────────────────────────────────────────────────────┐
+//	│  Effects (Prefixed Array of (Effect VarInt + Duration VarInt))│
+//	└───────────────────────────────────────────────────────────────┘
+type SuspiciousStewEffects struct {
+	Effects []SuspiciousStewEffect
+}
+
+// Decode reads SuspiciousStewEffects from the buffer.
+func (s *SuspiciousStewEffects) Decode(buf *PacketBuffer) error {
+	count, err := buf.ReadCount()
+	if err != nil {
+		return fmt.Errorf("failed to read stew effect count: %w", err)
+	}
+	s.Effects = make([]SuspiciousStewEffect, count)
+	for i := range s.Effects {
+		if s.Effects[i].Effect, err = buf.ReadVarInt(); err != nil {
+			return fmt.Errorf("failed to read stew effect %d type: %w", i, err)
+		}
+		if s.Effects[i].Duration, err = buf.ReadVarInt(); err != nil {
+			return fmt.Errorf("failed to read stew effect %d duration: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Encode writes SuspiciousStewEffects to the buffer.
+func (s *SuspiciousStewEffects) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(len(s.Effects))); err != nil {
+		return fmt.Errorf("failed to write stew effect count: %w", err)
+	}
+	for i, e := range s.Effects {
+		if err := buf.WriteVarInt(e.Effect); err != nil {
+			return fmt.Errorf("failed to write stew effect %d type: %w", i, err)
+		}
+		if err := buf.WriteVarInt(e.Duration); err != nil {
+			return fmt.Errorf("failed to write stew effect %d duration: %w", i, err)
+		}
+	}
+	return nil
+}
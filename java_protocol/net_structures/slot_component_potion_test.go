@@ -0,0 +1,113 @@
+package net_structures_test
+
+import (
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestPotionContents_RoundTrip(t *testing.T) {
+	in := &ns.PotionContents{
+		Potion:      ns.Some[ns.VarInt](17),
+		CustomColor: ns.Some[ns.Int32](0x385DC6),
+		CustomEffects: []ns.PotionEffect{{
+			Effect: 2,
+			Details: ns.MobEffectDetail{
+				Amplifier:    2,
+				Duration:     200,
+				ShowIcon:     true,
+				HiddenEffect: &ns.MobEffectDetail{Duration: 1200, HiddenEffect: &ns.MobEffectDetail{Duration: -1}},
+			},
+		}},
+		CustomName: ns.Some[ns.String]("mystery"),
+	}
+	var out ns.PotionContents
+	roundTripComponent(t, in, &out)
+
+	if potion, ok := out.Potion.Get(); !ok || potion != 17 {
+		t.Errorf("unexpected potion: %+v", out.Potion)
+	}
+	if len(out.CustomEffects) != 1 {
+		t.Fatalf("expected 1 custom effect, got %d", len(out.CustomEffects))
+	}
+	hidden := out.CustomEffects[0].Details.HiddenEffect
+	if hidden == nil || hidden.Duration != 1200 || hidden.HiddenEffect == nil || hidden.HiddenEffect.Duration != -1 {
+		t.Errorf("unexpected hidden effects: %+v", hidden)
+	}
+
+	// a plain water bottle has only the potion set
+	out = ns.PotionContents{}
+	roundTripComponent(t, &ns.PotionContents{Potion: ns.Some[ns.VarInt](0)}, &out)
+	if out.CustomColor.Present || out.CustomName.Present || len(out.CustomEffects) != 0 {
+		t.Errorf("unexpected contents: %+v", out)
+	}
+}
+
+func TestPotionContents_Wire(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *ns.PotionContents
+		raw  []byte
+	}{
+		{
+			name: "water bottle",
+			in:   &ns.PotionContents{Potion: ns.Some[ns.VarInt](0), CustomEffects: []ns.PotionEffect{}},
+			// potion=0, no color, no effects, no name
+			raw: []byte{0x01, 0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name: "custom",
+			in: &ns.PotionContents{
+				Potion:      ns.Some[ns.VarInt](17),
+				CustomColor: ns.Some[ns.Int32](0x385DC6),
+				CustomEffects: []ns.PotionEffect{{
+					Effect: 2,
+					Details: ns.MobEffectDetail{
+						Amplifier:    2,
+						Duration:     200,
+						Ambient:      true,
+						ShowIcon:     true,
+						HiddenEffect: &ns.MobEffectDetail{Duration: 1200, HiddenEffect: &ns.MobEffectDetail{Duration: -1}},
+					},
+				}},
+				CustomName: ns.Some[ns.String]("mystery"),
+			},
+			raw: wire(
+				[]byte{0x01, 0x11},                   // potion=17
+				[]byte{0x01, 0x00, 0x38, 0x5d, 0xc6}, // color
+				// 1 effect: id=2, amplifier=2, duration=200, ambient, no particles, icon
+				[]byte{0x01, 0x02, 0x02, 0xc8, 0x01, 0x01, 0x00, 0x01},
+				// hidden effect: amplifier=0, duration=1200, no flags
+				[]byte{0x01, 0x00, 0xb0, 0x09, 0x00, 0x00, 0x00},
+				// its hidden effect: amplifier=0, duration=-1, no flags, none hidden
+				[]byte{0x01, 0x00, 0xff, 0xff, 0xff, 0xff, 0x0f, 0x00, 0x00, 0x00, 0x00},
+				[]byte{0x01, 0x07}, "mystery",
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkComponentWire(t, tt.in, tt.raw, &ns.PotionContents{})
+		})
+	}
+}
+
+func TestSuspiciousStewEffects_RoundTrip(t *testing.T) {
+	in := &ns.SuspiciousStewEffects{Effects: []ns.SuspiciousStewEffect{
+		{Effect: 15, Duration: 160},
+		{Effect: 7, Duration: 100},
+	}}
+	var out ns.SuspiciousStewEffects
+	roundTripComponent(t, in, &out)
+	if len(out.Effects) != 2 || out.Effects[1] != in.Effects[1] {
+		t.Errorf("unexpected effects: %+v", out.Effects)
+	}
+}
+
+func TestPotionDurationScale_RoundTrip(t *testing.T) {
+	var out ns.PotionDurationScale
+	roundTripComponent(t, &ns.PotionDurationScale{Scale: 0.25}, &out)
+	if out.Scale != 0.25 {
+		t.Errorf("unexpected scale: %v", out.Scale)
+	}
+}
@@ -0,0 +1,290 @@
+package net_structures
+
+import "fmt"
+
+// PropertyMatcher matches a block state property either against an exact value
+// or against an inclusive range of values.
+//
+// Wire format:
+//
+//	┌─────────────────┬──────────────────────┬─────────────────────────────┐
+//	│  Name (String)  │  Is Exact (Boolean)  │  Matcher (depends on type)  │
+//	└─────────────────┴──────────────────────┴─────────────────────────────┘
+//
+// An exact matcher is followed by the value (String), a range matcher by the
+// min and max values (each a Prefixed Optional String).
+//
+// Range bounds are compared in the property's value order (e.g. 0..15 for power),
+// a missing bound is unbounded.
+type PropertyMatcher struct {
+	Name  String
+	Exact Boolean
+	Value String // only if Exact
+
+	Min PrefixedOptional[String] // only if not Exact
+	Max PrefixedOptional[String] // only if not Exact
+}
+
+// NewExactPropertyMatcher matches a property with exactly the given value.
+func NewExactPropertyMatcher(name, value String) PropertyMatcher {
+	return PropertyMatcher{Name: name, Exact: true, Value: value}
+}
+
+// NewRangePropertyMatcher matches a property within [min, max].
+func NewRangePropertyMatcher(name String, min, max PrefixedOptional[String]) PropertyMatcher {
+	return PropertyMatcher{Name: name, Min: min, Max: max}
+}
+
+// Decode reads a PropertyMatcher from the buffer.
+func (m *PropertyMatcher) Decode(buf *PacketBuffer) error {
+	var err error
+	if m.Name, err = buf.ReadString(32767); err != nil {
+		return fmt.Errorf("failed to read property name: %w", err)
+	}
+	if m.Exact, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read property match type: %w", err)
+	}
+	if m.Exact {
+		if m.Value, err = buf.ReadString(32767); err != nil {
+			return fmt.Errorf("failed to read property value: %w", err)
+		}
+		return nil
+	}
+	if err := m.Min.DecodeWith(buf, readPropertyValue); err != nil {
+		return fmt.Errorf("failed to read property min value: %w", err)
+	}
+	if err := m.Max.DecodeWith(buf, readPropertyValue); err != nil {
+		return fmt.Errorf("failed to read property max value: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a PropertyMatcher to the buffer.
+func (m *PropertyMatcher) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteString(m.Name); err != nil {
+		return fmt.Errorf("failed to write property name: %w", err)
+	}
+	if err := buf.WriteBool(m.Exact); err != nil {
+		return fmt.Errorf("failed to write property match type: %w", err)
+	}
+	if m.Exact {
+		if err := buf.WriteString(m.Value); err != nil {
+			return fmt.Errorf("failed to write property value: %w", err)
+		}
+		return nil
+	}
+	if err := m.Min.EncodeWith(buf, (*PacketBuffer).WriteString); err != nil {
+		return fmt.Errorf("failed to write property min value: %w", err)
+	}
+	if err := m.Max.EncodeWith(buf, (*PacketBuffer).WriteString); err != nil {
+		return fmt.Errorf("failed to write property max value: %w", err)
+	}
+	return nil
+}
+
+func readPropertyValue(buf *PacketBuffer) (String, error) {
+	return buf.ReadString(32767)
+}
+
+// ComponentPredicate is a partial data component predicate, e.g. "damage below 10"
+// or "has enchantment X", given as its minecraft:data_component_predicate_type
+// registry ID and its NBT-encoded arguments.
+type ComponentPredicate struct {
+	Type      VarInt
+	Predicate RawNBT
+}
+
+// BlockPredicate matches a block in the world. Every present condition must match;
+// a predicate without conditions matches any block.
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────────────────────────────────────────────┐
+//	│  Blocks (Prefixed Optional ID Set of minecraft:block)                       │
+//	├─────────────────────────────────────────────────────────────────────────────┤
+//	│  Properties (Prefixed Optional Prefixed Array of PropertyMatcher)           │
+//	├─────────────────────────────────────────────────────────────────────────────┤
+//	│  NBT (Prefixed Optional NBT) - block entity data that must be contained     │
+//	├─────────────────────────────────────────────────────────────────────────────┤
+//	│  Exact Components (Prefixed Array of (VarInt type + component data))        │
+//	├─────────────────────────────────────────────────────────────────────────────┤
+//	│  Partial Components (Prefixed Array of (VarInt type + NBT))                 │
+//	└─────────────────────────────────────────────────────────────────────────────┘
+//
+// The component matchers apply to the block entity's components. Exact components
+// are encoded like slot components, so decoding them needs a SlotDecoder.
+type BlockPredicate struct {
+	Blocks     PrefixedOptional[IDSet]
+	Properties PrefixedOptional[[]PropertyMatcher]
+	NBT        PrefixedOptional[RawNBT]
+
+	ExactComponents   []RawSlotComponent
+	PartialComponents []ComponentPredicate
+}
+
+// Decode reads a BlockPredicate without exact component matchers.
+// Use DecodeWith (or SlotComponentTypes) for predicates with exact components.
+func (p *BlockPredicate) Decode(buf *PacketBuffer) error {
+	return p.DecodeWith(buf, rejectNestedComponents)
+}
+
+// DecodeWith reads a BlockPredicate using decode for the exact component matchers.
+func (p *BlockPredicate) DecodeWith(buf *PacketBuffer, decode SlotDecoder) error {
+	if err := p.Blocks.DecodeWith(buf, func(buf *PacketBuffer) (IDSet, error) {
+		var s IDSet
+		err := s.Decode(buf)
+		return s, err
+	}); err != nil {
+		return fmt.Errorf("failed to read predicate blocks: %w", err)
+	}
+	if err := p.Properties.DecodeWith(buf, readPropertyMatchers); err != nil {
+		return fmt.Errorf("failed to read predicate properties: %w", err)
+	}
+	if err := p.NBT.DecodeWith(buf, (*PacketBuffer).ReadRawNBT); err != nil {
+		return fmt.Errorf("failed to read predicate nbt: %w", err)
+	}
+
+	count, err := buf.ReadCount()
+	if err != nil {
+		return fmt.Errorf("failed to read exact component count: %w", err)
+	}
+	p.ExactComponents = make([]RawSlotComponent, count)
+	for i := range p.ExactComponents {
+		id, err := buf.ReadVarInt()
+		if err != nil {
+			return fmt.Errorf("failed to read exact component %d id: %w", i, err)
+		}
+		data, err := decode(buf, id)
+		if err != nil {
+			return fmt.Errorf("failed to read exact component %d (id=%d): %w", i, id, err)
+		}
+		p.ExactComponents[i] = RawSlotComponent{ID: id, Data: data}
+	}
+
+	if count, err = buf.ReadCount(); err != nil {
+		return fmt.Errorf("failed to read partial component count: %w", err)
+	}
+	p.PartialComponents = make([]ComponentPredicate, count)
+	for i := range p.PartialComponents {
+		if p.PartialComponents[i].Type, err = buf.ReadVarInt(); err != nil {
+			return fmt.Errorf("failed to read partial component %d type: %w", i, err)
+		}
+		if p.PartialComponents[i].Predicate, err = buf.ReadRawNBT(); err != nil {
+			return fmt.Errorf("failed to read partial component %d predicate: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Encode writes a BlockPredicate to the buffer.
+func (p *BlockPredicate) Encode(buf *PacketBuffer) error {
+	if err := p.Blocks.EncodeWith(buf, func(buf *PacketBuffer, s IDSet) error {
+		return s.Encode(buf)
+	}); err != nil {
+		return fmt.Errorf("failed to write predicate blocks: %w", err)
+	}
+	if err := p.Properties.EncodeWith(buf, writePropertyMatchers); err != nil {
+		return fmt.Errorf("failed to write predicate properties: %w", err)
+	}
+	if err := p.NBT.EncodeWith(buf, (*PacketBuffer).WriteRawNBT); err != nil {
+		return fmt.Errorf("failed to write predicate nbt: %w", err)
+	}
+
+	if err := buf.WriteVarInt(VarInt(len(p.ExactComponents))); err != nil {
+		return fmt.Errorf("failed to write exact component count: %w", err)
+	}
+	for i, c := range p.ExactComponents {
+		if err := buf.WriteVarInt(c.ID); err != nil {
+			return fmt.Errorf("failed to write exact component %d id: %w", i, err)
+		}
+		if _, err := buf.Write(c.Data); err != nil {
+			return fmt.Errorf("failed to write exact component %d data: %w", i, err)
+		}
+	}
+
+	if err := buf.WriteVarInt(VarInt(len(p.PartialComponents))); err != nil {
+		return fmt.Errorf("failed to write partial component count: %w", err)
+	}
+	for i, c := range p.PartialComponents {
+		if err := buf.WriteVarInt(c.Type); err != nil {
+			return fmt.Errorf("failed to write partial component %d type: %w", i, err)
+		}
+		if err := buf.WriteRawNBT(c.Predicate); err != nil {
+			return fmt.Errorf("failed to write partial component %d predicate: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func readPropertyMatchers(buf *PacketBuffer) ([]PropertyMatcher, error) {
+	count, err := buf.ReadCount()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read property count: %w", err)
+	}
+	matchers := make([]PropertyMatcher, count)
+	for i := range matchers {
+		if err := matchers[i].Decode(buf); err != nil {
+			return nil, fmt.Errorf("failed to read property %d: %w", i, err)
+		}
+	}
+	return matchers, nil
+}
+
+func writePropertyMatchers(buf *PacketBuffer, matchers []PropertyMatcher) error {
+	if err := buf.WriteVarInt(VarInt(len(matchers))); err != nil {
+		return fmt.Errorf("failed to write property count: %w", err)
+	}
+	for i := range matchers {
+		if err := matchers[i].Encode(buf); err != nil {
+			return fmt.Errorf("failed to write property %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// AdventureModePredicate is the data of the minecraft:can_place_on and
+// minecraft:can_break components. In adventure mode, the item can only be placed
+// on (or break) blocks matching at least one of the predicates.
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────────────────────────────┐
+//	│  Predicates (Prefixed Array of BlockPredicate)              │
+//	└─────────────────────────────────────────────────────────────┘
+type AdventureModePredicate struct {
+	Predicates []BlockPredicate
+}
+
+// Decode reads an AdventureModePredicate without exact component matchers.
+// Use DecodeWith (or SlotComponentTypes) for predicates with exact components.
+func (a *AdventureModePredicate) Decode(buf *PacketBuffer) error {
+	return a.DecodeWith(buf, rejectNestedComponents)
+}
+
+// DecodeWith reads an AdventureModePredicate using decode for exact component matchers.
+func (a *AdventureModePredicate) DecodeWith(buf *PacketBuffer, decode SlotDecoder) error {
+	count, err := buf.ReadCount()
+	if err != nil {
+		return fmt.Errorf("failed to read block predicate count: %w", err)
+	}
+	a.Predicates = make([]BlockPredicate, count)
+	for i := range a.Predicates {
+		if err := a.Predicates[i].DecodeWith(buf, decode); err != nil {
+			return fmt.Errorf("failed to read block predicate %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Encode writes an AdventureModePredicate to the buffer.
+func (a *AdventureModePredicate) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(len(a.Predicates))); err != nil {
+		return fmt.Errorf("failed to write block predicate count: %w", err)
+	}
+	for i := range a.Predicates {
+		if err := a.Predicates[i].Encode(buf); err != nil {
+			return fmt.Errorf("failed to write block predicate %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package net_structures_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+)
+
+func TestAdventureModePredicate_RoundTrip(t *testing.T) {
+	data, err := nbt.EncodeNetwork(nbt.Compound{"Items": nbt.List{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	damage, err := nbt.EncodeNetwork(nbt.Compound{"durability": nbt.Int(10)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in := &ns.AdventureModePredicate{Predicates: []ns.BlockPredicate{
+		{Blocks: ns.Some(*ns.NewTagIDSet("minecraft:logs"))},
+		{
+			Blocks: ns.Some(*ns.NewInlineIDSet([]ns.VarInt{1, 9})),
+			Properties: ns.Some([]ns.PropertyMatcher{
+				ns.NewExactPropertyMatcher("facing", "north"),
+				ns.NewRangePropertyMatcher("power", ns.Some[ns.String]("4"), ns.None[ns.String]()),
+			}),
+			NBT:               ns.Some(ns.RawNBT(data)),
+			PartialComponents: []ns.ComponentPredicate{{Type: 0, Predicate: damage}},
+		},
+		{}, // matches any block
+	}}
+	var out ns.AdventureModePredicate
+	roundTripComponent(t, in, &out)
+
+	if len(out.Predicates) != 3 {
+		t.Fatalf("expected 3 predicates, got %d", len(out.Predicates))
+	}
+	if blocks, ok := out.Predicates[0].Blocks.Get(); !ok || blocks.TagName != "minecraft:logs" {
+		t.Errorf("unexpected blocks: %+v", out.Predicates[0].Blocks)
+	}
+	props, _ := out.Predicates[1].Properties.Get()
+	if len(props) != 2 || !props[0].Exact || props[0].Value != "north" {
+		t.Fatalf("unexpected properties: %+v", props)
+	}
+	if lo, _ := props[1].Min.Get(); bool(props[1].Exact) || lo != "4" || props[1].Max.Present {
+		t.Errorf("unexpected range matcher: %+v", props[1])
+	}
+	if raw, _ := out.Predicates[1].NBT.Get(); !bytes.Equal(raw, data) {
+		t.Errorf("nbt mismatch: %x", raw)
+	}
+	if p := out.Predicates[2]; p.Blocks.Present || p.Properties.Present || p.NBT.Present {
+		t.Errorf("expected empty predicate, got %+v", p)
+	}
+}
+
+func TestAdventureModePredicate_ExactComponents(t *testing.T) {
+	types := ns.SlotComponentTypes{ns.ComponentCanPlaceOn, ns.ComponentFood}
+	food, err := types.Raw(ns.ComponentFood, &ns.Food{Nutrition: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := &ns.AdventureModePredicate{Predicates: []ns.BlockPredicate{
+		{ExactComponents: []ns.RawSlotComponent{food}},
+	}}
+	raw, err := types.Raw(ns.ComponentCanPlaceOn, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var plain ns.AdventureModePredicate
+	if err := plain.Decode(ns.NewReader(raw.Data)); err == nil || !strings.Contains(err.Error(), "without SlotComponentTypes") {
+		t.Fatalf("expected nested component error, got: %v", err)
+	}
+
+	parsed, err := types.Parse(raw)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	exact := parsed.(*ns.AdventureModePredicate).Predicates[0].ExactComponents
+	if len(exact) != 1 || exact[0].ID != 1 || !bytes.Equal(exact[0].Data, food.Data) {
+		t.Errorf("unexpected exact components: %+v", exact)
+	}
+}
@@ -0,0 +1,282 @@
+package net_structures_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// testComponentTypes is a made-up component type registry for tests.
+var testComponentTypes = ns.SlotComponentTypes{
+	"minecraft:container_loot",
+	ns.ComponentAttributeModifiers,
+	ns.ComponentTooltipDisplay,
+}
+
+// roundTripComponent encodes c, decodes it into out and checks that re-encoding
+// out yields the same bytes.
+func roundTripComponent(t *testing.T, c, out ns.SlotComponent) {
+	t.Helper()
+	buf := ns.NewWriter()
+	if err := c.Encode(buf); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	encoded := append([]byte(nil), buf.Bytes()...)
+
+	r := bytes.NewReader(encoded)
+	if err := out.Decode(ns.NewReaderFrom(r)); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if r.Len() != 0 {
+		t.Fatalf("%d bytes left after decode", r.Len())
+	}
+
+	re := ns.NewWriter()
+	if err := out.Encode(re); err != nil {
+		t.Fatalf("re-encode error: %v", err)
+	}
+	if !bytes.Equal(re.Bytes(), encoded) {
+		t.Errorf("re-encoded bytes differ:\n got: %x\nwant: %x", re.Bytes(), encoded)
+	}
+}
+
+// wire concatenates the parts of a wire fixture: byte slices as they are and
+// strings as their raw UTF-8 bytes (length prefixes are spelled out).
+func wire(parts ...any) []byte {
+	var b []byte
+	for _, p := range parts {
+		switch v := p.(type) {
+		case []byte:
+			b = append(b, v...)
+		case string:
+			b = append(b, v...)
+		default:
+			panic("wire: unsupported part")
+		}
+	}
+	return b
+}
+
+// checkComponentWire checks that c encodes to raw, the bytes vanilla sends,
+// and that decoding raw into out gives back c.
+func checkComponentWire(t *testing.T, c ns.SlotComponent, raw []byte, out ns.SlotComponent) {
+	t.Helper()
+	buf := ns.NewWriter()
+	if err := c.Encode(buf); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), raw) {
+		t.Errorf("encode mismatch:\n  got:  %x\n  want: %x", buf.Bytes(), raw)
+	}
+
+	r := bytes.NewReader(raw)
+	if err := out.Decode(ns.NewReaderFrom(r)); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if r.Len() != 0 {
+		t.Fatalf("%d bytes left after decode", r.Len())
+	}
+	if !reflect.DeepEqual(out, c) {
+		t.Errorf("decode mismatch:\n  got:  %+v\n  want: %+v", out, c)
+	}
+}
+
+func TestAttributeModifiers_RoundTrip(t *testing.T) {
+	in := &ns.AttributeModifiers{Modifiers: []ns.AttributeModifier{
+		{
+			Attribute: 2,
+			ID:        "minecraft:base_attack_damage",
+			Value:     6,
+			Operation: ns.AttributeAddValue,
+			Slot:      ns.SlotGroupMainHand,
+		},
+		{
+			Attribute:   20,
+			ID:          "example:speed",
+			Value:       0.1,
+			Operation:   ns.AttributeAddMultipliedTotal,
+			Slot:        ns.SlotGroupFeet,
+			DisplayType: ns.AttributeDisplayOverride,
+			Display:     ns.NewTextComponent("Swift"),
+		},
+	}}
+	var out ns.AttributeModifiers
+	roundTripComponent(t, in, &out)
+
+	if len(out.Modifiers) != 2 {
+		t.Fatalf("expected 2 modifiers, got %d", len(out.Modifiers))
+	}
+	if out.Modifiers[1].Slot != ns.SlotGroupFeet || out.Modifiers[1].Display.Text != "Swift" {
+		t.Errorf("unexpected modifier: %+v", out.Modifiers[1])
+	}
+	if out.Modifiers[0].Operation.String() != "add_value" || out.Modifiers[0].Slot.String() != "mainhand" {
+		t.Errorf("unexpected names: %s, %s", out.Modifiers[0].Operation, out.Modifiers[0].Slot)
+	}
+}
+
+func TestAttributeModifiers_Wire(t *testing.T) {
+	in := &ns.AttributeModifiers{Modifiers: []ns.AttributeModifier{
+		{
+			Attribute: 2,
+			ID:        "minecraft:dmg",
+			Value:     6,
+			Operation: ns.AttributeAddValue,
+			Slot:      ns.SlotGroupMainHand,
+		},
+		{
+			Attribute:   20,
+			ID:          "example:speed",
+			Value:       0.1,
+			Operation:   ns.AttributeAddMultipliedTotal,
+			Slot:        ns.SlotGroupFeet,
+			DisplayType: ns.AttributeDisplayHidden,
+		},
+		{
+			Attribute:   20,
+			ID:          "example:fast",
+			Value:       -0.5,
+			Operation:   ns.AttributeAddMultipliedBase,
+			Slot:        ns.SlotGroupArmor,
+			DisplayType: ns.AttributeDisplayOverride,
+			Display:     ns.NewTextComponent("Swift"),
+		},
+	}}
+	raw := wire(
+		[]byte{0x03}, // 3 modifiers
+		// attribute=2, id, value=6.0, operation=add_value, slot=mainhand, display=default
+		[]byte{0x02, 0x0d}, "minecraft:dmg",
+		[]byte{0x40, 0x18, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		[]byte{0x00, 0x01, 0x00},
+		// attribute=20, id, value=0.1, operation=add_multiplied_total, slot=feet, display=hidden
+		[]byte{0x14, 0x0d}, "example:speed",
+		[]byte{0x3f, 0xb9, 0x99, 0x99, 0x99, 0x99, 0x99, 0x9a},
+		[]byte{0x02, 0x04, 0x01},
+		// attribute=20, id, value=-0.5, operation=add_multiplied_base, slot=armor,
+		// display=override with an NBT string component
+		[]byte{0x14, 0x0c}, "example:fast",
+		[]byte{0xbf, 0xe0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		[]byte{0x01, 0x08, 0x02},
+		[]byte{0x08, 0x00, 0x05}, "Swift",
+	)
+	checkComponentWire(t, in, raw, &ns.AttributeModifiers{})
+}
+
+func TestAttributeModifiers_InvalidSlot(t *testing.T) {
+	w := ns.NewWriter()
+	w.WriteVarInt(1)
+	w.WriteVarInt(0)
+	w.WriteIdentifier("example:x")
+	w.WriteFloat64(1)
+	w.WriteVarInt(0)
+	w.WriteVarInt(42) // slot group
+	w.WriteVarInt(0)
+
+	var out ns.AttributeModifiers
+	err := out.Decode(ns.NewReader(w.Bytes()))
+	if err == nil || !strings.Contains(err.Error(), "invalid equipment slot group: 42") {
+		t.Fatalf("expected invalid slot group error, got: %v", err)
+	}
+}
+
+func TestTooltipDisplay(t *testing.T) {
+	in := &ns.TooltipDisplay{HiddenComponents: []ns.VarInt{1, 2}}
+	var out ns.TooltipDisplay
+	roundTripComponent(t, in, &out)
+
+	if !out.Hides(2) || out.Hides(0) {
+		t.Errorf("unexpected Hides result for %+v", out)
+	}
+	out.HideTooltip = true
+	if !out.Hides(0) {
+		t.Error("HideTooltip should hide every component")
+	}
+}
+
+func TestSlotComponentTypes_Decoder(t *testing.T) {
+	display := &ns.TooltipDisplay{HideTooltip: true}
+	raw, err := testComponentTypes.Raw(ns.ComponentTooltipDisplay, display)
+	if err != nil {
+		t.Fatalf("raw error: %v", err)
+	}
+	if raw.ID != 2 {
+		t.Fatalf("expected component ID 2, got %d", raw.ID)
+	}
+
+	slot := ns.NewSlot(5, 1)
+	slot.Components.Add = append(slot.Components.Add, raw)
+	buf := ns.NewWriter()
+	if err := slot.Encode(buf); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	// trailing data after the slot must not be consumed
+	buf.WriteVarInt(99)
+
+	r := ns.NewReader(buf.Bytes())
+	decoded, err := r.ReadSlot(testComponentTypes.Decoder())
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if got, _ := r.ReadVarInt(); got != 99 {
+		t.Errorf("decoder consumed too much: trailing value = %d", got)
+	}
+
+	comp := decoded.GetComponent(2)
+	if comp == nil || !bytes.Equal(comp.Data, raw.Data) {
+		t.Fatalf("component data mismatch: %+v", comp)
+	}
+	typed, err := testComponentTypes.Parse(*comp)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if td, ok := typed.(*ns.TooltipDisplay); !ok || !bool(td.HideTooltip) {
+		t.Errorf("unexpected typed component: %#v", typed)
+	}
+}
+
+func TestSlotComponentTypes_Errors(t *testing.T) {
+	decode := testComponentTypes.Decoder()
+	if _, err := decode(ns.NewReader(nil), 7); err == nil || !strings.Contains(err.Error(), "unknown component type id: 7") {
+		t.Errorf("expected unknown id error, got: %v", err)
+	}
+	if _, err := decode(ns.NewReader(nil), 0); err == nil || !strings.Contains(err.Error(), "unsupported component: minecraft:container_loot") {
+		t.Errorf("expected unsupported component error, got: %v", err)
+	}
+	if _, err := testComponentTypes.Parse(ns.RawSlotComponent{ID: 2, Data: []byte{0x00, 0x00, 0xFF}}); err == nil || !strings.Contains(err.Error(), "trailing") {
+		t.Errorf("expected trailing bytes error, got: %v", err)
+	}
+}
+
+func TestProfileComponent(t *testing.T) {
+	types := ns.SlotComponentTypes{ns.ComponentProfile}
+	in := ns.NewCompleteProfile(ns.GameProfile{Username: "Steve"})
+	in.SkinModel = ns.Some[ns.VarInt](1)
+	raw, err := types.Raw(ns.ComponentProfile, in)
+	if err != nil {
+		t.Fatalf("raw error: %v", err)
+	}
+
+	head := ns.NewSlot(1100, 1)
+	head.Components.Add = []ns.RawSlotComponent{raw}
+	buf := ns.NewWriter()
+	if err := head.Encode(buf); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	decoded, err := ns.NewReader(buf.Bytes()).ReadSlot(types.Decoder())
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	typed, err := types.Parse(decoded.Components.Add[0])
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	profile, ok := typed.(*ns.ResolvableProfile)
+	if !ok || profile.Kind != ns.ProfileComplete || profile.CompleteProfile.Username != "Steve" {
+		t.Fatalf("unexpected profile: %#v", typed)
+	}
+	if skin, _ := profile.SkinModel.Get(); skin != 1 {
+		t.Errorf("expected slim skin model, got %d", skin)
+	}
+}
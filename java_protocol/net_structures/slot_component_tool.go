@@ -0,0 +1,383 @@
+package net_structures
+
+import "fmt"
+
+// ToolRule sets the mining speed and/or drop behavior for a set of blocks.
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────┬──────────────────────────────────┐
+//	│  Blocks (ID Set of minecraft:block) │  Speed (Prefixed Optional Float) │
+//	├─────────────────────────────────────┴──────────────────────────────────┤
+//	│  Correct For Drops (Prefixed Optional Boolean)                         │
+//	└────────────────────────────────────────────────────────────────────────┘
+type ToolRule struct {
+	Blocks          IDSet
+	Speed           PrefixedOptional[Float32]
+	CorrectForDrops PrefixedOptional[Boolean]
+}
+
+// Decode reads a ToolRule from the buffer.
+func (r *ToolRule) Decode(buf *PacketBuffer) error {
+	if err := r.Blocks.Decode(buf); err != nil {
+		return fmt.Errorf("failed to read tool rule blocks: %w", err)
+	}
+	if err := r.Speed.DecodeWith(buf, (*PacketBuffer).ReadFloat32); err != nil {
+		return fmt.Errorf("failed to read tool rule speed: %w", err)
+	}
+	if err := r.CorrectForDrops.DecodeWith(buf, (*PacketBuffer).ReadBool); err != nil {
+		return fmt.Errorf("failed to read tool rule correct for drops: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a ToolRule to the buffer.
+func (r *ToolRule) Encode(buf *PacketBuffer) error {
+	if err := r.Blocks.Encode(buf); err != nil {
+		return fmt.Errorf("failed to write tool rule blocks: %w", err)
+	}
+	if err := r.Speed.EncodeWith(buf, (*PacketBuffer).WriteFloat32); err != nil {
+		return fmt.Errorf("failed to write tool rule speed: %w", err)
+	}
+	if err := r.CorrectForDrops.EncodeWith(buf, (*PacketBuffer).WriteBool); err != nil {
+		return fmt.Errorf("failed to write tool rule correct for drops: %w", err)
+	}
+	return nil
+}
+
+// matches reports whether block is in the rule's block set. Tags are resolved
+// with inTag; a nil inTag matches no tags.
+func (r *ToolRule) matches(block VarInt, inTag func(tag Identifier, block VarInt) bool) bool {
+	if r.Blocks.IsTag {
+		return inTag != nil && inTag(r.Blocks.TagName, block)
+	}
+	for _, id := range r.Blocks.IDs {
+		if id == block {
+			return true
+		}
+	}
+	return false
+}
+
+// Tool is the data of the minecraft:tool component.
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────────────┬──────────────────────────────────┐
+//	│  Rules (Prefixed Array of ToolRule)         │  Default Mining Speed (Float)    │
+//	├─────────────────────────────────────────────┼──────────────────────────────────┤
+//	│  Damage Per Block (VarInt)                  │  Can Destroy In Creative (Bool)  │
+//	└─────────────────────────────────────────────┴──────────────────────────────────┘
+//
+// For a given block, the first rule with a speed (and the first rule with a
+// correct-for-drops value) that contains the block applies.
+type Tool struct {
+	Rules                      []ToolRule
+	DefaultMiningSpeed         Float32
+	DamagePerBlock             VarInt
+	CanDestroyBlocksInCreative Boolean
+}
+
+// MiningSpeed returns the mining speed of the tool for block. Tags referenced by
+// rules are resolved with inTag (e.g. backed by the Update Tags packet).
+func (t *Tool) MiningSpeed(block VarInt, inTag func(tag Identifier, block VarInt) bool) Float32 {
+	for i := range t.Rules {
+		if speed, ok := t.Rules[i].Speed.Get(); ok && t.Rules[i].matches(block, inTag) {
+			return speed
+		}
+	}
+	return t.DefaultMiningSpeed
+}
+
+// CorrectForDrops reports whether mining block with the tool drops its items.
+// Tags referenced by rules are resolved with inTag.
+func (t *Tool) CorrectForDrops(block VarInt, inTag func(tag Identifier, block VarInt) bool) bool {
+	for i := range t.Rules {
+		if correct, ok := t.Rules[i].CorrectForDrops.Get(); ok && t.Rules[i].matches(block, inTag) {
+			return bool(correct)
+		}
+	}
+	return false
+}
+
+// Decode reads a Tool from the buffer.
+func (t *Tool) Decode(buf *PacketBuffer) error {
+	count, err := buf.ReadCount()
+	if err != nil {
+		return fmt.Errorf("failed to read tool rule count: %w", err)
+	}
+	t.Rules = make([]ToolRule, count)
+	for i := range t.Rules {
+		if err := t.Rules[i].Decode(buf); err != nil {
+			return fmt.Errorf("failed to read tool rule %d: %w", i, err)
+		}
+	}
+	if t.DefaultMiningSpeed, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read tool default mining speed: %w", err)
+	}
+	if t.DamagePerBlock, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read tool damage per block: %w", err)
+	}
+	if t.CanDestroyBlocksInCreative, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read tool can destroy blocks in creative: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a Tool to the buffer.
+func (t *Tool) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(VarInt(len(t.Rules))); err != nil {
+		return fmt.Errorf("failed to write tool rule count: %w", err)
+	}
+	for i := range t.Rules {
+		if err := t.Rules[i].Encode(buf); err != nil {
+			return fmt.Errorf("failed to write tool rule %d: %w", i, err)
+		}
+	}
+	if err := buf.WriteFloat32(t.DefaultMiningSpeed); err != nil {
+		return fmt.Errorf("failed to write tool default mining speed: %w", err)
+	}
+	if err := buf.WriteVarInt(t.DamagePerBlock); err != nil {
+		return fmt.Errorf("failed to write tool damage per block: %w", err)
+	}
+	if err := buf.WriteBool(t.CanDestroyBlocksInCreative); err != nil {
+		return fmt.Errorf("failed to write tool can destroy blocks in creative: %w", err)
+	}
+	return nil
+}
+
+// Weapon is the data of the minecraft:weapon component.
+//
+// Wire format:
+//
+//	┌─────────────────────────────────────┬───────────────────────────────────────────┐
+//	│  Item Damage Per Attack (VarInt)    │  Disable Blocking For Seconds (Float)     │
+//	└─────────────────────────────────────┴───────────────────────────────────────────┘
+//
+// Items without the component take no durability damage when attacking. A
+// non-zero blocking duration disables a hit shield (as axes do).
+type Weapon struct {
+	ItemDamagePerAttack       VarInt
+	DisableBlockingForSeconds Float32
+}
+
+// Decode reads a Weapon from the buffer.
+func (w *Weapon) Decode(buf *PacketBuffer) error {
+	var err error
+	if w.ItemDamagePerAttack, err = buf.ReadVarInt(); err != nil {
+		return fmt.Errorf("failed to read weapon item damage per attack: %w", err)
+	}
+	if w.DisableBlockingForSeconds, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read weapon disable blocking duration: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a Weapon to the buffer.
+func (w *Weapon) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteVarInt(w.ItemDamagePerAttack); err != nil {
+		return fmt.Errorf("failed to write weapon item damage per attack: %w", err)
+	}
+	if err := buf.WriteFloat32(w.DisableBlockingForSeconds); err != nil {
+		return fmt.Errorf("failed to write weapon disable blocking duration: %w", err)
+	}
+	return nil
+}
+
+// DamageReduction reduces the damage of blocked attacks of some damage types.
+//
+// Wire format:
+//
+//	┌────────────────────────────────────┬────────────────────────────────────────────────────────────┐
+//	│  Horizontal Blocking Angle (Float) │  Type (Prefixed Optional ID Set of minecraft:damage_type)  │
+//	├────────────────────────────────────┼────────────────────────────────────────────────────────────┤
+//	│  Base (Float)                      │  Factor (Float)                                            │
+//	└────────────────────────────────────┴────────────────────────────────────────────────────────────┘
+//
+// The blocked damage is Base + Factor * damage, capped at the damage. Without a
+// type, the reduction applies to all damage types.
+type DamageReduction struct {
+	HorizontalBlockingAngle Float32
+	Type                    PrefixedOptional[IDSet]
+	Base                    Float32
+	Factor                  Float32
+}
+
+// Decode reads a DamageReduction from the buffer.
+func (d *DamageReduction) Decode(buf *PacketBuffer) error {
+	var err error
+	if d.HorizontalBlockingAngle, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read damage reduction blocking angle: %w", err)
+	}
+	if err := d.Type.DecodeWith(buf, func(buf *PacketBuffer) (IDSet, error) {
+		var s IDSet
+		err := s.Decode(buf)
+		return s, err
+	}); err != nil {
+		return fmt.Errorf("failed to read damage reduction type: %w", err)
+	}
+	if d.Base, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read damage reduction base: %w", err)
+	}
+	if d.Factor, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read damage reduction factor: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a DamageReduction to the buffer.
+func (d *DamageReduction) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteFloat32(d.HorizontalBlockingAngle); err != nil {
+		return fmt.Errorf("failed to write damage reduction blocking angle: %w", err)
+	}
+	if err := d.Type.EncodeWith(buf, func(buf *PacketBuffer, s IDSet) error {
+		return s.Encode(buf)
+	}); err != nil {
+		return fmt.Errorf("failed to write damage reduction type: %w", err)
+	}
+	if err := buf.WriteFloat32(d.Base); err != nil {
+		return fmt.Errorf("failed to write damage reduction base: %w", err)
+	}
+	if err := buf.WriteFloat32(d.Factor); err != nil {
+		return fmt.Errorf("failed to write damage reduction factor: %w", err)
+	}
+	return nil
+}
+
+// ItemDamageFunction computes the durability lost by blocking an attack.
+//
+// Wire format:
+//
+//	┌────────────────────┬────────────────┬──────────────────┐
+//	│  Threshold (Float) │  Base (Float)  │  Factor (Float)  │
+//	└────────────────────┴────────────────┴──────────────────┘
+//
+// Attacks below Threshold cost no durability; others cost Base + Factor * damage.
+type ItemDamageFunction struct {
+	Threshold Float32
+	Base      Float32
+	Factor    Float32
+}
+
+// Decode reads an ItemDamageFunction from the buffer.
+func (f *ItemDamageFunction) Decode(buf *PacketBuffer) error {
+	var err error
+	if f.Threshold, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read item damage threshold: %w", err)
+	}
+	if f.Base, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read item damage base: %w", err)
+	}
+	if f.Factor, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read item damage factor: %w", err)
+	}
+	return nil
+}
+
+// Encode writes an ItemDamageFunction to the buffer.
+func (f *ItemDamageFunction) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteFloat32(f.Threshold); err != nil {
+		return fmt.Errorf("failed to write item damage threshold: %w", err)
+	}
+	if err := buf.WriteFloat32(f.Base); err != nil {
+		return fmt.Errorf("failed to write item damage base: %w", err)
+	}
+	if err := buf.WriteFloat32(f.Factor); err != nil {
+		return fmt.Errorf("failed to write item damage factor: %w", err)
+	}
+	return nil
+}
+
+// BlocksAttacks is the data of the minecraft:blocks_attacks component: the item
+// blocks attacks while used, as shields do.
+//
+// Wire format:
+//
+//	┌───────────────────────────────────────────────┬───────────────────────────────────────────────┐
+//	│  Block Delay Seconds (Float)                  │  Disable Cooldown Scale (Float)               │
+//	├───────────────────────────────────────────────┼───────────────────────────────────────────────┤
+//	│  Damage Reductions (Prefixed Array of         │  Item Damage (ItemDamageFunction)             │
+//	│  DamageReduction)                             │                                               │
+//	├───────────────────────────────────────────────┼───────────────────────────────────────────────┤
+//	│  Bypassed By (Prefixed Optional Identifier)   │  Block Sound (Prefixed Optional               │
+//	│                                               │  ID or SoundEvent)                            │
+//	├───────────────────────────────────────────────┴───────────────────────────────────────────────┤
+//	│  Disable Sound (Prefixed Optional ID or SoundEvent)                                           │
+//	└───────────────────────────────────────────────────────────────────────────────────────────────┘
+//
+// Bypassed By is a minecraft:damage_type tag (without the leading '#') whose
+// damage cannot be blocked.
+type BlocksAttacks struct {
+	BlockDelaySeconds    Float32
+	DisableCooldownScale Float32
+	DamageReductions     []DamageReduction
+	ItemDamage           ItemDamageFunction
+	BypassedBy           PrefixedOptional[Identifier]
+	BlockSound           PrefixedOptional[IDOrX[SoundEvent]]
+	DisableSound         PrefixedOptional[IDOrX[SoundEvent]]
+}
+
+// Decode reads a BlocksAttacks from the buffer.
+func (b *BlocksAttacks) Decode(buf *PacketBuffer) error {
+	var err error
+	if b.BlockDelaySeconds, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read block delay: %w", err)
+	}
+	if b.DisableCooldownScale, err = buf.ReadFloat32(); err != nil {
+		return fmt.Errorf("failed to read disable cooldown scale: %w", err)
+	}
+	count, err := buf.ReadCount()
+	if err != nil {
+		return fmt.Errorf("failed to read damage reduction count: %w", err)
+	}
+	b.DamageReductions = make([]DamageReduction, count)
+	for i := range b.DamageReductions {
+		if err := b.DamageReductions[i].Decode(buf); err != nil {
+			return fmt.Errorf("failed to read damage reduction %d: %w", i, err)
+		}
+	}
+	if err := b.ItemDamage.Decode(buf); err != nil {
+		return fmt.Errorf("failed to read blocking item damage: %w", err)
+	}
+	if err := b.BypassedBy.DecodeWith(buf, (*PacketBuffer).ReadIdentifier); err != nil {
+		return fmt.Errorf("failed to read bypassed by: %w", err)
+	}
+	if err := b.BlockSound.DecodeWith(buf, (*PacketBuffer).ReadSoundEventHolder); err != nil {
+		return fmt.Errorf("failed to read block sound: %w", err)
+	}
+	if err := b.DisableSound.DecodeWith(buf, (*PacketBuffer).ReadSoundEventHolder); err != nil {
+		return fmt.Errorf("failed to read disable sound: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a BlocksAttacks to the buffer.
+func (b *BlocksAttacks) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteFloat32(b.BlockDelaySeconds); err != nil {
+		return fmt.Errorf("failed to write block delay: %w", err)
+	}
+	if err := buf.WriteFloat32(b.DisableCooldownScale); err != nil {
+		return fmt.Errorf("failed to write disable cooldown scale: %w", err)
+	}
+	if err := buf.WriteVarInt(VarInt(len(b.DamageReductions))); err != nil {
+		return fmt.Errorf("failed to write damage reduction count: %w", err)
+	}
+	for i := range b.DamageReductions {
+		if err := b.DamageReductions[i].Encode(buf); err != nil {
+			return fmt.Errorf("failed to write damage reduction %d: %w", i, err)
+		}
+	}
+	if err := b.ItemDamage.Encode(buf); err != nil {
+		return fmt.Errorf("failed to write blocking item damage: %w", err)
+	}
+	if err := b.BypassedBy.EncodeWith(buf, (*PacketBuffer).WriteIdentifier); err != nil {
+		return fmt.Errorf("failed to write bypassed by: %w", err)
+	}
+	if err := b.BlockSound.EncodeWith(buf, (*PacketBuffer).WriteSoundEventHolder); err != nil {
+		return fmt.Errorf("failed to write block sound: %w", err)
+	}
+	if err := b.DisableSound.EncodeWith(buf, (*PacketBuffer).WriteSoundEventHolder); err != nil {
+		return fmt.Errorf("failed to write disable sound: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package net_structures_test
+
+import (
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// pickaxe mirrors vanilla's iron pickaxe tool component.
+var pickaxe = &ns.Tool{
+	Rules: []ns.ToolRule{
+		{Blocks: *ns.NewTagIDSet("minecraft:incorrect_for_iron_tool"), CorrectForDrops: ns.Some[ns.Boolean](false)},
+		{Blocks: *ns.NewTagIDSet("minecraft:mineable/pickaxe"), Speed: ns.Some[ns.Float32](6), CorrectForDrops: ns.Some[ns.Boolean](true)},
+		{Blocks: *ns.NewInlineIDSet([]ns.VarInt{99}), Speed: ns.Some[ns.Float32](15)},
+	},
+	DefaultMiningSpeed:         1,
+	DamagePerBlock:             1,
+	CanDestroyBlocksInCreative: true,
+}
+
+func TestTool_RoundTrip(t *testing.T) {
+	var out ns.Tool
+	roundTripComponent(t, pickaxe, &out)
+	if len(out.Rules) != 3 || out.DefaultMiningSpeed != 1 || !bool(out.CanDestroyBlocksInCreative) {
+		t.Fatalf("unexpected tool: %+v", out)
+	}
+	if ids := out.Rules[2].Blocks.IDs; len(ids) != 1 || ids[0] != 99 {
+		t.Errorf("unexpected inline blocks: %+v", out.Rules[2].Blocks)
+	}
+}
+
+func TestTool_Wire(t *testing.T) {
+	raw := wire(
+		[]byte{0x03}, // 3 rules
+		// tag, no speed, correct for drops=false
+		[]byte{0x00, 0x21}, "minecraft:incorrect_for_iron_tool", []byte{0x00, 0x01, 0x00},
+		// tag, speed=6.0, correct for drops=true
+		[]byte{0x00, 0x1a}, "minecraft:mineable/pickaxe", []byte{0x01, 0x40, 0xc0, 0x00, 0x00, 0x01, 0x01},
+		// inline [99], speed=15.0, no correct for drops
+		[]byte{0x02, 0x63, 0x01, 0x41, 0x70, 0x00, 0x00, 0x00},
+		// default speed=1.0, damage per block=1, can destroy in creative=true
+		[]byte{0x3f, 0x80, 0x00, 0x00, 0x01, 0x01},
+	)
+	checkComponentWire(t, pickaxe, raw, &ns.Tool{})
+}
+
+func TestTool_MiningSpeed(t *testing.T) {
+	const stone, obsidian, dirt = 1, 2, 3
+	tags := map[ns.Identifier][]ns.VarInt{
+		"minecraft:mineable/pickaxe":        {stone, obsidian},
+		"minecraft:incorrect_for_iron_tool": {obsidian},
+	}
+	inTag := func(tag ns.Identifier, block ns.VarInt) bool {
+		for _, id := range tags[tag] {
+			if id == block {
+				return true
+			}
+		}
+		return false
+	}
+
+	tests := []struct {
+		block   ns.VarInt
+		speed   ns.Float32
+		correct bool
+	}{
+		{stone, 6, true},
+		{obsidian, 6, false},
+		{dirt, 1, false},
+		{99, 15, false},
+	}
+	for _, tt := range tests {
+		if got := pickaxe.MiningSpeed(tt.block, inTag); got != tt.speed {
+			t.Errorf("MiningSpeed(%d) = %v, want %v", tt.block, got, tt.speed)
+		}
+		if got := pickaxe.CorrectForDrops(tt.block, inTag); got != tt.correct {
+			t.Errorf("CorrectForDrops(%d) = %v, want %v", tt.block, got, tt.correct)
+		}
+	}
+
+	// unresolved tags never match
+	if got := pickaxe.MiningSpeed(stone, nil); got != 1 {
+		t.Errorf("MiningSpeed without tags = %v, want 1", got)
+	}
+}
+
+func TestWeapon_RoundTrip(t *testing.T) {
+	in := &ns.Weapon{ItemDamagePerAttack: 2, DisableBlockingForSeconds: 5}
+	var out ns.Weapon
+	roundTripComponent(t, in, &out)
+	if out != *in {
+		t.Errorf("got %+v, want %+v", out, *in)
+	}
+}
+
+func TestBlocksAttacks_RoundTrip(t *testing.T) {
+	in := &ns.BlocksAttacks{
+		BlockDelaySeconds:    0.25,
+		DisableCooldownScale: 1,
+		DamageReductions: []ns.DamageReduction{
+			{HorizontalBlockingAngle: 90, Factor: 1},
+			{HorizontalBlockingAngle: 90, Type: ns.Some(*ns.NewTagIDSet("minecraft:is_explosion")), Base: 2, Factor: 0.5},
+		},
+		ItemDamage:   ns.ItemDamageFunction{Threshold: 3, Base: 1, Factor: 1},
+		BypassedBy:   ns.Some[ns.Identifier]("minecraft:bypasses_shield"),
+		BlockSound:   ns.Some(ns.NewIDRef[ns.SoundEvent](1400)),
+		DisableSound: ns.None[ns.IDOrX[ns.SoundEvent]](),
+	}
+	var out ns.BlocksAttacks
+	roundTripComponent(t, in, &out)
+	if len(out.DamageReductions) != 2 || out.DamageReductions[0].Type.Present {
+		t.Fatalf("unexpected damage reductions: %+v", out.DamageReductions)
+	}
+	if set, ok := out.DamageReductions[1].Type.Get(); !ok || set.TagName != "minecraft:is_explosion" {
+		t.Errorf("unexpected damage reduction type: %+v", set)
+	}
+	if out.ItemDamage != in.ItemDamage || out.DisableSound.Present {
+		t.Errorf("unexpected blocks attacks: %+v", out)
+	}
+}
+
+func TestBlocksAttacks_Wire(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *ns.BlocksAttacks
+		raw  []byte
+	}{
+		{
+			name: "shield",
+			in: &ns.BlocksAttacks{
+				BlockDelaySeconds:    0.25,
+				DisableCooldownScale: 1,
+				DamageReductions: []ns.DamageReduction{
+					{HorizontalBlockingAngle: 90, Factor: 1},
+					{HorizontalBlockingAngle: 90, Type: ns.Some(*ns.NewTagIDSet("minecraft:is_explosion")), Base: 2, Factor: 0.5},
+				},
+				ItemDamage:   ns.ItemDamageFunction{Threshold: 3, Base: 1, Factor: 1},
+				BypassedBy:   ns.Some[ns.Identifier]("minecraft:bypasses_shield"),
+				BlockSound:   ns.Some(ns.NewIDRef[ns.SoundEvent](1400)),
+				DisableSound: ns.Some(ns.NewInlineValue(ns.SoundEvent{Name: "minecraft:item.shield.break"})),
+			},
+			raw: wire(
+				// block delay=0.25, disable cooldown scale=1.0
+				[]byte{0x3e, 0x80, 0x00, 0x00, 0x3f, 0x80, 0x00, 0x00},
+				[]byte{0x02}, // 2 damage reductions
+				// angle=90.0, no type, base=0.0, factor=1.0
+				[]byte{0x42, 0xb4, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3f, 0x80, 0x00, 0x00},
+				// angle=90.0, tag type, base=2.0, factor=0.5
+				[]byte{0x42, 0xb4, 0x00, 0x00, 0x01, 0x00, 0x16}, "minecraft:is_explosion",
+				[]byte{0x40, 0x00, 0x00, 0x00, 0x3f, 0x00, 0x00, 0x00},
+				// item damage: threshold=3.0, base=1.0, factor=1.0
+				[]byte{0x40, 0x40, 0x00, 0x00, 0x3f, 0x80, 0x00, 0x00, 0x3f, 0x80, 0x00, 0x00},
+				[]byte{0x01, 0x19}, "minecraft:bypasses_shield",
+				[]byte{0x01, 0xf9, 0x0a}, // block sound id 1400+1
+				// inline disable sound without a fixed range
+				[]byte{0x01, 0x00, 0x1b}, "minecraft:item.shield.break", []byte{0x00},
+			),
+		},
+		{
+			name: "minimal",
+			in: &ns.BlocksAttacks{
+				DisableCooldownScale: 1,
+				DamageReductions:     []ns.DamageReduction{},
+			},
+			raw: []byte{
+				0x00, 0x00, 0x00, 0x00, 0x3f, 0x80, 0x00, 0x00,
+				0x00, // no damage reductions
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, // no bypassed by, block sound or disable sound
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkComponentWire(t, tt.in, tt.raw, &ns.BlocksAttacks{})
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package net_structures
+
+import "fmt"
+
+// TrimMaterialOverride replaces a trim material's asset suffix for one armor material
+// (e.g. darker gold trim on gold armor).
+type TrimMaterialOverride struct {
+	// ArmorMaterial is the armor material's equipment asset ID.
+	ArmorMaterial Identifier
+	AssetSuffix   String
+}
+
+// TrimMaterial is an inline minecraft:trim_material registry entry.
+//
+// Wire format:
+//
+//	┌──────────────────────────┬─────────────────────────────────────────────────────────┐
+//	│  Asset Suffix (String)   │  Overrides (Prefixed Array of (Identifier + String))    │
+//	├──────────────────────────┴─────────────────────────────────────────────────────────┤
+//	│  Description (Text Component)                                                      │
+//	└────────────────────────────────────────────────────────────────────────────────────┘
+type TrimMaterial struct {
+	AssetSuffix String
+	Overrides   []TrimMaterialOverride
+	Description TextComponent
+}
+
+// Decode reads a TrimMaterial from the buffer.
+func (m *TrimMaterial) Decode(buf *PacketBuffer) error {
+	var err error
+	if m.AssetSuffix, err = buf.ReadString(32767); err != nil {
+		return fmt.Errorf("failed to read trim material asset suffix: %w", err)
+	}
+	count, err := buf.ReadCount()
+	if err != nil {
+		return fmt.Errorf("failed to read trim material override count: %w", err)
+	}
+	m.Overrides = make([]TrimMaterialOverride, count)
+	for i := range m.Overrides {
+		if m.Overrides[i].ArmorMaterial, err = buf.ReadIdentifier(); err != nil {
+			return fmt.Errorf("failed to read trim material override %d armor material: %w", i, err)
+		}
+		if m.Overrides[i].AssetSuffix, err = buf.ReadString(32767); err != nil {
+			return fmt.Errorf("failed to read trim material override %d asset suffix: %w", i, err)
+		}
+	}
+	if m.Description, err = buf.ReadTextComponent(); err != nil {
+		return fmt.Errorf("failed to read trim material description: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a TrimMaterial to the buffer.
+func (m *TrimMaterial) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteString(m.AssetSuffix); err != nil {
+		return fmt.Errorf("failed to write trim material asset suffix: %w", err)
+	}
+	if err := buf.WriteVarInt(VarInt(len(m.Overrides))); err != nil {
+		return fmt.Errorf("failed to write trim material override count: %w", err)
+	}
+	for i, o := range m.Overrides {
+		if err := buf.WriteIdentifier(o.ArmorMaterial); err != nil {
+			return fmt.Errorf("failed to write trim material override %d armor material: %w", i, err)
+		}
+		if err := buf.WriteString(o.AssetSuffix); err != nil {
+			return fmt.Errorf("failed to write trim material override %d asset suffix: %w", i, err)
+		}
+	}
+	if err := buf.WriteTextComponent(m.Description); err != nil {
+		return fmt.Errorf("failed to write trim material description: %w", err)
+	}
+	return nil
+}
+
+// TrimPattern is an inline minecraft:trim_pattern registry entry.
+//
+// Wire format:
+//
+//	┌─────────────────────────┬────────────────────────────────┬─────────────────┐
+//	│  Asset ID (Identifier)  │  Description (Text Component)  │  Decal (Bool)   │
+//	└─────────────────────────┴────────────────────────────────┴─────────────────┘
+type TrimPattern struct {
+	AssetID     Identifier
+	Description TextComponent
+	// Decal renders the pattern as a decal that does not cover the armor's texture.
+	Decal Boolean
+}
+
+// Decode reads a TrimPattern from the buffer.
+func (p *TrimPattern) Decode(buf *PacketBuffer) error {
+	var err error
+	if p.AssetID, err = buf.ReadIdentifier(); err != nil {
+		return fmt.Errorf("failed to read trim pattern asset id: %w", err)
+	}
+	if p.Description, err = buf.ReadTextComponent(); err != nil {
+		return fmt.Errorf("failed to read trim pattern description: %w", err)
+	}
+	if p.Decal, err = buf.ReadBool(); err != nil {
+		return fmt.Errorf("failed to read trim pattern decal: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a TrimPattern to the buffer.
+func (p *TrimPattern) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteIdentifier(p.AssetID); err != nil {
+		return fmt.Errorf("failed to write trim pattern asset id: %w", err)
+	}
+	if err := buf.WriteTextComponent(p.Description); err != nil {
+		return fmt.Errorf("failed to write trim pattern description: %w", err)
+	}
+	if err := buf.WriteBool(p.Decal); err != nil {
+		return fmt.Errorf("failed to write trim pattern decal: %w", err)
+	}
+	return nil
+}
+
+// ArmorTrim is the data of the minecraft:trim component. Material and pattern are
+// either registry references (vanilla and datapack entries known to the client)
+// or inline definitions.
+//
+// Wire format:
+//
+//	┌────────────────────────────────────┬───────────────────────────────────┐
+//	│  Material (ID or TrimMaterial)     │  Pattern (ID or TrimPattern)      │
+//	└────────────────────────────────────┴───────────────────────────────────┘
+//
+// Since 1.21.5 the tooltip line is hidden through TooltipDisplay instead of a
+// show_in_tooltip flag.
+type ArmorTrim struct {
+	Material IDOrX[TrimMaterial]
+	Pattern  IDOrX[TrimPattern]
+}
+
+// Decode reads an ArmorTrim from the buffer.
+func (t *ArmorTrim) Decode(buf *PacketBuffer) error {
+	var err error
+	if t.Material, err = readTrimMaterialHolder(buf); err != nil {
+		return fmt.Errorf("failed to read trim material: %w", err)
+	}
+	if err := t.Pattern.DecodeWith(buf, func(b *PacketBuffer) (TrimPattern, error) {
+		var p TrimPattern
+		err := p.Decode(b)
+		return p, err
+	}); err != nil {
+		return fmt.Errorf("failed to read trim pattern: %w", err)
+	}
+	return nil
+}
+
+// Encode writes an ArmorTrim to the buffer.
+func (t *ArmorTrim) Encode(buf *PacketBuffer) error {
+	if err := writeTrimMaterialHolder(buf, t.Material); err != nil {
+		return fmt.Errorf("failed to write trim material: %w", err)
+	}
+	if err := t.Pattern.EncodeWith(buf, func(b *PacketBuffer, p TrimPattern) error {
+		return p.Encode(b)
+	}); err != nil {
+		return fmt.Errorf("failed to write trim pattern: %w", err)
+	}
+	return nil
+}
+
+// ProvidesTrimMaterial is the data of the minecraft:provides_trim_material
+// component: the trim material the item supplies in a smithing table.
+//
+// Wire format:
+//
+//	┌───────────────────┬───────────────────────────────────────────────────────────┐
+//	│  Direct (Boolean) │  Material (ID or TrimMaterial if direct, else Identifier) │
+//	└───────────────────┴───────────────────────────────────────────────────────────┘
+//
+// A material given by name (resource key) does not need to be known to the
+// client's registry when the item is sent.
+type ProvidesTrimMaterial struct {
+	Material XOrY[IDOrX[TrimMaterial], Identifier]
+}
+
+// Decode reads a ProvidesTrimMaterial from the buffer.
+func (p *ProvidesTrimMaterial) Decode(buf *PacketBuffer) error {
+	if err := p.Material.DecodeWith(buf, readTrimMaterialHolder, (*PacketBuffer).ReadIdentifier); err != nil {
+		return fmt.Errorf("failed to read provided trim material: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a ProvidesTrimMaterial to the buffer.
+func (p *ProvidesTrimMaterial) Encode(buf *PacketBuffer) error {
+	if err := p.Material.EncodeWith(buf, writeTrimMaterialHolder, (*PacketBuffer).WriteIdentifier); err != nil {
+		return fmt.Errorf("failed to write provided trim material: %w", err)
+	}
+	return nil
+}
+
+func readTrimMaterialHolder(buf *PacketBuffer) (IDOrX[TrimMaterial], error) {
+	var m IDOrX[TrimMaterial]
+	err := m.DecodeWith(buf, func(buf *PacketBuffer) (TrimMaterial, error) {
+		var material TrimMaterial
+		err := material.Decode(buf)
+		return material, err
+	})
+	return m, err
+}
+
+func writeTrimMaterialHolder(buf *PacketBuffer, m IDOrX[TrimMaterial]) error {
+	return m.EncodeWith(buf, func(buf *PacketBuffer, material TrimMaterial) error {
+		return material.Encode(buf)
+	})
+}
@@ -0,0 +1,70 @@
+package net_structures_test
+
+import (
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestArmorTrim_RoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		trim ns.ArmorTrim
+	}{
+		{"registry references", ns.ArmorTrim{
+			Material: ns.NewIDRef[ns.TrimMaterial](4),
+			Pattern:  ns.NewIDRef[ns.TrimPattern](0),
+		}},
+		{"inline definitions", ns.ArmorTrim{
+			Material: ns.NewInlineValue(ns.TrimMaterial{
+				AssetSuffix: "ruby",
+				Overrides:   []ns.TrimMaterialOverride{{ArmorMaterial: "example:ruby", AssetSuffix: "ruby_darker"}},
+				Description: ns.TextComponent{Text: "Ruby", Color: "red"},
+			}),
+			Pattern: ns.NewInlineValue(ns.TrimPattern{
+				AssetID:     "example:stripes",
+				Description: ns.NewTextComponent("Stripes"),
+				Decal:       true,
+			}),
+		}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var out ns.ArmorTrim
+			roundTripComponent(t, &c.trim, &out)
+
+			if out.Material.IsInline != c.trim.Material.IsInline || out.Pattern.IsInline != c.trim.Pattern.IsInline {
+				t.Fatalf("inline flags changed: %+v", out)
+			}
+			if out.Material.IsInline {
+				m := out.Material.Value
+				if m.AssetSuffix != "ruby" || len(m.Overrides) != 1 || m.Overrides[0].AssetSuffix != "ruby_darker" || m.Description.Color != "red" {
+					t.Errorf("unexpected material: %+v", m)
+				}
+				if !bool(out.Pattern.Value.Decal) {
+					t.Error("decal flag lost")
+				}
+			} else if out.Material.ID != 4 {
+				t.Errorf("material ID = %d, want 4", out.Material.ID)
+			}
+		})
+	}
+}
+
+func TestProvidesTrimMaterial_RoundTrip(t *testing.T) {
+	var byName ns.ProvidesTrimMaterial
+	roundTripComponent(t, &ns.ProvidesTrimMaterial{Material: ns.NewY[ns.IDOrX[ns.TrimMaterial], ns.Identifier]("minecraft:amethyst")}, &byName)
+	if _, name, isHolder := byName.Material.Get(); isHolder || name != "minecraft:amethyst" {
+		t.Errorf("unexpected material: %+v", byName.Material)
+	}
+
+	var inline ns.ProvidesTrimMaterial
+	roundTripComponent(t, &ns.ProvidesTrimMaterial{Material: ns.NewX[ns.IDOrX[ns.TrimMaterial], ns.Identifier](
+		ns.NewInlineValue(ns.TrimMaterial{AssetSuffix: "ruby", Description: ns.NewTextComponent("Ruby")}),
+	)}, &inline)
+	holder, _, isHolder := inline.Material.Get()
+	if _, m, isInline := holder.Get(); !isHolder || !isInline || m.AssetSuffix != "ruby" {
+		t.Errorf("unexpected material: %+v", inline.Material)
+	}
+}
@@ -0,0 +1,59 @@
+package net_structures
+
+import "slices"
+
+// ItemPrototypes maps item registry IDs to their default ("prototype") components.
+//
+// Slots on the wire only carry a patch against the item's defaults: Add replaces
+// or adds components, Remove drops default components. The defaults are not sent
+// by the server, so they have to come from generated data for the targeted
+// version (e.g. go-mclib/data).
+type ItemPrototypes map[VarInt][]RawSlotComponent
+
+// Resolve returns the effective components of a slot: the item's default
+// components with the slot's patch applied. Defaults keep their order, replaced
+// components keep the default's position, and added components follow in patch
+// order. An empty slot has no components.
+//
+// The returned components share their Data with the prototypes and the slot.
+func (p ItemPrototypes) Resolve(slot Slot) []RawSlotComponent {
+	if slot.IsEmpty() {
+		return nil
+	}
+	defaults := p[slot.ItemID]
+	resolved := make([]RawSlotComponent, 0, len(defaults)+len(slot.Components.Add))
+
+	for _, c := range defaults {
+		if slices.Contains(slot.Components.Remove, c.ID) {
+			continue
+		}
+		if added := slot.GetComponent(c.ID); added != nil {
+			c = *added
+		}
+		resolved = append(resolved, c)
+	}
+	for _, c := range slot.Components.Add {
+		if !slices.ContainsFunc(defaults, func(d RawSlotComponent) bool { return d.ID == c.ID }) {
+			resolved = append(resolved, c)
+		}
+	}
+	return resolved
+}
+
+// Component returns the effective component with the given ID of a slot, or nil
+// if the slot does not have it (after applying its patch to the defaults).
+func (p ItemPrototypes) Component(slot Slot, id VarInt) *RawSlotComponent {
+	if slot.IsEmpty() || slices.Contains(slot.Components.Remove, id) {
+		return nil
+	}
+	if c := slot.GetComponent(id); c != nil {
+		return c
+	}
+	defaults := p[slot.ItemID]
+	for i := range defaults {
+		if defaults[i].ID == id {
+			return &defaults[i]
+		}
+	}
+	return nil
+}
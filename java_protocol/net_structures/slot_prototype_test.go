@@ -0,0 +1,59 @@
+package net_structures_test
+
+import (
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestItemPrototypes_Resolve(t *testing.T) {
+	const sword = 850
+	prototypes := ns.ItemPrototypes{
+		sword: {
+			{ID: 1, Data: []byte{64}}, // max stack size
+			{ID: 3, Data: []byte{0}},  // damage
+			{ID: 9, Data: []byte{0xAA}},
+		},
+	}
+
+	slot := ns.NewSlot(sword, 1)
+	slot.AddComponent(3, []byte{12}) // replaces default damage
+	slot.AddComponent(5, []byte{0x01})
+	slot.RemoveComponent(9)
+
+	resolved := prototypes.Resolve(slot)
+	want := []ns.RawSlotComponent{
+		{ID: 1, Data: []byte{64}},
+		{ID: 3, Data: []byte{12}},
+		{ID: 5, Data: []byte{0x01}},
+	}
+	if len(resolved) != len(want) {
+		t.Fatalf("expected %d components, got %+v", len(want), resolved)
+	}
+	for i := range want {
+		if resolved[i].ID != want[i].ID || resolved[i].Data[0] != want[i].Data[0] {
+			t.Errorf("component %d: got %+v, want %+v", i, resolved[i], want[i])
+		}
+	}
+
+	if c := prototypes.Component(slot, 3); c == nil || c.Data[0] != 12 {
+		t.Errorf("Component(3) = %+v, want patched damage", c)
+	}
+	if c := prototypes.Component(slot, 1); c == nil || c.Data[0] != 64 {
+		t.Errorf("Component(1) = %+v, want default", c)
+	}
+	if c := prototypes.Component(slot, 9); c != nil {
+		t.Errorf("Component(9) = %+v, want removed", c)
+	}
+
+	// an unpatched slot resolves to the defaults, unknown items to the patch only
+	if got := prototypes.Resolve(ns.NewSlot(sword, 1)); len(got) != 3 {
+		t.Errorf("expected defaults only, got %+v", got)
+	}
+	if got := prototypes.Resolve(ns.NewSlot(1, 1)); len(got) != 0 {
+		t.Errorf("expected no components for unknown item, got %+v", got)
+	}
+	if got := prototypes.Resolve(ns.EmptySlot()); got != nil {
+		t.Errorf("expected nil for empty slot, got %+v", got)
+	}
+}
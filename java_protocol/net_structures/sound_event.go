@@ -0,0 +1,65 @@
+package net_structures
+
+import "fmt"
+
+// SoundEvent is an inline sound event definition, used wherever a sound can be
+// given either as a minecraft:sound_event registry ID or inline (see IDOrX).
+//
+// Wire format:
+//
+//	┌─────────────────────────┬───────────────────────────────────────┐
+//	│  Sound Name (Identifier)│  Fixed Range (Prefixed Optional Float)│
+//	└─────────────────────────┴───────────────────────────────────────┘
+//
+// Without a fixed range, the sound's range scales with its volume.
+type SoundEvent struct {
+	Name       Identifier
+	FixedRange PrefixedOptional[Float32]
+}
+
+// Decode reads a SoundEvent from the buffer.
+func (s *SoundEvent) Decode(buf *PacketBuffer) error {
+	var err error
+	if s.Name, err = buf.ReadIdentifier(); err != nil {
+		return fmt.Errorf("failed to read sound name: %w", err)
+	}
+	if err := s.FixedRange.DecodeWith(buf, (*PacketBuffer).ReadFloat32); err != nil {
+		return fmt.Errorf("failed to read sound fixed range: %w", err)
+	}
+	return nil
+}
+
+// Encode writes a SoundEvent to the buffer.
+func (s *SoundEvent) Encode(buf *PacketBuffer) error {
+	if err := buf.WriteIdentifier(s.Name); err != nil {
+		return fmt.Errorf("failed to write sound name: %w", err)
+	}
+	if err := s.FixedRange.EncodeWith(buf, (*PacketBuffer).WriteFloat32); err != nil {
+		return fmt.Errorf("failed to write sound fixed range: %w", err)
+	}
+	return nil
+}
+
+// ReadSoundEvent reads an inline SoundEvent.
+func (pb *PacketBuffer) ReadSoundEvent() (SoundEvent, error) {
+	var s SoundEvent
+	err := s.Decode(pb)
+	return s, err
+}
+
+// WriteSoundEvent writes an inline SoundEvent.
+func (pb *PacketBuffer) WriteSoundEvent(s SoundEvent) error {
+	return s.Encode(pb)
+}
+
+// ReadSoundEventHolder reads a sound given as a registry ID or inline SoundEvent.
+func (pb *PacketBuffer) ReadSoundEventHolder() (IDOrX[SoundEvent], error) {
+	var s IDOrX[SoundEvent]
+	err := s.DecodeWith(pb, (*PacketBuffer).ReadSoundEvent)
+	return s, err
+}
+
+// WriteSoundEventHolder writes a sound given as a registry ID or inline SoundEvent.
+func (pb *PacketBuffer) WriteSoundEventHolder(s IDOrX[SoundEvent]) error {
+	return s.EncodeWith(pb, (*PacketBuffer).WriteSoundEvent)
+}
@@ -26,9 +26,14 @@ type TextComponent struct {
 	NBTEntity  string `nbt:"entity,omitempty" json:"entity,omitempty"`       // for nbt content type
 	NBTStorage string `nbt:"storage,omitempty" json:"storage,omitempty"`     // for nbt content type
 	Interpret  *bool  `nbt:"interpret,omitempty" json:"interpret,omitempty"` // for nbt content type
+	NBTSource  string `nbt:"source,omitempty" json:"source,omitempty"`       // for nbt content type: "block", "entity" or "storage"
 
-	// translation arguments (for translate content type)
-	With []TextComponent `nbt:"with,omitempty" json:"with,omitempty"`
+	// separator between matches (for selector and nbt content types), ", " if nil
+	Separator *TextComponent `nbt:"separator,omitempty" json:"separator,omitempty"`
+
+	// translation arguments and fallback format (for translate content type)
+	With     []TextComponent `nbt:"with,omitempty" json:"with,omitempty"`
+	Fallback string          `nbt:"fallback,omitempty" json:"fallback,omitempty"`
 
 	// style
 	Color         string `nbt:"color,omitempty" json:"color,omitempty"`
@@ -41,8 +46,8 @@ type TextComponent struct {
 	Insertion     string `nbt:"insertion,omitempty" json:"insertion,omitempty"`
 
 	// click/hover events
-	ClickEvent *ClickEvent `nbt:"click_event,omitempty" json:"clickEvent,omitempty"`
-	HoverEvent *HoverEvent `nbt:"hover_event,omitempty" json:"hoverEvent,omitempty"`
+	ClickEvent *ClickEvent `nbt:"click_event,omitempty" json:"click_event,omitempty"`
+	HoverEvent *HoverEvent `nbt:"hover_event,omitempty" json:"hover_event,omitempty"`
 
 	// children
 	Extra []TextComponent `nbt:"extra,omitempty" json:"extra,omitempty"`
@@ -50,38 +55,38 @@ type TextComponent struct {
 
 // Score represents score component content.
 type Score struct {
-	Name      string `nbt:"name"`
-	Objective string `nbt:"objective"`
+	Name      string `nbt:"name" json:"name"`
+	Objective string `nbt:"objective" json:"objective"`
 }
 
 // ClickEvent represents a click event for text components (1.21.5+ format).
 // Each action type uses a different field; the Action field determines which is relevant.
 type ClickEvent struct {
-	Action  string `nbt:"action"`
-	URL     string `nbt:"url,omitempty"`     // open_url
-	Path    string `nbt:"path,omitempty"`    // open_file
-	Command string `nbt:"command,omitempty"` // run_command, suggest_command
-	Page    int32  `nbt:"page,omitempty"`    // change_page
-	Value   string `nbt:"value,omitempty"`   // copy_to_clipboard
-	Dialog  any    `nbt:"dialog,omitempty"`  // show_dialog
-	ID      string `nbt:"id,omitempty"`      // custom
-	Payload any    `nbt:"payload,omitempty"` // custom
+	Action  string `nbt:"action" json:"action"`
+	URL     string `nbt:"url,omitempty" json:"url,omitempty"`         // open_url
+	Path    string `nbt:"path,omitempty" json:"path,omitempty"`       // open_file
+	Command string `nbt:"command,omitempty" json:"command,omitempty"` // run_command, suggest_command
+	Page    int32  `nbt:"page,omitempty" json:"page,omitempty"`       // change_page
+	Value   string `nbt:"value,omitempty" json:"value,omitempty"`     // copy_to_clipboard
+	Dialog  any    `nbt:"dialog,omitempty" json:"dialog,omitempty"`   // show_dialog
+	ID      string `nbt:"id,omitempty" json:"id,omitempty"`           // custom
+	Payload any    `nbt:"payload,omitempty" json:"payload,omitempty"` // custom
 }
 
 // HoverEvent represents a hover event for text components (1.21.5+ format).
 // Each action type uses different fields; the Action field determines which are relevant.
 type HoverEvent struct {
-	Action string `nbt:"action"`
+	Action string `nbt:"action" json:"action"`
 	// show_text
-	Value any `nbt:"value,omitempty"` // TextComponent (string or compound NBT)
+	Value any `nbt:"value,omitempty" json:"value,omitempty"` // TextComponent (string or compound NBT), see Text
 	// show_entity and show_item
-	ID string `nbt:"id,omitempty"` // entity type or item ID
+	ID string `nbt:"id,omitempty" json:"id,omitempty"` // entity type or item ID
 	// show_entity
-	EntityUUID any `nbt:"uuid,omitempty"` // IntArray in NBT
-	Name       any `nbt:"name,omitempty"` // optional TextComponent (string or compound NBT)
+	EntityUUID any `nbt:"uuid,omitempty" json:"uuid,omitempty"` // IntArray in NBT, see UUID
+	Name       any `nbt:"name,omitempty" json:"name,omitempty"` // optional TextComponent (string or compound NBT), see EntityName
 	// show_item
-	Count      int32 `nbt:"count,omitempty"`
-	Components any   `nbt:"components,omitempty"` // item components compound
+	Count      int32 `nbt:"count,omitempty" json:"count,omitempty"`
+	Components any   `nbt:"components,omitempty" json:"components,omitempty"` // item components compound
 }
 
 // NewTextComponent creates a simple text component with the given text.
@@ -107,7 +112,10 @@ func (tc *TextComponent) isSimpleText() bool {
 		tc.NBTEntity == "" &&
 		tc.NBTStorage == "" &&
 		tc.Interpret == nil &&
+		tc.NBTSource == "" &&
+		tc.Separator == nil &&
 		len(tc.With) == 0 &&
+		tc.Fallback == "" &&
 		tc.Color == "" &&
 		tc.Bold == nil &&
 		tc.Italic == nil &&
@@ -155,7 +163,9 @@ func (tc *TextComponent) Encode(buf *PacketBuffer) error {
 }
 
 // UnmarshalJSON handles both plain JSON strings (e.g. `"hello"`) and
-// JSON objects (e.g. `{"text":"hello","color":"red"}`).
+// JSON objects (e.g. `{"text":"hello","color":"red"}`). Events in the
+// pre-1.21.5 form ("clickEvent" and "hoverEvent" with "value"/"contents") are
+// converted to the current one.
 func (tc *TextComponent) UnmarshalJSON(data []byte) error {
 	// try plain string first
 	var s string
@@ -165,7 +175,22 @@ func (tc *TextComponent) UnmarshalJSON(data []byte) error {
 	}
 	// avoid infinite recursion through json.Unmarshaler
 	type plain TextComponent
-	return json.Unmarshal(data, (*plain)(tc))
+	var aux struct {
+		*plain
+		LegacyClickEvent *legacyClickEvent `json:"clickEvent"`
+		LegacyHoverEvent *legacyHoverEvent `json:"hoverEvent"`
+	}
+	aux.plain = (*plain)(tc)
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if tc.ClickEvent == nil && aux.LegacyClickEvent != nil {
+		tc.ClickEvent = aux.LegacyClickEvent.convert()
+	}
+	if tc.HoverEvent == nil && aux.LegacyHoverEvent != nil {
+		tc.HoverEvent = aux.LegacyHoverEvent.convert()
+	}
+	return nil
 }
 
 // UnmarshalNBT implements nbt.TagUnmarshaler, allowing TextComponent to be
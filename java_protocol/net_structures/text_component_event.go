@@ -0,0 +1,211 @@
+package net_structures
+
+import (
+	"strconv"
+
+	"github.com/go-mclib/protocol/nbt"
+)
+
+// NewOpenURLClick creates a click event that opens url in the browser.
+func NewOpenURLClick(url string) *ClickEvent {
+	return &ClickEvent{Action: "open_url", URL: url}
+}
+
+// NewRunCommandClick creates a click event that runs a command (or sends a
+// chat message if it does not start with "/").
+func NewRunCommandClick(command string) *ClickEvent {
+	return &ClickEvent{Action: "run_command", Command: command}
+}
+
+// NewSuggestCommandClick creates a click event that puts command into the
+// chat input.
+func NewSuggestCommandClick(command string) *ClickEvent {
+	return &ClickEvent{Action: "suggest_command", Command: command}
+}
+
+// NewChangePageClick creates a click event that turns a book to page (1-based).
+func NewChangePageClick(page int32) *ClickEvent {
+	return &ClickEvent{Action: "change_page", Page: page}
+}
+
+// NewCopyToClipboardClick creates a click event that copies value to the
+// clipboard.
+func NewCopyToClipboardClick(value string) *ClickEvent {
+	return &ClickEvent{Action: "copy_to_clipboard", Value: value}
+}
+
+// NewShowTextHover creates a hover event that shows a tooltip.
+func NewShowTextHover(text TextComponent) *HoverEvent {
+	return &HoverEvent{Action: "show_text", Value: text}
+}
+
+// NewShowItemHover creates a hover event that shows an item tooltip.
+// Components can be set on the result as an item components compound.
+func NewShowItemHover(itemID string, count int32) *HoverEvent {
+	return &HoverEvent{Action: "show_item", ID: itemID, Count: count}
+}
+
+// NewShowEntityHover creates a hover event that shows an entity's type, UUID
+// and, if name is non-nil, its name.
+func NewShowEntityHover(entityType string, uuid UUID, name *TextComponent) *HoverEvent {
+	msb, lsb := uuid.MostSignificantBits(), uuid.LeastSignificantBits()
+	h := &HoverEvent{
+		Action:     "show_entity",
+		ID:         entityType,
+		EntityUUID: []int32{int32(msb >> 32), int32(msb), int32(lsb >> 32), int32(lsb)},
+	}
+	if name != nil {
+		h.Name = *name
+	}
+	return h
+}
+
+// Text returns the tooltip of a show_text hover event.
+func (h *HoverEvent) Text() (TextComponent, bool) {
+	if h.Action != "show_text" {
+		return TextComponent{}, false
+	}
+	return componentFromAny(h.Value)
+}
+
+// EntityName returns the name of a show_entity hover event, if it has one.
+func (h *HoverEvent) EntityName() (TextComponent, bool) {
+	if h.Action != "show_entity" {
+		return TextComponent{}, false
+	}
+	return componentFromAny(h.Name)
+}
+
+// UUID returns the entity UUID of a show_entity hover event. The UUID may be
+// given as four ints (NBT and current JSON) or as a string (older JSON).
+func (h *HoverEvent) UUID() (UUID, bool) {
+	if h.Action != "show_entity" {
+		return UUID{}, false
+	}
+	switch v := h.EntityUUID.(type) {
+	case []int32:
+		if len(v) == 4 {
+			return uuidFromInts(v[0], v[1], v[2], v[3]), true
+		}
+	case []any:
+		// decoded from JSON (float64) or an NBT list
+		if len(v) != 4 {
+			return UUID{}, false
+		}
+		var ints [4]int32
+		for i, e := range v {
+			switch n := e.(type) {
+			case float64:
+				ints[i] = int32(n)
+			case int32:
+				ints[i] = n
+			default:
+				return UUID{}, false
+			}
+		}
+		return uuidFromInts(ints[0], ints[1], ints[2], ints[3]), true
+	case string:
+		u, err := UUIDFromString(v)
+		return u, err == nil
+	}
+	return UUID{}, false
+}
+
+func uuidFromInts(a, b, c, d int32) UUID {
+	return UUIDFromInt64s(int64(a)<<32|int64(uint32(b)), int64(c)<<32|int64(uint32(d)))
+}
+
+// componentFromAny converts an untyped text component, as left in HoverEvent
+// fields by NBT or JSON decoding, to a TextComponent.
+func componentFromAny(v any) (TextComponent, bool) {
+	switch c := v.(type) {
+	case nil:
+		return TextComponent{}, false
+	case TextComponent:
+		return c, true
+	case *TextComponent:
+		if c == nil {
+			return TextComponent{}, false
+		}
+		return *c, true
+	case string:
+		return TextComponent{Text: c}, true
+	}
+	tag, err := nbt.MarshalTag(v)
+	if err != nil {
+		return TextComponent{}, false
+	}
+	var tc TextComponent
+	if err := tc.UnmarshalNBT(tag); err != nil {
+		return TextComponent{}, false
+	}
+	return tc, true
+}
+
+// legacyClickEvent is the pre-1.21.5 JSON form of a click event, which puts
+// the argument of every action in "value".
+type legacyClickEvent struct {
+	Action string `json:"action"`
+	Value  any    `json:"value"`
+}
+
+func (e *legacyClickEvent) convert() *ClickEvent {
+	value, _ := e.Value.(string)
+	c := &ClickEvent{Action: e.Action}
+	switch e.Action {
+	case "open_url":
+		c.URL = value
+	case "open_file":
+		c.Path = value
+	case "run_command", "suggest_command":
+		c.Command = value
+	case "change_page":
+		if n, ok := e.Value.(float64); ok {
+			c.Page = int32(n)
+		} else if n, err := strconv.Atoi(value); err == nil {
+			c.Page = int32(n)
+		}
+	default:
+		c.Value = value
+	}
+	return c
+}
+
+// legacyHoverEvent is the pre-1.21.5 JSON form of a hover event, with the
+// details in "contents" (or "value" before 1.16).
+type legacyHoverEvent struct {
+	Action   string `json:"action"`
+	Contents any    `json:"contents"`
+	Value    any    `json:"value"`
+}
+
+func (e *legacyHoverEvent) convert() *HoverEvent {
+	contents := e.Contents
+	if contents == nil {
+		contents = e.Value
+	}
+	h := &HoverEvent{Action: e.Action}
+	switch e.Action {
+	case "show_item":
+		h.Count = 1
+		switch c := contents.(type) {
+		case string:
+			h.ID = c
+		case map[string]any:
+			h.ID, _ = c["id"].(string)
+			if n, ok := c["count"].(float64); ok {
+				h.Count = int32(n)
+			}
+			h.Components = c["components"]
+		}
+	case "show_entity":
+		if c, ok := contents.(map[string]any); ok {
+			h.ID, _ = c["type"].(string)
+			h.EntityUUID = c["id"]
+			h.Name = c["name"]
+		}
+	default:
+		h.Value = contents
+	}
+	return h
+}
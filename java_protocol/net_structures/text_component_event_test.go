@@ -0,0 +1,197 @@
+package net_structures_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func roundTripTextComponent(t *testing.T, in ns.TextComponent) ns.TextComponent {
+	t.Helper()
+	buf := ns.NewWriter()
+	if err := in.Encode(buf); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	out, err := ns.NewReader(buf.Bytes()).ReadTextComponent()
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	return out
+}
+
+func TestHoverEvent_ShowText(t *testing.T) {
+	in := ns.TextComponent{
+		Text:       "hover me",
+		HoverEvent: ns.NewShowTextHover(ns.TextComponent{Text: "tooltip", Color: "gold"}),
+	}
+
+	for name, out := range map[string]ns.TextComponent{
+		"nbt":  roundTripTextComponent(t, in),
+		"json": roundTripJSON(t, in),
+	} {
+		tip, ok := out.HoverEvent.Text()
+		if !ok || tip.Text != "tooltip" || tip.Color != "gold" {
+			t.Errorf("%s: Text() = %+v, %v", name, tip, ok)
+		}
+		if _, ok := out.HoverEvent.EntityName(); ok {
+			t.Errorf("%s: EntityName() ok for show_text", name)
+		}
+	}
+}
+
+func TestHoverEvent_ShowEntity(t *testing.T) {
+	uuid, _ := ns.UUIDFromString("069a79f4-44e9-4726-a5be-fca90e38aaf5")
+	name := ns.TextComponent{Text: "Notch", Bold: boolPtr(true)}
+	in := ns.TextComponent{Text: "x", HoverEvent: ns.NewShowEntityHover("minecraft:player", uuid, &name)}
+
+	for kind, out := range map[string]ns.TextComponent{
+		"nbt":  roundTripTextComponent(t, in),
+		"json": roundTripJSON(t, in),
+	} {
+		h := out.HoverEvent
+		if h.ID != "minecraft:player" {
+			t.Errorf("%s: ID = %q", kind, h.ID)
+		}
+		if got, ok := h.UUID(); !ok || got != uuid {
+			t.Errorf("%s: UUID() = %s, %v, want %s", kind, got, ok, uuid)
+		}
+		if got, ok := h.EntityName(); !ok || got.Text != "Notch" || got.Bold == nil || !*got.Bold {
+			t.Errorf("%s: EntityName() = %+v, %v", kind, got, ok)
+		}
+	}
+}
+
+func TestClickEvent_Constructors(t *testing.T) {
+	for _, c := range []*ns.ClickEvent{
+		ns.NewOpenURLClick("https://example.com"),
+		ns.NewRunCommandClick("/spawn"),
+		ns.NewSuggestCommandClick("/msg "),
+		ns.NewChangePageClick(3),
+		ns.NewCopyToClipboardClick("seed"),
+	} {
+		out := roundTripTextComponent(t, ns.TextComponent{Text: "x", ClickEvent: c})
+		if !reflect.DeepEqual(out.ClickEvent, c) {
+			t.Errorf("nbt round trip = %+v, want %+v", out.ClickEvent, c)
+		}
+		out = roundTripJSON(t, ns.TextComponent{Text: "x", ClickEvent: c})
+		if !reflect.DeepEqual(out.ClickEvent, c) {
+			t.Errorf("json round trip = %+v, want %+v", out.ClickEvent, c)
+		}
+	}
+}
+
+func TestTextComponent_JSONKeys(t *testing.T) {
+	data, err := json.Marshal(ns.TextComponent{
+		Text:       "x",
+		ClickEvent: ns.NewOpenURLClick("https://example.com"),
+		Extra:      []ns.TextComponent{{Score: &ns.Score{Name: "@p", Objective: "kills"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"text":"x","click_event":{"action":"open_url","url":"https://example.com"},` +
+		`"extra":[{"score":{"name":"@p","objective":"kills"}}]}`
+	if string(data) != want {
+		t.Errorf("json.Marshal =\n%s\nwant\n%s", data, want)
+	}
+}
+
+func TestTextComponent_LegacyJSONEvents(t *testing.T) {
+	var tc ns.TextComponent
+	err := json.Unmarshal([]byte(`{"text":"a","extra":[
+		{"text":"b","clickEvent":{"action":"open_url","value":"https://example.com"}},
+		{"text":"c","clickEvent":{"action":"change_page","value":"2"}},
+		{"text":"d","hoverEvent":{"action":"show_text","contents":{"text":"tip","color":"red"}}},
+		{"text":"e","hoverEvent":{"action":"show_text","value":"old tip"}},
+		{"text":"f","hoverEvent":{"action":"show_item","contents":{"id":"minecraft:diamond","count":5}}},
+		{"text":"g","hoverEvent":{"action":"show_entity","contents":{"type":"minecraft:pig","id":"069a79f4-44e9-4726-a5be-fca90e38aaf5","name":"Babe"}}}
+	]}`), &tc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := tc.Extra
+	if c := e[0].ClickEvent; c == nil || c.URL != "https://example.com" {
+		t.Errorf("open_url = %+v", c)
+	}
+	if c := e[1].ClickEvent; c == nil || c.Page != 2 {
+		t.Errorf("change_page = %+v", c)
+	}
+	if tip, ok := e[2].HoverEvent.Text(); !ok || tip.Text != "tip" || tip.Color != "red" {
+		t.Errorf("show_text contents = %+v, %v", tip, ok)
+	}
+	if tip, ok := e[3].HoverEvent.Text(); !ok || tip.Text != "old tip" {
+		t.Errorf("show_text value = %+v, %v", tip, ok)
+	}
+	if h := e[4].HoverEvent; h.ID != "minecraft:diamond" || h.Count != 5 {
+		t.Errorf("show_item = %+v", h)
+	}
+	h := e[5].HoverEvent
+	if uuid, ok := h.UUID(); !ok || uuid.String() != "069a79f4-44e9-4726-a5be-fca90e38aaf5" {
+		t.Errorf("show_entity uuid = %s, %v", uuid, ok)
+	}
+	if name, ok := h.EntityName(); !ok || name.Text != "Babe" || h.ID != "minecraft:pig" {
+		t.Errorf("show_entity = %+v, name %+v", h, name)
+	}
+}
+
+func TestTextComponent_NBTContent(t *testing.T) {
+	in := ns.TextComponent{
+		NBT:       "Inventory[0].id",
+		NBTEntity: "@s",
+		NBTSource: "entity",
+		Interpret: boolPtr(false),
+		Separator: &ns.TextComponent{Text: " | "},
+		Extra:     []ns.TextComponent{{Selector: "@a", Separator: &ns.TextComponent{Text: ", "}}},
+		Insertion: "copy",
+		Font:      "minecraft:uniform",
+	}
+	for kind, out := range map[string]ns.TextComponent{
+		"nbt":  roundTripTextComponent(t, in),
+		"json": roundTripJSON(t, in),
+	} {
+		if !reflect.DeepEqual(out, in) {
+			t.Errorf("%s round trip =\n%+v\nwant\n%+v", kind, out, in)
+		}
+	}
+}
+
+func TestTextComponent_TranslateFallback(t *testing.T) {
+	tc := ns.TextComponent{
+		Translate: "custom.greeting",
+		Fallback:  "Hello, %s!",
+		With:      []ns.TextComponent{{Text: "Steve"}},
+	}
+	if got := tc.String(); got != "Hello, Steve!" {
+		t.Errorf("String() = %q", got)
+	}
+	got := tc.Render(func(key string) string {
+		if key == "custom.greeting" {
+			return "Hi %s"
+		}
+		return ""
+	})
+	if got != "Hi Steve" {
+		t.Errorf("Render() = %q", got)
+	}
+
+	out := roundTripTextComponent(t, tc)
+	if out.Fallback != tc.Fallback || !strings.HasPrefix(out.String(), "Hello") {
+		t.Errorf("round trip = %+v", out)
+	}
+}
+
+func roundTripJSON(t *testing.T, in ns.TextComponent) ns.TextComponent {
+	t.Helper()
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	var out ns.TextComponent
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("json.Unmarshal(%s): %v", data, err)
+	}
+	return out
+}
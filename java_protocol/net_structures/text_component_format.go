@@ -266,16 +266,8 @@ func toLower(b byte) byte {
 }
 
 // PlainText extracts the plain text content from a TextComponent tree,
-// stripping all formatting.
+// stripping all formatting. It uses the same flattening as String and the other
+// renderers, so translate arguments, keybinds, scores and selectors are included.
 func (tc *TextComponent) PlainText() string {
-	var sb strings.Builder
-	tc.plainTextInto(&sb)
-	return sb.String()
-}
-
-func (tc *TextComponent) plainTextInto(sb *strings.Builder) {
-	sb.WriteString(tc.Text)
-	for i := range tc.Extra {
-		tc.Extra[i].plainTextInto(sb)
-	}
+	return tc.Render(nil)
 }
@@ -105,6 +105,18 @@ func TestPlainText(t *testing.T) {
 	if tc.PlainText() != "hello world" {
 		t.Errorf("got %q", tc.PlainText())
 	}
+
+	// flattened the same way as String(): translate args, keybinds and nested extras
+	tc = TextComponent{
+		Translate: "chat.type.text",
+		With:      []TextComponent{{Text: "Steve"}, {Text: "hi", Extra: []TextComponent{{Keybind: "key.jump"}}}},
+	}
+	if tc.PlainText() != tc.String() {
+		t.Errorf("PlainText() = %q, String() = %q", tc.PlainText(), tc.String())
+	}
+	if tc.PlainText() != "chat.type.textStevehikey.jump" {
+		t.Errorf("got %q", tc.PlainText())
+	}
 }
 
 func TestParseFormattedReset(t *testing.T) {
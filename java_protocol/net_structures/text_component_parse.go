@@ -42,31 +42,31 @@ var miniMessageFormats = map[string]func(tc *TextComponent){
 }
 
 // FromColorCodes parses a string with Bukkit-style section sign (§) color/format codes
-// into a TextComponent tree.
+// into a TextComponent tree. Hex colors in the Bukkit §x§r§r§g§g§b§b form are
+// supported.
 //
 //	FromColorCodes("§6Hello §lworld") → gold "Hello " + gold+bold "world"
 func FromColorCodes(s string) TextComponent {
 	root := TextComponent{}
-	var current *TextComponent
+	var style TextComponent // style of the text being collected
 	var buf strings.Builder
 
 	flush := func() {
-		text := buf.String()
-		buf.Reset()
-		if text == "" {
+		if buf.Len() == 0 {
 			return
 		}
-		if current == nil {
-			if root.Text == "" && len(root.Extra) == 0 {
-				root.Text = text
-				return
-			}
-			root.Extra = append(root.Extra, TextComponent{Text: text})
+		c := style
+		c.Text = buf.String()
+		buf.Reset()
+		if c.isSimpleText() && root.Text == "" && len(root.Extra) == 0 {
+			root.Text = c.Text
 			return
 		}
-		current.Text = text
-		root.Extra = append(root.Extra, *current)
-		current = nil
+		root.Extra = append(root.Extra, c)
+	}
+	on := func() *bool {
+		t := true
+		return &t
 	}
 
 	i := 0
@@ -74,74 +74,82 @@ func FromColorCodes(s string) TextComponent {
 		r, size := utf8.DecodeRuneInString(s[i:])
 
 		// check for § followed by a code character
-		if r == '§' && i+size < len(s) {
-			code, codeSize := utf8.DecodeRuneInString(s[i+size:])
+		if r != '§' || i+size >= len(s) {
+			buf.WriteRune(r)
+			i += size
+			continue
+		}
+		code, codeSize := utf8.DecodeRuneInString(s[i+size:])
+		next := i + size + codeSize
 
-			if color, ok := codeToMcColor[code]; ok {
-				// color resets formatting
+		if code == 'x' || code == 'X' {
+			if hex, n, ok := parseHexColorCodes(s[next:]); ok {
 				flush()
-				current = &TextComponent{Color: color}
-				i += size + codeSize
+				style = TextComponent{Color: hex}
+				i = next + n
 				continue
 			}
-
-			switch code {
-			case 'l', 'L':
-				flush()
-				if current == nil {
-					current = &TextComponent{}
-				}
-				t := true
-				current.Bold = &t
-			case 'o', 'O':
-				flush()
-				if current == nil {
-					current = &TextComponent{}
-				}
-				t := true
-				current.Italic = &t
-			case 'n', 'N':
-				flush()
-				if current == nil {
-					current = &TextComponent{}
-				}
-				t := true
-				current.Underlined = &t
-			case 'm', 'M':
-				flush()
-				if current == nil {
-					current = &TextComponent{}
-				}
-				t := true
-				current.Strikethrough = &t
-			case 'k', 'K':
-				flush()
-				if current == nil {
-					current = &TextComponent{}
-				}
-				t := true
-				current.Obfuscated = &t
-			case 'r', 'R':
-				// reset
-				flush()
-				current = nil
-			default:
-				buf.WriteRune(r)
-				i += size
-				continue
-			}
-			i += size + codeSize
+		}
+		if color, ok := codeToMcColor[code]; ok {
+			// color resets formatting
+			flush()
+			style = TextComponent{Color: color}
+			i = next
 			continue
 		}
 
-		buf.WriteRune(r)
-		i += size
+		switch code {
+		case 'l', 'L':
+			flush()
+			style.Bold = on()
+		case 'o', 'O':
+			flush()
+			style.Italic = on()
+		case 'n', 'N':
+			flush()
+			style.Underlined = on()
+		case 'm', 'M':
+			flush()
+			style.Strikethrough = on()
+		case 'k', 'K':
+			flush()
+			style.Obfuscated = on()
+		case 'r', 'R':
+			// reset
+			flush()
+			style = TextComponent{}
+		default:
+			buf.WriteRune(r)
+			i += size
+			continue
+		}
+		i = next
 	}
 
 	flush()
 	return root
 }
 
+// parseHexColorCodes parses the six §-prefixed hex digits following §x and
+// returns the color as "#rrggbb" and the number of bytes consumed.
+func parseHexColorCodes(s string) (string, int, bool) {
+	hex := make([]byte, 1, 7)
+	hex[0] = '#'
+	n := 0
+	for range 6 {
+		if !strings.HasPrefix(s[n:], "§") || len(s) < n+len("§")+1 {
+			return "", 0, false
+		}
+		c := s[n+len("§")]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return "", 0, false
+		}
+		hex = append(hex, c|0x20) // lowercase
+		n += len("§") + 1
+	}
+	return string(hex), n, true
+}
+
 // FromMiniMessage parses a subset of Adventure MiniMessage format into a TextComponent tree.
 // Supports color tags (<gold>, <#ff0000>), format tags (<bold>, <italic>, etc.),
 // <reset>, and <lang:key:arg1:arg2>.
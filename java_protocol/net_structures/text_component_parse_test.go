@@ -16,8 +16,10 @@ func TestFromColorCodes(t *testing.T) {
 		{"single color", "§6Hello", "§6Hello"},
 		{"color + format", "§6§lHello", "§6§lHello"},
 		{"multiple segments", "§6Hello §cWorld", "§6Hello §cWorld"},
-		{"reset", "§6Hello§r World", "§6Hello World"},
+		{"reset", "§6Hello§r World", "§6Hello§r World"},
 		{"format only", "§lBold", "§lBold"},
+		{"format keeps color", "§6Hello §lworld", "§6Hello §6§lworld"},
+		{"hex color", "§x§F§F§5§5§5§5Hex", "§cHex"},
 	}
 
 	for _, tt := range tests {
@@ -31,6 +33,16 @@ func TestFromColorCodes(t *testing.T) {
 	}
 }
 
+func TestFromColorCodesHex(t *testing.T) {
+	// an incomplete §x is kept as text
+	tc := ns.FromColorCodes("a§x§1§2§a§b§c§dB§x§1C")
+	if len(tc.Extra) != 2 ||
+		tc.Extra[0].Color != "#12abcd" || tc.Extra[0].Text != "B§x" ||
+		tc.Extra[1].Color != "dark_blue" || tc.Extra[1].Text != "C" {
+		t.Errorf("FromColorCodes = %#v", tc)
+	}
+}
+
 func TestFromColorCodesPlainText(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -2,6 +2,8 @@ package net_structures
 
 import (
 	"fmt"
+	"html"
+	"strconv"
 	"strings"
 )
 
@@ -51,7 +53,8 @@ type componentWriter func(tc *TextComponent, b *strings.Builder)
 // writeContent writes the resolved content of this component (without extras).
 // If translate is non-nil and the component has a translate key, the key is
 // resolved and %s / %N$s placeholders are substituted with With args rendered
-// via the provided writer. Otherwise the raw text/key is written.
+// via the provided writer. Untranslated keys use the Fallback format if set,
+// otherwise the raw text/key is written.
 func (tc *TextComponent) writeContent(b *strings.Builder, write componentWriter, translate func(string) string) {
 	if tc.Translate != "" {
 		if translate != nil {
@@ -60,6 +63,10 @@ func (tc *TextComponent) writeContent(b *strings.Builder, write componentWriter,
 				return
 			}
 		}
+		if tc.Fallback != "" {
+			writeFormatted(b, tc.Fallback, tc.With, write)
+			return
+		}
 		// no translation available, show key + with args as-is
 		b.WriteString(tc.Translate)
 		for i := range tc.With {
@@ -155,71 +162,60 @@ func (tc *TextComponent) writePlain(b *strings.Builder, translate func(string) s
 }
 
 // ANSI returns the text with ANSI terminal escape codes for colors and formatting.
-// Translate keys are shown as-is.
+// Translate keys are shown as-is. Use String for a plain-text fallback when the
+// output is not a terminal.
 func (tc TextComponent) ANSI() string {
 	return tc.RenderANSI(nil)
 }
 
 // RenderANSI returns ANSI-formatted text with translate keys resolved by fn (if non-nil).
+// Children inherit their parent's color and formatting unless they override it.
 func (tc TextComponent) RenderANSI(translate func(string) string) string {
 	var b strings.Builder
-	if tc.writeANSI(&b, translate) {
+	var active string
+	for _, run := range tc.Flatten(translate) {
+		// only emit codes when the style actually changes
+		if codes := run.Style.ansiCodes(); codes != active {
+			if active != "" {
+				b.WriteString("\033[0m")
+			}
+			b.WriteString(codes)
+			active = codes
+		}
+		b.WriteString(run.Text)
+	}
+	if active != "" {
 		b.WriteString("\033[0m")
 	}
 	return b.String()
 }
 
-func (tc *TextComponent) writeANSI(b *strings.Builder, translate func(string) string) bool {
-	prefix := tc.ansiPrefix()
-	styled := prefix != ""
-	if styled {
-		b.WriteString(prefix)
-	}
-
-	tc.writeContent(b, func(child *TextComponent, b *strings.Builder) {
-		if child.writeANSI(b, translate) {
-			styled = true
-		}
-	}, translate)
-
-	for i := range tc.Extra {
-		// reset before each styled child so parent style doesn't bleed
-		if styled {
-			b.WriteString("\033[0m")
-		}
-		if tc.Extra[i].writeANSI(b, translate) {
-			styled = true
-		}
-	}
-	return styled
-}
-
-func (tc *TextComponent) ansiPrefix() string {
+func (s Style) ansiCodes() string {
 	var codes []string
 
-	if tc.Color != "" {
-		if ansi, ok := mcColorToANSI[tc.Color]; ok {
+	if s.Color != "" {
+		if ansi, ok := mcColorToANSI[s.Color]; ok {
 			codes = append(codes, ansi)
-		} else if strings.HasPrefix(tc.Color, "#") && len(tc.Color) == 7 {
+		} else if strings.HasPrefix(s.Color, "#") && len(s.Color) == 7 {
 			// hex color → 24-bit ANSI
 			var r, g, b int
-			fmt.Sscanf(tc.Color[1:], "%02x%02x%02x", &r, &g, &b)
+			fmt.Sscanf(s.Color[1:], "%02x%02x%02x", &r, &g, &b)
 			codes = append(codes, fmt.Sprintf("\033[38;2;%d;%d;%dm", r, g, b))
 		}
 	}
-	if tc.Bold != nil && *tc.Bold {
+	if s.Bold {
 		codes = append(codes, "\033[1m")
 	}
-	if tc.Italic != nil && *tc.Italic {
+	if s.Italic {
 		codes = append(codes, "\033[3m")
 	}
-	if tc.Underlined != nil && *tc.Underlined {
+	if s.Underlined {
 		codes = append(codes, "\033[4m")
 	}
-	if tc.Strikethrough != nil && *tc.Strikethrough {
+	if s.Strikethrough {
 		codes = append(codes, "\033[9m")
 	}
-	if tc.Obfuscated != nil && *tc.Obfuscated {
+	if s.Obfuscated {
 		codes = append(codes, "\033[8m")
 	}
 
@@ -233,40 +229,77 @@ func (tc TextComponent) ColorCodes() string {
 }
 
 // RenderColorCodes returns section-sign colored text with translate keys resolved by fn (if non-nil).
+// Hex colors are mapped to the nearest of the 16 legacy colors. Since a color
+// code resets the formatting, formatting codes are repeated after each color.
 func (tc TextComponent) RenderColorCodes(translate func(string) string) string {
 	var b strings.Builder
-	tc.writeColorCodes(&b, translate)
+	var active string
+	for _, run := range tc.Flatten(translate) {
+		if codes := run.Style.colorCodes(); codes != active {
+			// without a color code to reset the previous style, reset explicitly
+			if active != "" && run.Style.Color == "" {
+				b.WriteString("§r")
+			}
+			b.WriteString(codes)
+			active = codes
+		}
+		b.WriteString(run.Text)
+	}
 	return b.String()
 }
 
-func (tc *TextComponent) writeColorCodes(b *strings.Builder, translate func(string) string) {
-	if tc.Color != "" {
-		if code, ok := mcColorToCode[tc.Color]; ok {
-			b.WriteString(code)
+func (s Style) colorCodes() string {
+	var b strings.Builder
+
+	if s.Color != "" {
+		color := s.Color
+		if isHexColor(color) {
+			color = nearestLegacyColor(color)
 		}
+		b.WriteString(mcColorToCode[color])
 	}
-	if tc.Bold != nil && *tc.Bold {
+	if s.Bold {
 		b.WriteString("§l")
 	}
-	if tc.Italic != nil && *tc.Italic {
+	if s.Italic {
 		b.WriteString("§o")
 	}
-	if tc.Underlined != nil && *tc.Underlined {
+	if s.Underlined {
 		b.WriteString("§n")
 	}
-	if tc.Strikethrough != nil && *tc.Strikethrough {
+	if s.Strikethrough {
 		b.WriteString("§m")
 	}
-	if tc.Obfuscated != nil && *tc.Obfuscated {
+	if s.Obfuscated {
 		b.WriteString("§k")
 	}
 
-	tc.writeContent(b, func(child *TextComponent, b *strings.Builder) {
-		child.writeColorCodes(b, translate)
-	}, translate)
-	for i := range tc.Extra {
-		tc.Extra[i].writeColorCodes(b, translate)
+	return b.String()
+}
+
+// legacy colors in code order (§0 to §f)
+var legacyColors = []string{
+	"black", "dark_blue", "dark_green", "dark_aqua", "dark_red", "dark_purple", "gold", "gray",
+	"dark_gray", "blue", "green", "aqua", "red", "light_purple", "yellow", "white",
+}
+
+// nearestLegacyColor returns the legacy color closest to a "#rrggbb" color.
+func nearestLegacyColor(hex string) string {
+	r, g, b := hexRGB(hex)
+	best, bestDist := legacyColors[0], -1
+	for _, name := range legacyColors {
+		lr, lg, lb := hexRGB(mcColorToHex[name])
+		dist := (r-lr)*(r-lr) + (g-lg)*(g-lg) + (b-lb)*(b-lb)
+		if bestDist < 0 || dist < bestDist {
+			best, bestDist = name, dist
+		}
 	}
+	return best
+}
+
+func hexRGB(hex string) (r, g, b int) {
+	v, _ := strconv.ParseUint(hex[1:], 16, 32)
+	return int(v >> 16 & 0xFF), int(v >> 8 & 0xFF), int(v & 0xFF)
 }
 
 // MiniMessage returns the text in Adventure MiniMessage format.
@@ -341,3 +374,186 @@ func (tc *TextComponent) writeMiniMessage(b *strings.Builder, translate func(str
 		b.WriteByte('>')
 	}
 }
+
+// MC color name -> CSS color, as rendered by the vanilla client
+var mcColorToHex = map[string]string{
+	"black":        "#000000",
+	"dark_blue":    "#0000aa",
+	"dark_green":   "#00aa00",
+	"dark_aqua":    "#00aaaa",
+	"dark_red":     "#aa0000",
+	"dark_purple":  "#aa00aa",
+	"gold":         "#ffaa00",
+	"gray":         "#aaaaaa",
+	"dark_gray":    "#555555",
+	"blue":         "#5555ff",
+	"green":        "#55ff55",
+	"aqua":         "#55ffff",
+	"red":          "#ff5555",
+	"light_purple": "#ff55ff",
+	"yellow":       "#ffff55",
+	"white":        "#ffffff",
+}
+
+// HTML returns the text as HTML: styled text is wrapped in <span> elements with
+// inline CSS for color and formatting, and all text is escaped, so the output
+// is safe to embed in a page. Translate keys are shown as-is.
+func (tc TextComponent) HTML() string {
+	return tc.RenderHTML(nil)
+}
+
+// RenderHTML returns HTML with translate keys resolved by fn (if non-nil).
+// Obfuscated text is written as is.
+func (tc TextComponent) RenderHTML(translate func(string) string) string {
+	var b strings.Builder
+	eachRunGroup(tc.Flatten(translate), Style.css, func(css, text string) {
+		if css == "" {
+			b.WriteString(html.EscapeString(text))
+			return
+		}
+		b.WriteString(`<span style="`)
+		b.WriteString(css)
+		b.WriteString(`">`)
+		b.WriteString(html.EscapeString(text))
+		b.WriteString("</span>")
+	})
+	return b.String()
+}
+
+func (s Style) css() string {
+	var decls []string
+
+	if hex, ok := mcColorToHex[s.Color]; ok {
+		decls = append(decls, "color:"+hex)
+	} else if isHexColor(s.Color) {
+		decls = append(decls, "color:"+s.Color)
+	}
+	if s.Bold {
+		decls = append(decls, "font-weight:bold")
+	}
+	if s.Italic {
+		decls = append(decls, "font-style:italic")
+	}
+	switch {
+	case s.Underlined && s.Strikethrough:
+		decls = append(decls, "text-decoration:underline line-through")
+	case s.Underlined:
+		decls = append(decls, "text-decoration:underline")
+	case s.Strikethrough:
+		decls = append(decls, "text-decoration:line-through")
+	}
+
+	return strings.Join(decls, ";")
+}
+
+// isHexColor reports whether s is a "#rrggbb" color. Anything else is dropped
+// rather than passed into the style attribute.
+func isHexColor(s string) bool {
+	if len(s) != 7 || s[0] != '#' {
+		return false
+	}
+	for _, c := range s[1:] {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// Markdown returns the text as Discord-flavored markdown, for chat bridges.
+// Translate keys are shown as-is.
+func (tc TextComponent) Markdown() string {
+	return tc.RenderMarkdown(nil)
+}
+
+// RenderMarkdown returns Discord-flavored markdown with translate keys resolved
+// by fn (if non-nil). Bold, italic, underline and strikethrough map to their
+// markdown markers and obfuscated text becomes a spoiler; colors cannot be
+// expressed and are dropped. Markdown characters in the text are escaped, so
+// players cannot inject formatting, links or mentions.
+func (tc TextComponent) RenderMarkdown(translate func(string) string) string {
+	var b strings.Builder
+	lineStart := true
+	eachRunGroup(tc.Flatten(translate), Style.markdownMarkers, func(open, text string) {
+		escaped := escapeMarkdown(text, lineStart)
+		lineStart = strings.HasSuffix(text, "\n")
+
+		// markers must touch the text: "** x**" is not bold
+		core := strings.TrimSpace(escaped)
+		if open == "" || core == "" {
+			b.WriteString(escaped)
+			return
+		}
+		lead := escaped[:strings.Index(escaped, core)]
+		trail := escaped[len(lead)+len(core):]
+
+		b.WriteString(lead)
+		if s := b.String(); s != "" && s[len(s)-1] == open[0] {
+			// "*a***b**" would not parse, separate the markers with a zero-width space
+			b.WriteString("\u200b")
+		}
+		b.WriteString(open)
+		b.WriteString(core)
+		// the markers are runs of one character, so reversing closes them in order
+		for i := len(open) - 1; i >= 0; i-- {
+			b.WriteByte(open[i])
+		}
+		b.WriteString(trail)
+	})
+	return b.String()
+}
+
+func (s Style) markdownMarkers() string {
+	var b strings.Builder
+	if s.Obfuscated {
+		b.WriteString("||")
+	}
+	if s.Strikethrough {
+		b.WriteString("~~")
+	}
+	if s.Underlined {
+		b.WriteString("__")
+	}
+	if s.Bold {
+		b.WriteString("**")
+	}
+	if s.Italic {
+		b.WriteString("*")
+	}
+	return b.String()
+}
+
+// escapeMarkdown backslash-escapes markdown characters in s, and @ so that
+// @everyone and @here do not ping. Headings, quotes and list items only start
+// a line, so #, > and - are escaped there only.
+func escapeMarkdown(s string, lineStart bool) string {
+	var b strings.Builder
+	for i, r := range s {
+		atLineStart := i == 0 && lineStart || i > 0 && s[i-1] == '\n'
+		if strings.ContainsRune("\\*_~`|<[]@", r) || atLineStart && strings.ContainsRune("#>-", r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// eachRunGroup calls fn for each group of adjacent runs that render the same,
+// i.e. have the same key.
+func eachRunGroup(runs []StyledRun, key func(Style) string, fn func(key, text string)) {
+	var current string
+	var text strings.Builder
+	for _, run := range runs {
+		if k := key(run.Style); k != current {
+			if text.Len() > 0 {
+				fn(current, text.String())
+				text.Reset()
+			}
+			current = k
+		}
+		text.WriteString(run.Text)
+	}
+	if text.Len() > 0 {
+		fn(current, text.String())
+	}
+}
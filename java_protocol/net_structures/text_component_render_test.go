@@ -63,6 +63,43 @@ func TestTextComponent_ANSI(t *testing.T) {
 	}
 }
 
+func TestTextComponent_ANSIInheritance(t *testing.T) {
+	cases := []struct {
+		name string
+		tc   ns.TextComponent
+		want string
+	}{
+		{
+			"child inherits parent color",
+			ns.TextComponent{Text: "a", Color: "red", Extra: []ns.TextComponent{{Text: "b"}}},
+			"\033[91mab\033[0m",
+		},
+		{
+			"child adds bold to inherited color",
+			ns.TextComponent{Text: "a", Color: "red", Extra: []ns.TextComponent{{Text: "b", Bold: boolPtr(true)}, {Text: "c"}}},
+			"\033[91ma\033[0m\033[91m\033[1mb\033[0m\033[91mc\033[0m",
+		},
+		{
+			"child disables inherited bold",
+			ns.TextComponent{Text: "a", Bold: boolPtr(true), Extra: []ns.TextComponent{{Text: "b", Bold: boolPtr(false)}}},
+			"\033[1ma\033[0mb",
+		},
+		{
+			"translate args keep surrounding style",
+			ns.TextComponent{Translate: "chat.type.text", Color: "gray", With: []ns.TextComponent{{Text: "Steve", Color: "gold"}}},
+			"\033[37mchat.type.text\033[0m\033[33mSteve\033[0m",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.tc.ANSI(); got != c.want {
+				t.Errorf("ANSI() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
 func TestTextComponent_ColorCodes(t *testing.T) {
 	tc := ns.TextComponent{Text: "Hello", Color: "green", Bold: boolPtr(true)}
 	got := tc.ColorCodes()
@@ -80,6 +117,25 @@ func TestTextComponent_ColorCodes(t *testing.T) {
 	if got != "§6Hello §cWorld" {
 		t.Errorf("ColorCodes() = %q, want %q", got, "§6Hello §cWorld")
 	}
+
+	// formatting is inherited and repeated after the child's color
+	tc = ns.TextComponent{
+		Text:  "a",
+		Bold:  boolPtr(true),
+		Extra: []ns.TextComponent{{Text: "b", Color: "red"}, {Text: "c", Bold: boolPtr(false)}},
+	}
+	got = tc.ColorCodes()
+	if got != "§la§c§lb§rc" {
+		t.Errorf("ColorCodes() = %q, want %q", got, "§la§c§lb§rc")
+	}
+
+	// hex colors map to the nearest legacy color
+	for hex, want := range map[string]string{"#ff0000": "§4", "#FFAA10": "§6", "#101010": "§0", "#fefefe": "§f"} {
+		tc = ns.TextComponent{Text: "x", Color: hex}
+		if got := tc.ColorCodes(); got != want+"x" {
+			t.Errorf("ColorCodes() for %s = %q, want %q", hex, got, want+"x")
+		}
+	}
 }
 
 func TestTextComponent_UnmarshalJSON(t *testing.T) {
@@ -134,3 +190,88 @@ func TestTextComponent_MiniMessage(t *testing.T) {
 		t.Errorf("MiniMessage() = %q, want %q", got, "<gold><bold>wow</bold></gold>")
 	}
 }
+
+func TestTextComponent_HTML(t *testing.T) {
+	tests := []struct {
+		name string
+		tc   ns.TextComponent
+		want string
+	}{
+		{"plain", ns.TextComponent{Text: "Hello"}, "Hello"},
+		{"escaped", ns.TextComponent{Text: `<script>alert("x")</script> & co`},
+			"&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt; &amp; co"},
+		{"named color", ns.TextComponent{Text: "Hi", Color: "red", Bold: boolPtr(true)},
+			`<span style="color:#ff5555;font-weight:bold">Hi</span>`},
+		{"hex color", ns.TextComponent{Text: "Hi", Color: "#12AB9f"},
+			`<span style="color:#12AB9f">Hi</span>`},
+		{"invalid color dropped", ns.TextComponent{Text: "Hi", Color: `red;background:url("x")`}, "Hi"},
+		{"decorations", ns.TextComponent{Text: "Hi", Underlined: boolPtr(true), Strikethrough: boolPtr(true)},
+			`<span style="text-decoration:underline line-through">Hi</span>`},
+		{"inheritance", ns.TextComponent{
+			Text:  "[",
+			Color: "gray",
+			Extra: []ns.TextComponent{
+				{Text: "Admin", Color: "gold"},
+				{Text: "] "},
+				{Text: "Steve", Color: "#000000", Italic: boolPtr(true)},
+			},
+		}, `<span style="color:#aaaaaa">[</span><span style="color:#ffaa00">Admin</span>` +
+			`<span style="color:#aaaaaa">] </span><span style="color:#000000;font-style:italic">Steve</span>`},
+		// obfuscated has no CSS equivalent, so it joins the surrounding span
+		{"same css merged", ns.TextComponent{
+			Text:  "a",
+			Color: "red",
+			Extra: []ns.TextComponent{{Text: "b", Obfuscated: boolPtr(true)}},
+		}, `<span style="color:#ff5555">ab</span>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tc.HTML(); got != tt.want {
+				t.Errorf("HTML() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTextComponent_Markdown(t *testing.T) {
+	tests := []struct {
+		name string
+		tc   ns.TextComponent
+		want string
+	}{
+		{"plain", ns.TextComponent{Text: "Hello", Color: "red"}, "Hello"},
+		{"bold italic", ns.TextComponent{Text: "Hi", Bold: boolPtr(true), Italic: boolPtr(true)}, "***Hi***"},
+		{"all", ns.TextComponent{
+			Text:          "Hi",
+			Obfuscated:    boolPtr(true),
+			Strikethrough: boolPtr(true),
+			Underlined:    boolPtr(true),
+		}, "||~~__Hi__~~||"},
+		{"escaped", ns.TextComponent{Text: "*not bold* <@123> [x](y) a_b ~~c~~ `d` \\"},
+			"\\*not bold\\* \\<\\@123> \\[x\\](y) a\\_b \\~\\~c\\~\\~ \\`d\\` \\\\"},
+		{"mentions", ns.TextComponent{Text: "@everyone @here me@example.com"},
+			"\\@everyone \\@here me\\@example.com"},
+		{"line start", ns.TextComponent{Text: "# title\n> quote\n- item - not"},
+			"\\# title\n\\> quote\n\\- item - not"},
+		{"whitespace outside markers", ns.TextComponent{
+			Text:  "say ",
+			Extra: []ns.TextComponent{{Text: " loud ", Bold: boolPtr(true)}, {Text: "now"}},
+		}, "say  **loud** now"},
+		{"adjacent markers separated", ns.TextComponent{
+			Text:   "a",
+			Italic: boolPtr(true),
+			Extra:  []ns.TextComponent{{Text: "b", Italic: boolPtr(false), Bold: boolPtr(true)}},
+		}, "*a*\u200b**b**"},
+		{"translate", ns.TextComponent{
+			Translate: "chat.type.text",
+			With:      []ns.TextComponent{{Text: "Steve", Bold: boolPtr(true)}, {Text: "hi_there"}},
+		}, "chat.type.text**Steve**hi\\_there"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tc.Markdown(); got != tt.want {
+				t.Errorf("Markdown() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package net_structures
+
+import "strings"
+
+// Style is the fully resolved style of a piece of text: the component's own
+// style with unset fields inherited from its parents.
+type Style struct {
+	// Color is a named color (e.g. "red") or a "#RRGGBB" hex color; empty for the default color.
+	Color         string
+	Bold          bool
+	Italic        bool
+	Underlined    bool
+	Strikethrough bool
+	Obfuscated    bool
+	Font          string
+	Insertion     string
+	ClickEvent    *ClickEvent
+	HoverEvent    *HoverEvent
+}
+
+// inherit returns the style of tc, taking unset fields from s.
+func (s Style) inherit(tc *TextComponent) Style {
+	if tc.Color != "" {
+		s.Color = tc.Color
+	}
+	if tc.Bold != nil {
+		s.Bold = *tc.Bold
+	}
+	if tc.Italic != nil {
+		s.Italic = *tc.Italic
+	}
+	if tc.Underlined != nil {
+		s.Underlined = *tc.Underlined
+	}
+	if tc.Strikethrough != nil {
+		s.Strikethrough = *tc.Strikethrough
+	}
+	if tc.Obfuscated != nil {
+		s.Obfuscated = *tc.Obfuscated
+	}
+	if tc.Font != "" {
+		s.Font = tc.Font
+	}
+	if tc.Insertion != "" {
+		s.Insertion = tc.Insertion
+	}
+	if tc.ClickEvent != nil {
+		s.ClickEvent = tc.ClickEvent
+	}
+	if tc.HoverEvent != nil {
+		s.HoverEvent = tc.HoverEvent
+	}
+	return s
+}
+
+// StyledRun is a piece of text with a single resolved style.
+type StyledRun struct {
+	Text  string
+	Style Style
+}
+
+// Flatten walks the component tree in display order and returns its text as
+// styled runs. Children (extras and translation arguments) inherit their
+// parent's style unless they override it, as in vanilla. Adjacent runs with
+// the same style are merged and empty runs are dropped.
+//
+// Translate keys are resolved by translate (if non-nil), like Render. Renderers
+// for other formats (HTML, markdown, ...) only need to handle the runs.
+func (tc TextComponent) Flatten(translate func(string) string) []StyledRun {
+	f := flattener{translate: translate}
+	f.walk(&tc, Style{})
+	return f.runs
+}
+
+type flattener struct {
+	translate func(string) string
+	runs      []StyledRun
+}
+
+func (f *flattener) walk(tc *TextComponent, parent Style) {
+	style := parent.inherit(tc)
+	var b strings.Builder
+	flush := func() {
+		f.add(b.String(), style)
+		b.Reset()
+	}
+
+	tc.writeContent(&b, func(child *TextComponent, _ *strings.Builder) {
+		flush()
+		f.walk(child, style)
+	}, f.translate)
+	flush()
+
+	for i := range tc.Extra {
+		f.walk(&tc.Extra[i], style)
+	}
+}
+
+func (f *flattener) add(text string, style Style) {
+	if text == "" {
+		return
+	}
+	if n := len(f.runs); n > 0 && f.runs[n-1].Style == style {
+		f.runs[n-1].Text += text
+		return
+	}
+	f.runs = append(f.runs, StyledRun{Text: text, Style: style})
+}
@@ -0,0 +1,54 @@
+package net_structures_test
+
+import (
+	"reflect"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestTextComponent_Flatten(t *testing.T) {
+	click := &ns.ClickEvent{Action: "open_url", URL: "https://example.com"}
+	tc := ns.TextComponent{
+		Translate: "chat.type.text",
+		Color:     "gray",
+		With: []ns.TextComponent{
+			{Text: "Steve", Color: "gold", ClickEvent: click},
+			{Text: "hi ", Extra: []ns.TextComponent{{Text: "all", Bold: boolPtr(true)}, {Text: "!"}}},
+		},
+	}
+	translate := func(key string) string {
+		if key == "chat.type.text" {
+			return "<%s> %s"
+		}
+		return ""
+	}
+
+	want := []ns.StyledRun{
+		{Text: "<", Style: ns.Style{Color: "gray"}},
+		{Text: "Steve", Style: ns.Style{Color: "gold", ClickEvent: click}},
+		{Text: "> hi ", Style: ns.Style{Color: "gray"}},
+		{Text: "all", Style: ns.Style{Color: "gray", Bold: true}},
+		{Text: "!", Style: ns.Style{Color: "gray"}},
+	}
+	if got := tc.Flatten(translate); !reflect.DeepEqual(got, want) {
+		t.Errorf("Flatten() =\n%+v\nwant\n%+v", got, want)
+	}
+
+	if got := (ns.TextComponent{}).Flatten(nil); len(got) != 0 {
+		t.Errorf("Flatten() of empty component = %+v", got)
+	}
+}
+
+func TestTextComponent_FlattenOverride(t *testing.T) {
+	tc := ns.TextComponent{Text: "a", Bold: boolPtr(true), Font: "minecraft:uniform", Extra: []ns.TextComponent{
+		{Text: "b", Bold: boolPtr(false), Color: "#FF5555"},
+	}}
+	runs := tc.Flatten(nil)
+	if len(runs) != 2 {
+		t.Fatalf("got %d runs: %+v", len(runs), runs)
+	}
+	if s := runs[1].Style; s.Bold || s.Color != "#FF5555" || s.Font != "minecraft:uniform" {
+		t.Errorf("unexpected child style: %+v", s)
+	}
+}
@@ -70,6 +70,23 @@ func (u UUID) String() string {
 		u[0:4], u[4:6], u[6:8], u[8:10], u[10:16])
 }
 
+// MarshalText implements encoding.TextMarshaler, so that UUIDs are written
+// to JSON in their hyphenated form.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting both forms
+// of UUIDFromString.
+func (u *UUID) UnmarshalText(text []byte) error {
+	parsed, err := UUIDFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
 // MostSignificantBits returns the first 64 bits of the UUID.
 func (u UUID) MostSignificantBits() int64 {
 	return int64(u[0])<<56 | int64(u[1])<<48 | int64(u[2])<<40 | int64(u[3])<<32 |
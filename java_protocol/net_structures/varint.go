@@ -69,6 +69,18 @@ func (v VarInt) ToBytes() (ByteArray, error) {
 	return buf[:n], nil
 }
 
+// Append appends the encoded VarInt to dst and returns the extended slice.
+// Together with Len it lets callers size a buffer up front and encode into it
+// without intermediate allocations.
+func (v VarInt) Append(dst []byte) []byte {
+	value := uint32(v)
+	for value&^uint32(0x7F) != 0 {
+		dst = append(dst, byte(value&0x7F|0x80))
+		value >>= 7
+	}
+	return append(dst, byte(value))
+}
+
 // Len returns the number of bytes needed to encode this VarInt.
 func (v VarInt) Len() int {
 	value := uint32(v)
@@ -112,6 +124,38 @@ func DecodeVarInt(r io.Reader) (VarInt, error) {
 	return VarInt(value), nil
 }
 
+// DecodeVarIntStrict reads a VarInt from r, accepting only its canonical
+// (shortest) encoding of at most maxBytes bytes (0 or more than 5 means 5).
+//
+// DecodeVarInt accepts over-long encodings such as [0x80, 0x00] for 0 and drops
+// bits beyond 32, like vanilla. Peers that reject those can use this instead.
+func DecodeVarIntStrict(r io.Reader, maxBytes int) (VarInt, error) {
+	if maxBytes <= 0 || maxBytes > 5 {
+		maxBytes = 5
+	}
+
+	var value uint32
+	var b [1]byte
+	for i := range maxBytes {
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return 0, err
+		}
+		if i == 4 && b[0] > 0x0F {
+			return 0, fmt.Errorf("VarInt overflows 32 bits")
+		}
+
+		value |= uint32(b[0]&0x7F) << (7 * i)
+
+		if b[0]&0x80 == 0 {
+			if i > 0 && b[0] == 0 {
+				return 0, fmt.Errorf("non-canonical VarInt: %d bytes for %d", i+1, int32(value))
+			}
+			return VarInt(value), nil
+		}
+	}
+	return 0, fmt.Errorf("VarInt is longer than %d bytes", maxBytes)
+}
+
 // VarLong is a variable-length signed 64-bit integer.
 //
 // Same encoding as VarInt but for 64-bit values.
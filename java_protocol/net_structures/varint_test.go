@@ -2,6 +2,7 @@ package net_structures_test
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
@@ -62,6 +63,12 @@ func TestVarInt(t *testing.T) {
 				t.Errorf("got %x, want %x", got, tc.raw)
 			}
 		})
+
+		t.Run(tc.name+" append", func(t *testing.T) {
+			if got := tc.value.Append([]byte{0xAA}); !bytes.Equal(got, append([]byte{0xAA}, tc.raw...)) {
+				t.Errorf("got %x, want aa%x", got, tc.raw)
+			}
+		})
 	}
 }
 
@@ -97,6 +104,41 @@ func TestVarInt_TooLong(t *testing.T) {
 	}
 }
 
+func TestVarInt_Strict(t *testing.T) {
+	for _, tc := range varIntTestCases {
+		got, err := ns.NewReader(tc.raw).ReadVarIntStrict(0)
+		if err != nil || got != tc.value {
+			t.Errorf("%s: got %d, %v; want %d", tc.name, got, err, tc.value)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		raw      []byte
+		maxBytes int
+		want     string
+	}{
+		{"padded zero", []byte{0x80, 0x00}, 0, "non-canonical VarInt: 2 bytes for 0"},
+		{"padded one", []byte{0x81, 0x80, 0x00}, 0, "non-canonical VarInt: 3 bytes for 1"},
+		{"overflow", []byte{0xff, 0xff, 0xff, 0xff, 0x1f}, 0, "overflows 32 bits"},
+		{"continuation on fifth byte", []byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x01}, 0, "overflows 32 bits"},
+		{"over max bytes", []byte{0x80, 0x80, 0x80, 0x01}, 3, "longer than 3 bytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ns.NewReader(tt.raw).ReadVarIntStrict(tt.maxBytes)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected error containing %q, got: %v", tt.want, err)
+			}
+		})
+	}
+
+	// the lenient decoder accepts over-long encodings
+	if got, err := ns.NewReader([]byte{0x80, 0x00}).ReadVarInt(); err != nil || got != 0 {
+		t.Errorf("ReadVarInt() = %d, %v; want 0", got, err)
+	}
+}
+
 func TestVarInt_Len(t *testing.T) {
 	cases := []struct {
 		value ns.VarInt
@@ -0,0 +1,166 @@
+package net_structures
+
+import "fmt"
+
+// Vec3 is a full-precision 3D vector of doubles.
+// Used for explosion centers and player knockback, and as the decoded form
+// of entity velocities (in blocks per tick).
+//
+// Wire format:
+//
+//	┌──────────────┬──────────────┬──────────────┐
+//	│  X (Double)  │  Y (Double)  │  Z (Double)  │
+//	└──────────────┴──────────────┴──────────────┘
+type Vec3 struct {
+	X, Y, Z float64
+}
+
+// TicksPerSecond is the vanilla game tick rate, used to convert velocities
+// between blocks per tick (wire) and blocks per second.
+const TicksPerSecond = 20
+
+// Decode reads a Vec3 from the buffer.
+func (v *Vec3) Decode(buf *PacketBuffer) error {
+	for i, c := range []*float64{&v.X, &v.Y, &v.Z} {
+		f, err := buf.ReadFloat64()
+		if err != nil {
+			return fmt.Errorf("failed to read vec3 component %d: %w", i, err)
+		}
+		*c = float64(f)
+	}
+	return nil
+}
+
+// Encode writes a Vec3 to the buffer.
+func (v *Vec3) Encode(buf *PacketBuffer) error {
+	for i, c := range []float64{v.X, v.Y, v.Z} {
+		if err := buf.WriteFloat64(Float64(c)); err != nil {
+			return fmt.Errorf("failed to write vec3 component %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Add returns v + o.
+func (v Vec3) Add(o Vec3) Vec3 {
+	return Vec3{X: v.X + o.X, Y: v.Y + o.Y, Z: v.Z + o.Z}
+}
+
+// Scale returns v multiplied by f.
+func (v Vec3) Scale(f float64) Vec3 {
+	return Vec3{X: v.X * f, Y: v.Y * f, Z: v.Z * f}
+}
+
+// PerSecond converts a velocity in blocks per tick to blocks per second.
+func (v Vec3) PerSecond() Vec3 {
+	return v.Scale(TicksPerSecond)
+}
+
+// PerTick converts a velocity in blocks per second to blocks per tick.
+func (v Vec3) PerTick() Vec3 {
+	return v.Scale(1.0 / TicksPerSecond)
+}
+
+// LowPrecision converts the vector to its low-precision wire form.
+// Components are clamped to the range LpVec3 can represent and NaNs become 0,
+// matching what Encode would send.
+func (v Vec3) LowPrecision() LpVec3 {
+	return LpVec3{X: lpSanitize(v.X), Y: lpSanitize(v.Y), Z: lpSanitize(v.Z)}
+}
+
+// Vec3 returns the vector as a full-precision Vec3.
+func (v LpVec3) Vec3() Vec3 {
+	return Vec3{X: v.X, Y: v.Y, Z: v.Z}
+}
+
+// ReadVec3 reads three doubles as a Vec3.
+func (pb *PacketBuffer) ReadVec3() (Vec3, error) {
+	var v Vec3
+	err := v.Decode(pb)
+	return v, err
+}
+
+// WriteVec3 writes a Vec3 as three doubles.
+func (pb *PacketBuffer) WriteVec3(v Vec3) error {
+	return v.Encode(pb)
+}
+
+// ReadOptionalVec3 reads a boolean-prefixed Vec3, as used for the player
+// knockback of the Explosion packet.
+func (pb *PacketBuffer) ReadOptionalVec3() (PrefixedOptional[Vec3], error) {
+	var v PrefixedOptional[Vec3]
+	err := v.DecodeWith(pb, (*PacketBuffer).ReadVec3)
+	return v, err
+}
+
+// WriteOptionalVec3 writes a boolean-prefixed Vec3.
+func (pb *PacketBuffer) WriteOptionalVec3(v PrefixedOptional[Vec3]) error {
+	return v.EncodeWith(pb, (*PacketBuffer).WriteVec3)
+}
+
+// Vector3f is a 3D vector of floats, used by entity metadata (display entity
+// translation and scale, armor stand rotations in degrees).
+//
+// Wire format:
+//
+//	┌─────────────┬─────────────┬─────────────┐
+//	│  X (Float)  │  Y (Float)  │  Z (Float)  │
+//	└─────────────┴─────────────┴─────────────┘
+type Vector3f struct {
+	X, Y, Z Float32
+}
+
+// Decode reads a Vector3f from the buffer.
+func (v *Vector3f) Decode(buf *PacketBuffer) error {
+	for i, c := range []*Float32{&v.X, &v.Y, &v.Z} {
+		f, err := buf.ReadFloat32()
+		if err != nil {
+			return fmt.Errorf("failed to read vector component %d: %w", i, err)
+		}
+		*c = f
+	}
+	return nil
+}
+
+// Encode writes a Vector3f to the buffer.
+func (v *Vector3f) Encode(buf *PacketBuffer) error {
+	for i, c := range []Float32{v.X, v.Y, v.Z} {
+		if err := buf.WriteFloat32(c); err != nil {
+			return fmt.Errorf("failed to write vector component %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Quaternionf is a rotation quaternion of floats, used by display entity metadata.
+//
+// Wire format:
+//
+//	┌─────────────┬─────────────┬─────────────┬─────────────┐
+//	│  X (Float)  │  Y (Float)  │  Z (Float)  │  W (Float)  │
+//	└─────────────┴─────────────┴─────────────┴─────────────┘
+type Quaternionf struct {
+	X, Y, Z, W Float32
+}
+
+// Decode reads a Quaternionf from the buffer.
+func (q *Quaternionf) Decode(buf *PacketBuffer) error {
+	for i, c := range []*Float32{&q.X, &q.Y, &q.Z, &q.W} {
+		f, err := buf.ReadFloat32()
+		if err != nil {
+			return fmt.Errorf("failed to read quaternion component %d: %w", i, err)
+		}
+		*c = f
+	}
+	return nil
+}
+
+// Encode writes a Quaternionf to the buffer.
+func (q *Quaternionf) Encode(buf *PacketBuffer) error {
+	for i, c := range []Float32{q.X, q.Y, q.Z, q.W} {
+		if err := buf.WriteFloat32(c); err != nil {
+			return fmt.Errorf("failed to write quaternion component %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package net_structures_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestVec3(t *testing.T) {
+	raw := []byte{
+		0x3f, 0xf0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // 1.0
+		0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // -2.0
+		0x3f, 0xe0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // 0.5
+	}
+	want := ns.Vec3{X: 1, Y: -2, Z: 0.5}
+
+	got, err := ns.NewReader(raw).ReadVec3()
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if got != want {
+		t.Errorf("decode = %+v, want %+v", got, want)
+	}
+
+	buf := ns.NewWriter()
+	if err := buf.WriteVec3(want); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), raw) {
+		t.Errorf("encode mismatch:\n  got:  %x\n  want: %x", buf.Bytes(), raw)
+	}
+}
+
+func TestOptionalVec3(t *testing.T) {
+	knockback := ns.Vec3{X: 0.25, Y: 0.4, Z: -0.1}
+	buf := ns.NewWriter()
+	if err := buf.WriteOptionalVec3(ns.Some(knockback)); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	if buf.Len() != 25 {
+		t.Fatalf("encoded length = %d, want 25", buf.Len())
+	}
+
+	got, err := ns.NewReader(buf.Bytes()).ReadOptionalVec3()
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if v, ok := got.Get(); !ok || v != knockback {
+		t.Errorf("got %+v (present=%v), want %+v", v, ok, knockback)
+	}
+}
+
+func TestVec3_Velocity(t *testing.T) {
+	perTick := ns.Vec3{X: 0.5, Y: -0.08, Z: 0}
+	perSecond := perTick.PerSecond()
+	if perSecond != (ns.Vec3{X: 10, Y: -1.6, Z: 0}) {
+		t.Errorf("PerSecond() = %+v", perSecond)
+	}
+	back := perSecond.PerTick()
+	if math.Abs(back.X-perTick.X) > 1e-12 || math.Abs(back.Y-perTick.Y) > 1e-12 {
+		t.Errorf("PerTick() = %+v, want %+v", back, perTick)
+	}
+
+	// NaN is sanitized, huge values are clamped
+	lp := ns.Vec3{X: math.NaN(), Y: 1e20, Z: -1e20}.LowPrecision()
+	if lp.X != 0 || lp.Y >= 1e20 || lp.Z <= -1e20 {
+		t.Errorf("LowPrecision() did not sanitize: %+v", lp)
+	}
+
+	// round trip through the wire
+	buf := ns.NewWriter()
+	if err := buf.WriteLpVec3(perTick.LowPrecision()); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	decoded, err := ns.NewReader(buf.Bytes()).ReadLpVec3()
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	v := decoded.Vec3()
+	if math.Abs(v.X-perTick.X) > 1e-4 || math.Abs(v.Y-perTick.Y) > 1e-4 {
+		t.Errorf("round trip = %+v, want ~%+v", v, perTick)
+	}
+}
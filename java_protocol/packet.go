@@ -52,6 +52,23 @@ const (
 	StatePlay
 )
 
+func (s State) String() string {
+	switch s {
+	case StateHandshake:
+		return "handshake"
+	case StateStatus:
+		return "status"
+	case StateLogin:
+		return "login"
+	case StateConfiguration:
+		return "configuration"
+	case StatePlay:
+		return "play"
+	default:
+		return fmt.Sprintf("State(%d)", uint8(s))
+	}
+}
+
 // Bound is the direction that the packet is going.
 //
 // Serverbound: Client -> Server (C2S)
@@ -66,6 +83,17 @@ const (
 	S2C
 )
 
+func (b Bound) String() string {
+	switch b {
+	case C2S:
+		return "c2s"
+	case S2C:
+		return "s2c"
+	default:
+		return fmt.Sprintf("Bound(%d)", uint8(b))
+	}
+}
+
 // WirePacket represents the raw packet as it appears on the wire.
 // It contains only wire-level data without typed field information.
 type WirePacket struct {
@@ -88,28 +116,70 @@ func (w *WirePacket) Clone() *WirePacket {
 	}
 }
 
+// MaxPacketLength is the largest allowed Packet Length: 2^21 - 1, the maximum
+// value of a 3-byte VarInt.
+const MaxPacketLength = 1<<21 - 1
+
+// MaxDataLength is the largest allowed Data Length (uncompressed size of Packet ID +
+// Data) of a compressed packet: 2^23, as enforced by the vanilla server.
+const MaxDataLength = 1 << 23
+
 // ReadWirePacketFrom reads a WirePacket from the given reader.
 // Handles both compressed and uncompressed packet formats based on compressionThreshold.
 // Use compressionThreshold < 0 to disable compression.
+//
+// The framing is validated like the vanilla server does: the Packet Length must
+// fit in 3 bytes (so at most MaxPacketLength), and a compressed packet must declare a Data
+// Length of at least the threshold (and at most MaxDataLength) that matches its
+// inflated size. Like vanilla, a padded Packet Length (e.g. [0x85, 0x80, 0x00]
+// for 5, as written by proxies that patch the length in afterwards) is accepted
+// as long as it fits in 3 bytes. A stream that ends before the first byte returns
+// io.EOF, one that ends mid-packet io.ErrUnexpectedEOF (both wrapped).
 func ReadWirePacketFrom(r io.Reader, compressionThreshold int) (*WirePacket, error) {
-	packetLength, err := ns.DecodeVarInt(r)
+	packetLength, err := readPacketLength(r)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read packet length: %w", err)
 	}
+	if packetLength == 0 {
+		return nil, fmt.Errorf("empty packet: missing packet ID")
+	}
 
 	data := make([]byte, packetLength)
 	if _, err := io.ReadFull(r, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
 		return nil, fmt.Errorf("failed to read packet data: %w", err)
 	}
 
 	reader := bytes.NewReader(data)
 
 	if compressionThreshold >= 0 {
-		return readCompressedPacket(reader, packetLength)
+		return readCompressedPacket(reader, packetLength, compressionThreshold)
 	}
 	return readUncompressedPacket(reader, packetLength)
 }
 
+// readPacketLength reads the Packet Length VarInt, which may be at most 3 bytes long
+// and thus never exceeds MaxPacketLength.
+func readPacketLength(r io.Reader) (ns.VarInt, error) {
+	var value int32
+	var b [1]byte
+	for i := range 3 {
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			if err == io.EOF && i > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		value |= int32(b[0]&0x7F) << (7 * i)
+		if b[0]&0x80 == 0 {
+			return ns.VarInt(value), nil
+		}
+	}
+	return 0, fmt.Errorf("packet length is longer than 3 bytes")
+}
+
 func readUncompressedPacket(reader *bytes.Reader, length ns.VarInt) (*WirePacket, error) {
 	packetID, err := ns.DecodeVarInt(reader)
 	if err != nil {
@@ -128,7 +198,7 @@ func readUncompressedPacket(reader *bytes.Reader, length ns.VarInt) (*WirePacket
 	}, nil
 }
 
-func readCompressedPacket(reader *bytes.Reader, length ns.VarInt) (*WirePacket, error) {
+func readCompressedPacket(reader *bytes.Reader, length ns.VarInt, compressionThreshold int) (*WirePacket, error) {
 	dataLength, err := ns.DecodeVarInt(reader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read data length: %w", err)
@@ -138,13 +208,19 @@ func readCompressedPacket(reader *bytes.Reader, length ns.VarInt) (*WirePacket,
 	if dataLength == 0 {
 		return readUncompressedPacket(reader, length)
 	}
+	if dataLength < 0 || dataLength > MaxDataLength {
+		return nil, fmt.Errorf("invalid data length: %d (max %d)", dataLength, MaxDataLength)
+	}
+	if int(dataLength) < compressionThreshold {
+		return nil, fmt.Errorf("compressed packet data length %d is below the threshold of %d", dataLength, compressionThreshold)
+	}
 
 	// uncompress
 	compressedData, err := io.ReadAll(reader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read compressed data: %w", err)
 	}
-	uncompressedData, err := decompressZlib(compressedData)
+	uncompressedData, err := decompressZlib(compressedData, int(dataLength))
 	if err != nil {
 		return nil, fmt.Errorf("failed to decompress: %w", err)
 	}
@@ -194,14 +270,20 @@ func (w *WirePacket) WriteTo(writer io.Writer, compressionThreshold int) error {
 }
 
 // ReadInto deserializes the wire packet's raw data into a typed Packet.
-// Returns an error if the packet ID doesn't match.
-func (w *WirePacket) ReadInto(p Packet) error {
+// Returns an error if the packet ID doesn't match. A panic in the packet's Read
+// (e.g. on malformed data) is returned as an error as well.
+func (w *WirePacket) ReadInto(p Packet) (err error) {
 	if w == nil {
 		return fmt.Errorf("nil wire packet")
 	}
 	if w.PacketID != p.ID() {
 		return fmt.Errorf("packet ID mismatch: expected 0x%02X, got 0x%02X", p.ID(), w.PacketID)
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while reading packet 0x%02X: %v", w.PacketID, r)
+		}
+	}()
 	buf := ns.NewReader(w.Data)
 	return p.Read(buf)
 }
@@ -225,6 +307,34 @@ func ReadPacket[T any, PT interface {
 	return pt, nil
 }
 
+// ReadPacketFrom reads one framed packet from r and deserializes it into a typed
+// Packet. It is ReadWirePacketFrom followed by ReadPacket, so any stream (a
+// net.Conn, a capture file) can be read without handling WirePackets.
+//
+// Example:
+//
+//	login, err := ReadPacketFrom[LoginSuccessPacket](conn, threshold)
+func ReadPacketFrom[T any, PT interface {
+	*T
+	Packet
+}](r io.Reader, compressionThreshold int) (PT, error) {
+	wire, err := ReadWirePacketFrom(r, compressionThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return ReadPacket[T, PT](wire)
+}
+
+// WritePacketTo serializes a typed Packet and writes it to w as a single framed
+// packet, compressed according to compressionThreshold (see WirePacket.WriteTo).
+func WritePacketTo(w io.Writer, p Packet, compressionThreshold int) error {
+	wire, err := ToWire(p)
+	if err != nil {
+		return err
+	}
+	return wire.WriteTo(w, compressionThreshold)
+}
+
 // ToWire converts a typed Packet to a WirePacket by serializing its data.
 // The resulting WirePacket can then be written to a connection via WriteTo()
 // or converted to bytes via ToBytes().
@@ -256,42 +366,34 @@ func ToWire(p Packet) (*WirePacket, error) {
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#With_compression
 func (w *WirePacket) toBytesCompressed(compressionThreshold int) ([]byte, error) {
-	packetIDBytes, err := w.PacketID.ToBytes()
-	if err != nil {
-		return nil, err
-	}
-	uncompressedPayload := append(packetIDBytes, w.Data...)
-	uncompressedLength := len(uncompressedPayload)
+	uncompressedLength := w.PacketID.Len() + len(w.Data)
 
 	if uncompressedLength >= compressionThreshold {
-		// Compress the payload
-		compressedPayload := compressZlib(uncompressedPayload)
-
-		dataLengthBytes, err := ns.VarInt(uncompressedLength).ToBytes()
-		if err != nil {
-			return nil, err
+		// deflate behind room for the largest possible header, then fill the
+		// header in right-aligned so the payload never has to be moved
+		dataLength := ns.VarInt(uncompressedLength)
+		headerRoom := maxPacketLengthSize + dataLength.Len()
+		out := compressZlib(headerRoom, w.PacketID.Append(nil), w.Data)
+
+		contentLength := dataLength.Len() + len(out) - headerRoom
+		if contentLength > MaxPacketLength {
+			return nil, fmt.Errorf("packet too large: %d bytes (max %d)", contentLength, MaxPacketLength)
 		}
-		packetContent := append(dataLengthBytes, compressedPayload...)
-		packetLengthBytes, err := ns.VarInt(len(packetContent)).ToBytes()
-		if err != nil {
-			return nil, err
-		}
-
-		return append(packetLengthBytes, packetContent...), nil
+		start := headerRoom - dataLength.Len() - ns.VarInt(contentLength).Len()
+		dataLength.Append(ns.VarInt(contentLength).Append(out[start:start]))
+		return out[start:], nil
 	}
 
-	// Uncompressed (below threshold)
-	dataLengthBytes, err := ns.VarInt(0).ToBytes()
-	if err != nil {
-		return nil, err
-	}
-	packetContent := append(dataLengthBytes, uncompressedPayload...)
-	packetLengthBytes, err := ns.VarInt(len(packetContent)).ToBytes()
-	if err != nil {
-		return nil, err
+	// Uncompressed (below threshold): Data Length is 0
+	contentLength := 1 + uncompressedLength
+	if contentLength > MaxPacketLength {
+		return nil, fmt.Errorf("packet too large: %d bytes (max %d)", contentLength, MaxPacketLength)
 	}
-
-	return append(packetLengthBytes, packetContent...), nil
+	out := make([]byte, 0, ns.VarInt(contentLength).Len()+contentLength)
+	out = ns.VarInt(contentLength).Append(out)
+	out = ns.VarInt(0).Append(out)
+	out = w.PacketID.Append(out)
+	return append(out, w.Data...), nil
 }
 
 // toBytesUncompressed serializes without compression.
@@ -304,34 +406,47 @@ func (w *WirePacket) toBytesCompressed(compressionThreshold int) ([]byte, error)
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Without_compression
 func (w *WirePacket) toBytesUncompressed() ([]byte, error) {
-	packetIDBytes, err := w.PacketID.ToBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	payload := append(packetIDBytes, w.Data...)
-	packetLengthBytes, err := ns.VarInt(len(payload)).ToBytes()
-	if err != nil {
-		return nil, err
+	length := w.PacketID.Len() + len(w.Data)
+	if length > MaxPacketLength {
+		return nil, fmt.Errorf("packet too large: %d bytes (max %d)", length, MaxPacketLength)
 	}
-
-	return append(packetLengthBytes, payload...), nil
+	out := make([]byte, 0, ns.VarInt(length).Len()+length)
+	out = ns.VarInt(length).Append(out)
+	out = w.PacketID.Append(out)
+	return append(out, w.Data...), nil
 }
 
-func compressZlib(data []byte) []byte {
-	compressedData := bytes.NewBuffer(nil)
+// maxPacketLengthSize is the encoded size of MaxPacketLength, the largest
+// Packet Length VarInt.
+const maxPacketLengthSize = 3
+
+// compressZlib deflates the concatenation of parts into a buffer that starts
+// with headerRoom reserved (zero) bytes.
+func compressZlib(headerRoom int, parts ...[]byte) []byte {
+	compressedData := bytes.NewBuffer(make([]byte, headerRoom, headerRoom+64))
 	writer := zlib.NewWriter(compressedData)
-	_, _ = writer.Write(data)
+	for _, part := range parts {
+		_, _ = writer.Write(part)
+	}
 	_ = writer.Close()
 	return compressedData.Bytes()
 }
 
-func decompressZlib(data []byte) ([]byte, error) {
+// decompressZlib inflates data, which must decompress to exactly size bytes.
+func decompressZlib(data []byte, size int) ([]byte, error) {
 	reader, err := zlib.NewReader(bytes.NewReader(data))
 	if err != nil {
 		return nil, err
 	}
 	defer reader.Close()
 
-	return io.ReadAll(reader)
+	// read one byte past size to detect oversized payloads without inflating them fully
+	out, err := io.ReadAll(io.LimitReader(reader, int64(size)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(out) != size {
+		return nil, fmt.Errorf("decompressed length mismatch: declared %d, got %d", size, len(out))
+	}
+	return out, nil
 }
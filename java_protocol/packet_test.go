@@ -0,0 +1,210 @@
+package java_protocol_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	jp "github.com/go-mclib/protocol/java_protocol"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// frame builds a packet from raw fields: each part is either a VarInt or raw bytes.
+func frame(parts ...any) []byte {
+	var body bytes.Buffer
+	for _, p := range parts {
+		switch v := p.(type) {
+		case int:
+			ns.VarInt(v).Encode(&body)
+		case []byte:
+			body.Write(v)
+		}
+	}
+	var out bytes.Buffer
+	ns.VarInt(body.Len()).Encode(&out)
+	out.Write(body.Bytes())
+	return out.Bytes()
+}
+
+func zlibBytes(data []byte) []byte {
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	w.Write(data)
+	w.Close()
+	return buf.Bytes()
+}
+
+func TestReadWirePacketFrom_RoundTrip(t *testing.T) {
+	for _, threshold := range []int{-1, 0, 64, 1 << 20} {
+		wire := &jp.WirePacket{PacketID: 0x2C, Data: bytes.Repeat([]byte{0xAB}, 300)}
+		var buf bytes.Buffer
+		if err := wire.WriteTo(&buf, threshold); err != nil {
+			t.Fatalf("threshold %d: write error: %v", threshold, err)
+		}
+		got, err := jp.ReadWirePacketFrom(&buf, threshold)
+		if err != nil {
+			t.Fatalf("threshold %d: read error: %v", threshold, err)
+		}
+		if got.PacketID != wire.PacketID || !bytes.Equal(got.Data, wire.Data) {
+			t.Errorf("threshold %d: got id=0x%02X len=%d", threshold, got.PacketID, len(got.Data))
+		}
+		if buf.Len() != 0 {
+			t.Errorf("threshold %d: %d bytes left over", threshold, buf.Len())
+		}
+	}
+}
+
+func TestReadWirePacketFrom_Truncated(t *testing.T) {
+	if _, err := jp.ReadWirePacketFrom(bytes.NewReader(nil), -1); !errors.Is(err, io.EOF) {
+		t.Errorf("empty stream: expected io.EOF, got %v", err)
+	}
+
+	full := frame(0x01, []byte("hello"))
+	for _, n := range []int{1, 3} {
+		_, err := jp.ReadWirePacketFrom(bytes.NewReader(full[:n]), -1)
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("truncated at %d bytes: expected io.ErrUnexpectedEOF, got %v", n, err)
+		}
+	}
+
+	// length VarInt cut off after its first byte
+	if _, err := jp.ReadWirePacketFrom(bytes.NewReader([]byte{0x80}), -1); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("truncated length: expected io.ErrUnexpectedEOF, got %v", err)
+	}
+}
+
+func TestReadWirePacketFrom_PaddedLength(t *testing.T) {
+	// length 6 padded to 3 bytes, as proxies write it
+	data := append([]byte{0x86, 0x80, 0x00, 0x01}, "hello"...)
+	wire, err := jp.ReadWirePacketFrom(bytes.NewReader(data), -1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if wire.Length != 6 || wire.PacketID != 0x01 || string(wire.Data) != "hello" {
+		t.Errorf("got length=%d id=%d data=%q", wire.Length, wire.PacketID, wire.Data)
+	}
+}
+
+func TestReadWirePacketFrom_Invalid(t *testing.T) {
+	payload := bytes.Repeat([]byte{0x07}, 100)
+	tests := []struct {
+		name      string
+		data      []byte
+		threshold int
+		want      string
+	}{
+		{"zero length", []byte{0x00}, -1, "empty packet"},
+		{"length over 3 bytes", []byte{0x80, 0x80, 0x80, 0x01}, -1, "longer than 3 bytes"},
+		{"data length too large", frame(1<<23+1, []byte{0x00}), 0, "invalid data length"},
+		{"below threshold", frame(50, zlibBytes(payload[:50])), 64, "below the threshold of 64"},
+		{"length mismatch", frame(99, zlibBytes(payload)), 64, "decompressed length mismatch: declared 99"},
+		{"oversized payload", frame(100, zlibBytes(append(payload, 0x01))), 64, "decompressed length mismatch"},
+		{"not zlib", frame(100, []byte{0x01, 0x02}), 64, "failed to decompress"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := jp.ReadWirePacketFrom(bytes.NewReader(tt.data), tt.threshold)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected error containing %q, got: %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestWirePacket_WriteTo_TooLarge(t *testing.T) {
+	wire := &jp.WirePacket{PacketID: 0x01, Data: make([]byte, jp.MaxPacketLength)}
+	if err := wire.WriteTo(io.Discard, -1); err == nil || !strings.Contains(err.Error(), "packet too large") {
+		t.Fatalf("expected packet too large error, got: %v", err)
+	}
+	// compresses well below the limit
+	if err := wire.WriteTo(io.Discard, 256); err != nil {
+		t.Fatalf("compressed write error: %v", err)
+	}
+}
+
+// indexingPacket reads a length-prefixed payload by indexing without bounds
+// checks, like a hand-written Read that trusts its input.
+type indexingPacket struct {
+	Payload []byte
+}
+
+func (p *indexingPacket) ID() ns.VarInt                    { return 0x05 }
+func (p *indexingPacket) State() jp.State                  { return jp.StatePlay }
+func (p *indexingPacket) Bound() jp.Bound                  { return jp.S2C }
+func (p *indexingPacket) Write(buf *ns.PacketBuffer) error { return nil }
+func (p *indexingPacket) Read(buf *ns.PacketBuffer) error {
+	data, err := io.ReadAll(buf.Reader())
+	if err != nil {
+		return err
+	}
+	p.Payload = p.Payload[:0]
+	for i := range int(data[0]) {
+		p.Payload = append(p.Payload, data[1+i])
+	}
+	return nil
+}
+
+func TestWirePacket_ReadInto_RecoversPanic(t *testing.T) {
+	var p indexingPacket
+	if err := (&jp.WirePacket{PacketID: 0x05, Data: []byte{2, 'o', 'k'}}).ReadInto(&p); err != nil || string(p.Payload) != "ok" {
+		t.Fatalf("ReadInto() = %q, %v", p.Payload, err)
+	}
+
+	err := (&jp.WirePacket{PacketID: 0x05, Data: []byte{9, 'o', 'k'}}).ReadInto(&p)
+	if err == nil || !strings.Contains(err.Error(), "panic while reading packet 0x05") {
+		t.Fatalf("expected recovered panic error, got: %v", err)
+	}
+}
+
+func TestWritePacketTo_ReadPacketFrom(t *testing.T) {
+	for _, threshold := range []int{-1, 0, 256} {
+		in := &benchPacket{X: 1.5, Y: 64, Z: -3, OnGround: true, Payload: bytes.Repeat([]byte{0x01}, 300)}
+		var buf bytes.Buffer
+		if err := jp.WritePacketTo(&buf, in, threshold); err != nil {
+			t.Fatalf("threshold %d: write error: %v", threshold, err)
+		}
+		out, err := jp.ReadPacketFrom[benchPacket](&buf, threshold)
+		if err != nil {
+			t.Fatalf("threshold %d: read error: %v", threshold, err)
+		}
+		if out.X != in.X || out.Z != in.Z || out.OnGround != in.OnGround || !bytes.Equal(out.Payload, in.Payload) {
+			t.Errorf("threshold %d: got %+v", threshold, out)
+		}
+	}
+
+	if _, err := jp.ReadPacketFrom[benchPacket](bytes.NewReader(nil), -1); !errors.Is(err, io.EOF) {
+		t.Errorf("expected io.EOF, got: %v", err)
+	}
+	if _, err := jp.ReadPacketFrom[benchPacket](bytes.NewReader(frame(0x05)), -1); err == nil || !strings.Contains(err.Error(), "packet ID mismatch") {
+		t.Errorf("expected packet ID mismatch, got: %v", err)
+	}
+}
+
+func TestWirePacket_WriteTo_LengthSizes(t *testing.T) {
+	// incompressible payloads whose Packet Length needs 1, 2 and 3 bytes
+	rng := rand.New(rand.NewPCG(1, 2))
+	for _, size := range []int{0, 100, 127, 128, 16383, 16384, 100_000} {
+		data := make([]byte, size)
+		for i := range data {
+			data[i] = byte(rng.Uint32())
+		}
+		for _, threshold := range []int{-1, 0, 256} {
+			wire := &jp.WirePacket{PacketID: 0x7F, Data: data}
+			var buf bytes.Buffer
+			if err := wire.WriteTo(&buf, threshold); err != nil {
+				t.Fatalf("size %d, threshold %d: write error: %v", size, threshold, err)
+			}
+			got, err := jp.ReadWirePacketFrom(&buf, threshold)
+			if err != nil {
+				t.Fatalf("size %d, threshold %d: read error: %v", size, threshold, err)
+			}
+			if got.PacketID != wire.PacketID || !bytes.Equal(got.Data, data) || buf.Len() != 0 {
+				t.Errorf("size %d, threshold %d: round trip mismatch", size, threshold)
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package registries
+
+import (
+	"slices"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+)
+
+// Registry keys of the registries with a typed model.
+const (
+	DimensionTypeKey   ns.Identifier = "minecraft:dimension_type"
+	BiomeKey           ns.Identifier = "minecraft:worldgen/biome"
+	ChatTypeKey        ns.Identifier = "minecraft:chat_type"
+	DamageTypeKey      ns.Identifier = "minecraft:damage_type"
+	BannerPatternKey   ns.Identifier = "minecraft:banner_pattern"
+	WolfVariantKey     ns.Identifier = "minecraft:wolf_variant"
+	PaintingVariantKey ns.Identifier = "minecraft:painting_variant"
+)
+
+// Registries is a snapshot of the registries a server sent during
+// configuration. Registries without a typed model (trim materials, enchantments,
+// the other mob variants, ...) are kept as raw NBT in Other.
+type Registries struct {
+	DimensionTypes   *Registry[DimensionType]
+	Biomes           *Registry[Biome]
+	ChatTypes        *Registry[ns.ChatType]
+	DamageTypes      *Registry[DamageType]
+	BannerPatterns   *Registry[ns.BannerPattern]
+	WolfVariants     *Registry[WolfVariant]
+	PaintingVariants *Registry[ns.PaintingVariant]
+	Other            map[ns.Identifier]*Registry[nbt.Tag]
+}
+
+// New creates an empty snapshot.
+func New() *Registries {
+	return &Registries{
+		DimensionTypes:   NewRegistry(DimensionTypeKey, Unmarshal[DimensionType]),
+		Biomes:           NewRegistry(BiomeKey, Unmarshal[Biome]),
+		ChatTypes:        NewRegistry(ChatTypeKey, Unmarshal[ns.ChatType]),
+		DamageTypes:      NewRegistry(DamageTypeKey, Unmarshal[DamageType]),
+		BannerPatterns:   NewRegistry(BannerPatternKey, Unmarshal[ns.BannerPattern]),
+		WolfVariants:     NewRegistry(WolfVariantKey, Unmarshal[WolfVariant]),
+		PaintingVariants: NewRegistry(PaintingVariantKey, Unmarshal[ns.PaintingVariant]),
+		Other:            make(map[ns.Identifier]*Registry[nbt.Tag]),
+	}
+}
+
+// loader is the part of Registry that does not depend on the entry type.
+type loader interface {
+	Key() ns.Identifier
+	Len() int
+	Load(entries []Entry) error
+	SetTags(tags map[ns.Identifier][]int32)
+}
+
+func (r *Registries) typed() []loader {
+	return []loader{
+		r.DimensionTypes, r.Biomes, r.ChatTypes, r.DamageTypes,
+		r.BannerPatterns, r.WolfVariants, r.PaintingVariants,
+	}
+}
+
+func (r *Registries) registry(key ns.Identifier, create bool) loader {
+	key = normalize(key)
+	for _, reg := range r.typed() {
+		if reg.Key() == key {
+			return reg
+		}
+	}
+	reg, ok := r.Other[key]
+	if !ok {
+		if !create {
+			return nil
+		}
+		reg = NewRegistry(key, Raw)
+		r.Other[key] = reg
+	}
+	return reg
+}
+
+// Add loads the entries of one Registry Data packet into the registry named
+// key, replacing what was there.
+func (r *Registries) Add(key ns.Identifier, entries []Entry) error {
+	return r.registry(key, true).Load(entries)
+}
+
+// SetTags sets the tags of a registry from an Update Tags packet. Tags of
+// registries that were not sent (e.g. minecraft:block, which is not
+// synchronized) are ignored; they are usually resolved against go-mclib/data.
+func (r *Registries) SetTags(key ns.Identifier, tags map[ns.Identifier][]int32) {
+	if reg := r.registry(key, false); reg != nil {
+		reg.SetTags(tags)
+	}
+}
+
+// Keys returns the keys of the registries that have been loaded, sorted.
+func (r *Registries) Keys() []ns.Identifier {
+	var keys []ns.Identifier
+	for _, reg := range r.typed() {
+		if reg.Len() > 0 {
+			keys = append(keys, reg.Key())
+		}
+	}
+	for key := range r.Other {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// ChatTypeList returns the chat types in network ID order, as needed by
+// ChatTypeBound.Format.
+func (r *Registries) ChatTypeList() []ns.ChatType {
+	return r.ChatTypes.values
+}
@@ -0,0 +1,243 @@
+package registries_test
+
+import (
+	"reflect"
+	"testing"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/java_protocol/registries"
+	"github.com/go-mclib/protocol/nbt"
+)
+
+func mustParse(t *testing.T, snbt string) nbt.Tag {
+	t.Helper()
+	tag, err := nbt.Parse(snbt)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", snbt, err)
+	}
+	return tag
+}
+
+func TestEntry_RoundTrip(t *testing.T) {
+	for _, in := range []registries.Entry{
+		{ID: "minecraft:overworld", Data: mustParse(t, `{min_y:-64,height:384}`)},
+		{ID: "minecraft:the_nether"},
+	} {
+		buf := ns.NewWriter()
+		if err := registries.WriteRegistryEntry(buf, in); err != nil {
+			t.Fatalf("Encode: %v", err)
+		}
+		out, err := registries.ReadRegistryEntry(ns.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatalf("Decode: %v", err)
+		}
+		if !reflect.DeepEqual(out, in) {
+			t.Errorf("round trip = %+v, want %+v", out, in)
+		}
+	}
+}
+
+func TestRegistries_Typed(t *testing.T) {
+	r := registries.New()
+	err := r.Add("minecraft:dimension_type", []registries.Entry{
+		{ID: "minecraft:overworld", Data: mustParse(t, `{min_y:-64,height:384,logical_height:384,has_skylight:1b,`+
+			`has_ceiling:0b,coordinate_scale:1.0d,ambient_light:0.0f,infiniburn:"#minecraft:infiniburn_overworld",`+
+			`monster_spawn_light_level:{type:"minecraft:uniform",min_inclusive:0,max_inclusive:7}}`)},
+		{ID: "minecraft:the_nether", Data: mustParse(t, `{min_y:0,height:256,fixed_time:18000L,has_ceiling:1b,coordinate_scale:8.0d}`)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	overworld, ok := r.DimensionTypes.Lookup("overworld")
+	if !ok || !overworld.HasSkylight || overworld.Infiniburn != "#minecraft:infiniburn_overworld" {
+		t.Errorf("overworld = %+v, %v", overworld, ok)
+	}
+	if h := overworld.DimensionHeight(); h.MinY != -64 || h.Sections() != 24 {
+		t.Errorf("DimensionHeight() = %+v", h)
+	}
+	nether, ok := r.DimensionTypes.Get(1)
+	if !ok || nether.FixedTime == nil || *nether.FixedTime != 18000 || nether.CoordinateScale != 8 {
+		t.Errorf("nether = %+v, %v", nether, ok)
+	}
+	if id, ok := r.DimensionTypes.ID("minecraft:the_nether"); !ok || id != 1 {
+		t.Errorf("ID(the_nether) = %d, %v", id, ok)
+	}
+	if name, ok := r.DimensionTypes.Name(0); !ok || name != "minecraft:overworld" {
+		t.Errorf("Name(0) = %q, %v", name, ok)
+	}
+	if _, ok := r.DimensionTypes.Get(2); ok {
+		t.Error("Get(2) should fail")
+	}
+
+	err = r.Add("minecraft:worldgen/biome", []registries.Entry{
+		{ID: "minecraft:plains", Data: mustParse(t, `{has_precipitation:1b,temperature:0.8f,downfall:0.4f,`+
+			`effects:{fog_color:12638463,sky_color:7907327,water_color:4159204,water_fog_color:329011}}`)},
+		{ID: "minecraft:swamp", Data: mustParse(t, `{has_precipitation:1b,temperature:0.8f,downfall:0.9f,`+
+			`effects:{fog_color:12638463,sky_color:7907327,water_color:6388580,water_fog_color:2302743,`+
+			`foliage_color:6975545,grass_color_modifier:"swamp"}}`)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	swamp, _ := r.Biomes.Lookup("swamp")
+	if swamp.Effects.FoliageColor == nil || *swamp.Effects.FoliageColor != 6975545 ||
+		swamp.Effects.GrassColor != nil || swamp.Effects.GrassColorModifier != "swamp" {
+		t.Errorf("swamp = %+v", swamp.Effects)
+	}
+
+	err = r.Add("minecraft:painting_variant", []registries.Entry{
+		{ID: "minecraft:kebab", Data: mustParse(t, `{asset_id:"minecraft:kebab",width:1,height:1,`+
+			`title:{translate:"painting.minecraft.kebab.title",color:"yellow"},author:{translate:"painting.minecraft.kebab.author",color:"gray"}}`)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kebab, _ := r.PaintingVariants.Get(0)
+	title, hasTitle := kebab.Title.Get()
+	author, _ := kebab.Author.Get()
+	if !hasTitle || title.Translate != "painting.minecraft.kebab.title" || author.Color != "gray" || kebab.Width != 1 {
+		t.Errorf("kebab = %+v", kebab)
+	}
+}
+
+func TestRegistries_ResolveComponents(t *testing.T) {
+	r := registries.New()
+	err := r.Add("painting_variant", []registries.Entry{
+		{ID: "minecraft:alban", Data: mustParse(t, `{asset_id:"minecraft:alban",width:1,height:1}`)},
+		{ID: "minecraft:sunset", Data: mustParse(t, `{asset_id:"minecraft:sunset",width:2,height:1,title:"Sunset"}`)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.Add("banner_pattern", []registries.Entry{
+		{ID: "minecraft:stripe_top", Data: mustParse(t, `{asset_id:"minecraft:stripe_top",translation_key:"block.minecraft.banner.stripe_top"}`)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// components as decoded from a slot, referencing the registries by ID
+	buf := ns.NewWriter()
+	if err := (&ns.PaintingVariantComponent{Variant: ns.NewIDRef[ns.PaintingVariant](1)}).Encode(buf); err != nil {
+		t.Fatal(err)
+	}
+	var painting ns.PaintingVariantComponent
+	if err := painting.Decode(ns.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	sunset, err := r.PaintingVariants.Resolve(painting.Variant)
+	if title, _ := sunset.Title.Get(); err != nil || sunset.AssetID != "minecraft:sunset" || sunset.Width != 2 || title.Text != "Sunset" {
+		t.Errorf("Resolve(painting) = %+v, %v", sunset, err)
+	}
+
+	buf = ns.NewWriter()
+	layers := &ns.BannerPatterns{Layers: []ns.BannerLayer{{Pattern: ns.NewIDRef[ns.BannerPattern](0), Color: ns.DyeRed}}}
+	if err := layers.Encode(buf); err != nil {
+		t.Fatal(err)
+	}
+	var banner ns.BannerPatterns
+	if err := banner.Decode(ns.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	pattern, err := r.BannerPatterns.Resolve(banner.Layers[0].Pattern)
+	if err != nil || pattern.TranslationKey != "block.minecraft.banner.stripe_top" {
+		t.Errorf("Resolve(banner layer) = %+v, %v", pattern, err)
+	}
+
+	// inline values resolve to themselves
+	inline := ns.NewInlineValue(ns.PaintingVariant{AssetID: "example:custom", Width: 3, Height: 3})
+	if v, err := r.PaintingVariants.Resolve(inline); err != nil || v.AssetID != "example:custom" {
+		t.Errorf("Resolve(inline) = %+v, %v", v, err)
+	}
+}
+
+func TestRegistries_ChatTypes(t *testing.T) {
+	r := registries.New()
+	err := r.Add("chat_type", []registries.Entry{{
+		ID: "minecraft:chat",
+		Data: mustParse(t, `{chat:{translation_key:"chat.type.text",parameters:["sender","content"]},`+
+			`narration:{translation_key:"chat.type.text.narrate",parameters:["sender","content"]}}`),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bound := ns.ChatTypeBound{ChatType: ns.NewIDRef[ns.ChatType](0), Name: ns.NewTextComponent("Steve")}
+	tc, err := bound.Format(r.ChatTypeList(), ns.NewTextComponent("hi"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tc.Render(func(string) string { return "<%s> %s" }); got != "<Steve> hi" {
+		t.Errorf("Render() = %q", got)
+	}
+
+	ct, err := r.ChatTypes.Resolve(bound.ChatType)
+	if err != nil || ct.Narration.TranslationKey != "chat.type.text.narrate" {
+		t.Errorf("Resolve() = %+v, %v", ct, err)
+	}
+	if _, err := r.ChatTypes.Resolve(ns.NewIDRef[ns.ChatType](5)); err == nil {
+		t.Error("expected error for unknown ID")
+	}
+}
+
+func TestRegistries_OtherAndKnownPacks(t *testing.T) {
+	r := registries.New()
+	err := r.Add("minecraft:trim_material", []registries.Entry{
+		{ID: "minecraft:amethyst"},
+		{ID: "custom:ruby", Data: mustParse(t, `{asset_name:"ruby",description:"Ruby"}`)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	trims := r.Other["minecraft:trim_material"]
+	if trims == nil || trims.Len() != 2 {
+		t.Fatalf("trim_material = %+v", trims)
+	}
+	if trims.HasData(0) || !trims.HasData(1) {
+		t.Error("HasData mismatch: only the custom entry was sent with data")
+	}
+	ruby, ok := trims.Lookup("custom:ruby")
+	if c, isCompound := ruby.(nbt.Compound); !ok || !isCompound || c.GetString("asset_name") != "ruby" {
+		t.Errorf("ruby = %v", ruby)
+	}
+
+	r.SetTags("minecraft:trim_material", map[ns.Identifier][]int32{"custom:gems": {1}})
+	r.SetTags("minecraft:block", map[ns.Identifier][]int32{"minecraft:logs": {1, 2}})
+
+	if ids, err := trims.ResolveSet(*ns.NewTagIDSet("custom:gems")); err != nil || !reflect.DeepEqual(ids, []int32{1}) {
+		t.Errorf("ResolveSet(tag) = %v, %v", ids, err)
+	}
+	if ids, err := trims.ResolveSet(*ns.NewInlineIDSet([]ns.VarInt{0, 1})); err != nil || !reflect.DeepEqual(ids, []int32{0, 1}) {
+		t.Errorf("ResolveSet(inline) = %v, %v", ids, err)
+	}
+	if _, err := trims.ResolveSet(*ns.NewInlineIDSet([]ns.VarInt{2})); err == nil {
+		t.Error("expected error for out of range ID")
+	}
+	if _, err := trims.ResolveSet(*ns.NewTagIDSet("minecraft:unknown")); err == nil {
+		t.Error("expected error for unknown tag")
+	}
+
+	want := []ns.Identifier{"minecraft:trim_material"}
+	if keys := r.Keys(); !reflect.DeepEqual(keys, want) {
+		t.Errorf("Keys() = %v, want %v", keys, want)
+	}
+}
+
+func TestRegistries_Invalid(t *testing.T) {
+	r := registries.New()
+	err := r.Add("minecraft:damage_type", []registries.Entry{
+		{ID: "minecraft:fall", Data: mustParse(t, `{message_id:"fall",scaling:"when_caused_by_living_non_player",exhaustion:0.0f}`)},
+		{ID: "fall"},
+	})
+	if err == nil {
+		t.Error("expected error for duplicate entry")
+	}
+
+	err = r.Add("minecraft:banner_pattern", []registries.Entry{
+		{ID: "minecraft:base", Data: mustParse(t, `{asset_id:1}`)},
+	})
+	if err == nil {
+		t.Error("expected error for mistyped field")
+	}
+}
@@ -0,0 +1,264 @@
+// Package registries turns the Registry Data packets of the configuration
+// phase into typed, in-memory registries. Registry entries are referenced on
+// the network by their ID: the index of the entry in the order the server sent
+// it (e.g. the chat type of Player Chat, the biomes of a chunk, IDOrX and
+// IDSet fields), so the client has to keep these lists to make sense of them.
+//
+// See https://minecraft.wiki/w/Java_Edition_protocol/Registry_data
+package registries
+
+import (
+	"fmt"
+	"strings"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+)
+
+// Entry is one entry of a Registry Data packet.
+//
+// Wire format:
+//
+//	┌──────────────────────────┬──────────────────────────────────┐
+//	│  Entry ID (Identifier)   │  Data (Prefixed Optional NBT)    │
+//	└──────────────────────────┴──────────────────────────────────┘
+type Entry struct {
+	ID ns.Identifier
+	// Data is nil if the server expects the client to take the entry from a
+	// known pack (see Serverbound Known Packs) instead of sending it.
+	Data nbt.Tag
+}
+
+// Decode reads an Entry from the buffer.
+func (e *Entry) Decode(buf *ns.PacketBuffer) error {
+	id, err := buf.ReadIdentifier()
+	if err != nil {
+		return fmt.Errorf("failed to read registry entry id: %w", err)
+	}
+	e.ID, e.Data = id, nil
+	present, err := buf.ReadBool()
+	if err != nil {
+		return fmt.Errorf("failed to read registry entry data presence: %w", err)
+	}
+	if !present {
+		return nil
+	}
+	if e.Data, _, err = nbt.NewReaderFrom(buf.Reader()).ReadTag(true); err != nil {
+		return fmt.Errorf("failed to read registry entry data: %w", err)
+	}
+	return nil
+}
+
+// Encode writes an Entry to the buffer.
+func (e *Entry) Encode(buf *ns.PacketBuffer) error {
+	if err := buf.WriteIdentifier(e.ID); err != nil {
+		return fmt.Errorf("failed to write registry entry id: %w", err)
+	}
+	if err := buf.WriteBool(ns.Boolean(e.Data != nil)); err != nil {
+		return fmt.Errorf("failed to write registry entry data presence: %w", err)
+	}
+	if e.Data == nil {
+		return nil
+	}
+	data, err := nbt.EncodeNetwork(e.Data)
+	if err != nil {
+		return fmt.Errorf("failed to write registry entry data: %w", err)
+	}
+	_, err = buf.Write(data)
+	return err
+}
+
+// ReadRegistryEntry reads a registry data Entry.
+func ReadRegistryEntry(buf *ns.PacketBuffer) (Entry, error) {
+	var e Entry
+	err := e.Decode(buf)
+	return e, err
+}
+
+// WriteRegistryEntry writes a registry data Entry.
+func WriteRegistryEntry(buf *ns.PacketBuffer, e Entry) error {
+	return e.Encode(buf)
+}
+
+// Registry is a synchronized registry: its entries by network ID and by
+// identifier, decoded to T.
+type Registry[T any] struct {
+	key     ns.Identifier
+	names   []ns.Identifier
+	values  []T
+	raw     []nbt.Tag
+	byName  map[ns.Identifier]int32
+	tags    map[ns.Identifier][]int32
+	decoder func(nbt.Tag) (T, error)
+}
+
+// NewRegistry creates an empty registry named key (e.g. "minecraft:chat_type")
+// whose entry data is decoded with decode.
+func NewRegistry[T any](key ns.Identifier, decode func(nbt.Tag) (T, error)) *Registry[T] {
+	return &Registry[T]{
+		key:     normalize(key),
+		byName:  make(map[ns.Identifier]int32),
+		tags:    make(map[ns.Identifier][]int32),
+		decoder: decode,
+	}
+}
+
+// Unmarshal decodes entry data with nbt.UnmarshalTag, which is enough for
+// registries whose type has nbt struct tags.
+func Unmarshal[T any](tag nbt.Tag) (T, error) {
+	var v T
+	err := nbt.UnmarshalTag(tag, &v)
+	return v, err
+}
+
+// Raw keeps entry data as the NBT tag itself.
+func Raw(tag nbt.Tag) (nbt.Tag, error) {
+	return tag, nil
+}
+
+// Key returns the registry's identifier.
+func (r *Registry[T]) Key() ns.Identifier {
+	return r.key
+}
+
+// Len returns the number of entries.
+func (r *Registry[T]) Len() int {
+	return len(r.names)
+}
+
+// Load replaces the registry's entries with those of a Registry Data packet.
+// Entry IDs are assigned in order, starting at 0.
+func (r *Registry[T]) Load(entries []Entry) error {
+	names := make([]ns.Identifier, len(entries))
+	values := make([]T, len(entries))
+	raw := make([]nbt.Tag, len(entries))
+	byName := make(map[ns.Identifier]int32, len(entries))
+	for i, e := range entries {
+		name := normalize(e.ID)
+		if _, dup := byName[name]; dup {
+			return fmt.Errorf("%s: duplicate entry %s", r.key, name)
+		}
+		names[i], raw[i], byName[name] = name, e.Data, int32(i)
+		if e.Data == nil {
+			continue
+		}
+		v, err := r.decoder(e.Data)
+		if err != nil {
+			return fmt.Errorf("%s: failed to decode entry %s: %w", r.key, name, err)
+		}
+		values[i] = v
+	}
+	r.names, r.values, r.raw, r.byName = names, values, raw, byName
+	return nil
+}
+
+// Get returns the entry with the given network ID. Entries the server left to
+// a known pack (see HasData) are returned as the zero T.
+func (r *Registry[T]) Get(id int32) (T, bool) {
+	if id < 0 || int(id) >= len(r.values) {
+		var zero T
+		return zero, false
+	}
+	return r.values[id], true
+}
+
+// Lookup returns the entry with the given identifier. The "minecraft"
+// namespace may be omitted.
+func (r *Registry[T]) Lookup(name ns.Identifier) (T, bool) {
+	id, ok := r.ID(name)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return r.values[id], true
+}
+
+// ID returns the network ID of the entry with the given identifier.
+func (r *Registry[T]) ID(name ns.Identifier) (int32, bool) {
+	id, ok := r.byName[normalize(name)]
+	return id, ok
+}
+
+// Name returns the identifier of the entry with the given network ID.
+func (r *Registry[T]) Name(id int32) (ns.Identifier, bool) {
+	if id < 0 || int(id) >= len(r.names) {
+		return "", false
+	}
+	return r.names[id], true
+}
+
+// Names returns the identifiers of all entries, indexed by network ID.
+func (r *Registry[T]) Names() []ns.Identifier {
+	return r.names
+}
+
+// HasData reports whether the server sent data for the entry. If not, the
+// client is expected to know it from a shared pack (usually the vanilla one).
+func (r *Registry[T]) HasData(id int32) bool {
+	return id >= 0 && int(id) < len(r.raw) && r.raw[id] != nil
+}
+
+// RawData returns the entry's data as sent, or nil.
+func (r *Registry[T]) RawData(id int32) nbt.Tag {
+	if id < 0 || int(id) >= len(r.raw) {
+		return nil
+	}
+	return r.raw[id]
+}
+
+// Resolve returns the entry an IDOrX refers to: its inline value, or the
+// registry entry with its ID.
+func (r *Registry[T]) Resolve(x ns.IDOrX[T]) (T, error) {
+	id, value, inline := x.Get()
+	if inline {
+		return value, nil
+	}
+	v, ok := r.Get(int32(id))
+	if !ok {
+		return v, fmt.Errorf("%s: no entry with ID %d (%d entries)", r.key, id, len(r.values))
+	}
+	return v, nil
+}
+
+// SetTags replaces the registry's tags with those of an Update Tags packet:
+// tag name (without "#") to entry IDs.
+func (r *Registry[T]) SetTags(tags map[ns.Identifier][]int32) {
+	r.tags = make(map[ns.Identifier][]int32, len(tags))
+	for name, ids := range tags {
+		r.tags[normalize(name)] = ids
+	}
+}
+
+// Tag returns the entry IDs of a tag. A leading "#" is ignored.
+func (r *Registry[T]) Tag(name ns.Identifier) ([]int32, bool) {
+	ids, ok := r.tags[normalize(ns.Identifier(strings.TrimPrefix(string(name), "#")))]
+	return ids, ok
+}
+
+// ResolveSet returns the entry IDs an IDSet refers to: its inline IDs, or the
+// IDs of its tag (see SetTags).
+func (r *Registry[T]) ResolveSet(set ns.IDSet) ([]int32, error) {
+	if !set.IsTag {
+		ids := make([]int32, len(set.IDs))
+		for i, id := range set.IDs {
+			if id < 0 || int(id) >= len(r.names) {
+				return nil, fmt.Errorf("%s: no entry with ID %d (%d entries)", r.key, id, len(r.names))
+			}
+			ids[i] = int32(id)
+		}
+		return ids, nil
+	}
+	ids, ok := r.Tag(set.TagName)
+	if !ok {
+		return nil, fmt.Errorf("%s: unknown tag #%s", r.key, normalize(set.TagName))
+	}
+	return ids, nil
+}
+
+// normalize adds the default "minecraft" namespace to identifiers without one.
+func normalize(id ns.Identifier) ns.Identifier {
+	if id == "" || strings.ContainsRune(string(id), ':') {
+		return id
+	}
+	return "minecraft:" + id
+}
@@ -0,0 +1,81 @@
+package registries
+
+import (
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// Typed registry entries cover the fields the client needs to interpret
+// network data; fields a server does not send are left zero, and the full
+// entry is always available from Registry.RawData.
+
+// DimensionType is a minecraft:dimension_type entry.
+type DimensionType struct {
+	MinY            int32   `nbt:"min_y"`
+	Height          int32   `nbt:"height"`
+	LogicalHeight   int32   `nbt:"logical_height"`
+	HasSkylight     bool    `nbt:"has_skylight"`
+	HasCeiling      bool    `nbt:"has_ceiling"`
+	CoordinateScale float64 `nbt:"coordinate_scale"`
+	AmbientLight    float32 `nbt:"ambient_light"`
+	// FixedTime is set for dimensions where the time of day does not pass
+	// (e.g. 6000 in the nether).
+	FixedTime *int64 `nbt:"fixed_time"`
+	// Infiniburn is the block tag that burns forever, e.g. "#minecraft:infiniburn_overworld".
+	Infiniburn                  string `nbt:"infiniburn"`
+	MonsterSpawnBlockLightLimit int32  `nbt:"monster_spawn_block_light_limit"`
+}
+
+// DimensionHeight returns the vertical extent used to parse chunks of the
+// dimension (see net_structures.NewChunkColumn).
+func (d DimensionType) DimensionHeight() ns.DimensionHeight {
+	return ns.DimensionHeight{MinY: int(d.MinY), Height: int(d.Height)}
+}
+
+// Biome is a minecraft:worldgen/biome entry, as synchronized to clients.
+type Biome struct {
+	HasPrecipitation bool    `nbt:"has_precipitation"`
+	Temperature      float32 `nbt:"temperature"`
+	// TemperatureModifier is "none" (or empty) or "frozen".
+	TemperatureModifier string       `nbt:"temperature_modifier"`
+	Downfall            float32      `nbt:"downfall"`
+	Effects             BiomeEffects `nbt:"effects"`
+}
+
+// BiomeEffects are the colors of a biome, as 0xRRGGBB.
+type BiomeEffects struct {
+	FogColor      int32 `nbt:"fog_color"`
+	SkyColor      int32 `nbt:"sky_color"`
+	WaterColor    int32 `nbt:"water_color"`
+	WaterFogColor int32 `nbt:"water_fog_color"`
+	// FoliageColor and GrassColor override the colormap if set.
+	FoliageColor *int32 `nbt:"foliage_color"`
+	GrassColor   *int32 `nbt:"grass_color"`
+	// GrassColorModifier is "none" (or empty), "dark_forest" or "swamp".
+	GrassColorModifier string `nbt:"grass_color_modifier"`
+}
+
+// DamageType is a minecraft:damage_type entry.
+type DamageType struct {
+	// MessageID is the suffix of the death message translation key, e.g.
+	// "fall" for death.attack.fall.
+	MessageID string `nbt:"message_id"`
+	// Scaling is "never", "when_caused_by_living_non_player" or "always".
+	Scaling    string  `nbt:"scaling"`
+	Exhaustion float32 `nbt:"exhaustion"`
+	// Effects is the sound played on damage, e.g. "hurt" (default), "burning".
+	Effects string `nbt:"effects"`
+	// DeathMessageType is "default", "fall_variants" or "intentional_game_design".
+	DeathMessageType string `nbt:"death_message_type"`
+}
+
+// WolfVariant is a minecraft:wolf_variant entry: the textures of the wolf.
+type WolfVariant struct {
+	Assets WolfAssets `nbt:"assets"`
+}
+
+// WolfAssets are the texture identifiers of a wolf variant.
+type WolfAssets struct {
+	Wild  ns.Identifier `nbt:"wild"`
+	Tame  ns.Identifier `nbt:"tame"`
+	Angry ns.Identifier `nbt:"angry"`
+}
@@ -0,0 +1,144 @@
+package java_protocol
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy controls how often and how fast RetryDialer reconnects.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of connection attempts. 0 or less retries forever.
+	MaxAttempts int
+	// InitialBackoff is the wait before the second attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between attempts.
+	MaxBackoff time.Duration
+	// Multiplier grows the wait after each failed attempt (exponential backoff).
+	// Values below 1 are treated as 1.
+	Multiplier float64
+	// Jitter randomizes each wait by up to ±Jitter (a fraction, e.g. 0.2 for 20%),
+	// so that many bots do not reconnect in lockstep.
+	Jitter float64
+}
+
+// DefaultRetryPolicy returns the policy used by NewRetryDialer: 5 attempts,
+// starting at 5 seconds (longer than DefaultThrottleWindow) and doubling up to
+// a minute.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     time.Minute,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// Backoff returns the wait after the given failed attempt (1-based), without jitter.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	wait := float64(p.InitialBackoff)
+	mult := max(p.Multiplier, 1)
+	for i := 1; i < attempt; i++ {
+		wait *= mult
+		if p.MaxBackoff > 0 && wait >= float64(p.MaxBackoff) {
+			return p.MaxBackoff
+		}
+	}
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	return time.Duration(wait)
+}
+
+func (p RetryPolicy) jittered(attempt int) time.Duration {
+	wait := p.Backoff(attempt)
+	if p.Jitter <= 0 || wait <= 0 {
+		return wait
+	}
+	return time.Duration(float64(wait) * (1 + p.Jitter*(2*rand.Float64()-1)))
+}
+
+// RetryDialer connects to a server, retrying transient failures with backoff.
+// Unlike TCPClient.Connect, which only opens the socket, an attempt can also
+// cover the login (see Handshake), so that e.g. a throttled or full server
+// is retried while a ban is reported immediately.
+type RetryDialer struct {
+	Policy     RetryPolicy
+	TCPOptions TCPOptions
+	// Handshake, if set, runs on each new connection (e.g. handshake and login).
+	// If it fails the connection is closed and the error decides whether to retry;
+	// it should return a *DisconnectError when the server sends Disconnect.
+	Handshake func(c *TCPClient) error
+	// Retryable decides whether an error is retried. Nil uses IsRetryable.
+	Retryable func(err error) bool
+	// Throttle, if set, spaces out attempts to the same address (including
+	// those of other dialers sharing it), e.g. NewThrottle(DefaultThrottleWindow).
+	Throttle *Throttle
+	// OnRetry, if set, is called before waiting for the next attempt.
+	OnRetry func(attempt int, err error, wait time.Duration)
+}
+
+// NewRetryDialer creates a RetryDialer with the default policy and socket options.
+func NewRetryDialer() *RetryDialer {
+	return &RetryDialer{
+		Policy:     DefaultRetryPolicy(),
+		TCPOptions: DefaultTCPOptions(),
+	}
+}
+
+// Dial connects to address until an attempt succeeds, an error is not
+// retryable, the policy's attempts are used up or ctx is done. The returned
+// error wraps the last attempt's error.
+func (d *RetryDialer) Dial(ctx context.Context, address string) (*TCPClient, error) {
+	retryable := d.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+
+	for attempt := 1; ; attempt++ {
+		if d.Throttle != nil {
+			if err := d.Throttle.Wait(ctx, address); err != nil {
+				return nil, err
+			}
+		}
+		client, err := d.attempt(address)
+		if err == nil {
+			return client, nil
+		}
+		if !retryable(err) {
+			return nil, fmt.Errorf("attempt %d failed: %w", attempt, err)
+		}
+		if d.Policy.MaxAttempts > 0 && attempt >= d.Policy.MaxAttempts {
+			return nil, fmt.Errorf("giving up after %d attempts: %w", attempt, err)
+		}
+
+		wait := d.Policy.jittered(attempt)
+		if d.OnRetry != nil {
+			d.OnRetry(attempt, err, wait)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("%w (after %d attempts, last error: %w)", ctx.Err(), attempt, err)
+		case <-timer.C:
+		}
+	}
+}
+
+func (d *RetryDialer) attempt(address string) (*TCPClient, error) {
+	client := NewTCPClient()
+	client.SetTCPOptions(d.TCPOptions)
+	if _, _, err := client.Connect(address); err != nil {
+		return nil, err
+	}
+	if d.Handshake != nil {
+		if err := d.Handshake(client); err != nil {
+			client.Close()
+			return nil, err
+		}
+	}
+	return client, nil
+}
@@ -0,0 +1,190 @@
+package java_protocol_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	jp "github.com/go-mclib/protocol/java_protocol"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := jp.RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 10 * time.Second, Multiplier: 2}
+	for attempt, want := range map[int]time.Duration{
+		1: time.Second,
+		2: 2 * time.Second,
+		3: 4 * time.Second,
+		4: 8 * time.Second,
+		5: 10 * time.Second,
+		9: 10 * time.Second,
+	} {
+		if got := p.Backoff(attempt); got != want {
+			t.Errorf("Backoff(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+
+	constant := jp.RetryPolicy{InitialBackoff: time.Second}
+	if got := constant.Backoff(5); got != time.Second {
+		t.Errorf("Backoff without multiplier = %v, want 1s", got)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	disconnect := func(tc ns.TextComponent) error {
+		return fmt.Errorf("login: %w", &jp.DisconnectError{Reason: tc})
+	}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"refused", &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}, true},
+		{"reset", fmt.Errorf("read: %w", syscall.ECONNRESET), true},
+		{"eof", fmt.Errorf("failed to read packet: %w", io.EOF), true},
+		{"dns not found", &net.DNSError{Err: "no such host", IsNotFound: true}, false},
+		{"dns timeout", &net.DNSError{Err: "timeout", IsTimeout: true}, true},
+		{"throttled literal", disconnect(ns.NewTextComponent("Connection throttled! Please wait before reconnecting.")), true},
+		{"server full", disconnect(ns.TextComponent{Translate: "multiplayer.disconnect.server_full"}), true},
+		{"banned", disconnect(ns.TextComponent{Translate: "multiplayer.disconnect.banned.reason", With: []ns.TextComponent{{Text: "griefing"}}}), false},
+		{"outdated", disconnect(ns.TextComponent{Translate: "multiplayer.disconnect.outdated_client", With: []ns.TextComponent{{Text: "26.1"}}}), false},
+		{"plugin kick", disconnect(ns.NewTextComponent("You are not allowed here")), false},
+		{"other", errors.New("failed to serialize packet"), false},
+	}
+	for _, tt := range tests {
+		if got := jp.IsRetryable(tt.err); got != tt.want {
+			t.Errorf("%s: IsRetryable(%v) = %v, want %v", tt.name, tt.err, got, tt.want)
+		}
+	}
+}
+
+func listenLoopback(t *testing.T) net.Listener {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot listen on loopback: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				io.Copy(io.Discard, conn)
+				conn.Close()
+			}()
+		}
+	}()
+	return ln
+}
+
+func fastRetryDialer(maxAttempts int) *jp.RetryDialer {
+	d := jp.NewRetryDialer()
+	d.Policy = jp.RetryPolicy{MaxAttempts: maxAttempts, InitialBackoff: time.Millisecond, Multiplier: 2}
+	return d
+}
+
+func TestRetryDialer_RetriesThrottle(t *testing.T) {
+	ln := listenLoopback(t)
+
+	d := fastRetryDialer(5)
+	attempts := 0
+	d.Handshake = func(c *jp.TCPClient) error {
+		attempts++
+		if attempts < 3 {
+			return &jp.DisconnectError{Reason: ns.NewTextComponent("Connection throttled! Please wait before reconnecting.")}
+		}
+		return nil
+	}
+	var retries []int
+	d.OnRetry = func(attempt int, err error, wait time.Duration) { retries = append(retries, attempt) }
+
+	client, err := d.Dial(context.Background(), ln.Addr().String())
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer client.Close()
+	if attempts != 3 || len(retries) != 2 {
+		t.Errorf("attempts = %d, retries = %v", attempts, retries)
+	}
+}
+
+func TestRetryDialer_FatalDisconnect(t *testing.T) {
+	ln := listenLoopback(t)
+
+	d := fastRetryDialer(5)
+	attempts := 0
+	d.Handshake = func(c *jp.TCPClient) error {
+		attempts++
+		return &jp.DisconnectError{Reason: ns.TextComponent{Translate: "multiplayer.disconnect.banned"}}
+	}
+
+	_, err := d.Dial(context.Background(), ln.Addr().String())
+	var de *jp.DisconnectError
+	if !errors.As(err, &de) || attempts != 1 {
+		t.Errorf("Dial() = %v after %d attempts, want DisconnectError after 1", err, attempts)
+	}
+}
+
+func TestRetryDialer_GivesUp(t *testing.T) {
+	// a closed listener's port refuses connections
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot listen on loopback: %v", err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	d := fastRetryDialer(3)
+	retries := 0
+	d.OnRetry = func(int, error, time.Duration) { retries++ }
+	_, err = d.Dial(context.Background(), addr)
+	if err == nil || !strings.Contains(err.Error(), "after 3 attempts") || !errors.Is(err, syscall.ECONNREFUSED) {
+		t.Errorf("Dial() = %v", err)
+	}
+	if retries != 2 {
+		t.Errorf("retries = %d, want 2", retries)
+	}
+}
+
+func TestRetryDialer_ContextCanceled(t *testing.T) {
+	ln := listenLoopback(t)
+
+	d := jp.NewRetryDialer()
+	d.Policy.MaxAttempts = 0
+	d.Policy.InitialBackoff = time.Hour
+	d.Handshake = func(c *jp.TCPClient) error { return io.EOF }
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := d.Dial(ctx, ln.Addr().String())
+	if !errors.Is(err, context.DeadlineExceeded) || !errors.Is(err, io.EOF) {
+		t.Errorf("Dial() = %v, want deadline exceeded wrapping EOF", err)
+	}
+}
+
+func TestRetryDialer_Throttle(t *testing.T) {
+	ln := listenLoopback(t)
+
+	const window = 20 * time.Millisecond
+	d := fastRetryDialer(3)
+	d.Throttle = jp.NewThrottle(window)
+	d.Handshake = func(c *jp.TCPClient) error { return io.EOF }
+
+	start := time.Now()
+	if _, err := d.Dial(context.Background(), ln.Addr().String()); err == nil {
+		t.Fatal("expected error")
+	}
+	if elapsed := time.Since(start); elapsed < 2*window {
+		t.Errorf("3 throttled attempts took %v, want at least %v", elapsed, 2*window)
+	}
+}
@@ -0,0 +1,154 @@
+package session_server
+
+import (
+	stdcrypto "crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// PublicKeysURL is the Minecraft services endpoint listing the keys used to sign
+// profile properties and player certificates.
+const PublicKeysURL = "https://api.minecraftservices.com/publickeys"
+
+// ErrNotJoined is returned by VerifyJoin when the session server has no record of
+// the player joining with the given server hash.
+var ErrNotJoined = errors.New("player has not joined")
+
+// PublicKeysResponse represents the response from the /publickeys endpoint
+type PublicKeysResponse struct {
+	ProfilePropertyKeys   []PublicKeyEntry `json:"profilePropertyKeys"`
+	PlayerCertificateKeys []PublicKeyEntry `json:"playerCertificateKeys"`
+}
+
+// PublicKeyEntry is a single base64 encoded SPKI DER public key
+type PublicKeyEntry struct {
+	PublicKey string `json:"publicKey"`
+}
+
+// FetchProfilePropertyKeys retrieves the keys that sign profile properties (e.g. textures)
+func (c *SessionServerClient) FetchProfilePropertyKeys() ([]*rsa.PublicKey, error) {
+	req, err := http.NewRequest("GET", c.publicKeysURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create public keys request: %w", err)
+	}
+	req.Header.Set("User-Agent", "gomc-lib/protocol (github.com/go-mclib/protocol)")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch public keys: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("public keys request failed with status %d", resp.StatusCode)
+	}
+
+	var keys PublicKeysResponse
+	if err := json.NewDecoder(resp.Body).Decode(&keys); err != nil {
+		return nil, fmt.Errorf("failed to parse public keys response: %w", err)
+	}
+	return ParsePublicKeys(keys.ProfilePropertyKeys)
+}
+
+// ParsePublicKeys decodes base64 encoded SPKI DER public keys
+func ParsePublicKeys(entries []PublicKeyEntry) ([]*rsa.PublicKey, error) {
+	keys := make([]*rsa.PublicKey, 0, len(entries))
+	for i, entry := range entries {
+		der, err := base64.StdEncoding.DecodeString(entry.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode public key %d: %w", i, err)
+		}
+		key, err := x509.ParsePKIXPublicKey(der)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse public key %d: %w", i, err)
+		}
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("public key %d is not RSA", i)
+		}
+		keys = append(keys, rsaKey)
+	}
+	return keys, nil
+}
+
+// Verify checks the property's signature (SHA1withRSA over the base64 value)
+// against the given keys. It succeeds if any of the keys verifies it.
+func (p Property) Verify(keys ...*rsa.PublicKey) error {
+	if p.Signature == "" {
+		return fmt.Errorf("property %q is not signed", p.Name)
+	}
+	signature, err := base64.StdEncoding.DecodeString(p.Signature)
+	if err != nil {
+		return fmt.Errorf("failed to decode signature of property %q: %w", p.Name, err)
+	}
+
+	digest := sha1.Sum([]byte(p.Value))
+	for _, key := range keys {
+		if rsa.VerifyPKCS1v15(key, stdcrypto.SHA1, digest[:], signature) == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid signature for property %q", p.Name)
+}
+
+// Verify checks that every property of the profile carries a valid signature
+func (r *HasJoinedResponse) Verify(keys ...*rsa.PublicKey) error {
+	for _, prop := range r.Properties {
+		if err := prop.Verify(keys...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GameProfile converts the response to the profile sent in Login Success
+func (r *HasJoinedResponse) GameProfile() (ns.GameProfile, error) {
+	uuid, err := ns.UUIDFromString(r.ID)
+	if err != nil {
+		return ns.GameProfile{}, fmt.Errorf("failed to parse profile id: %w", err)
+	}
+
+	profile := ns.GameProfile{
+		UUID:       uuid,
+		Username:   ns.String(r.Name),
+		Properties: make(ns.PrefixedArray[ns.ProfileProperty], 0, len(r.Properties)),
+	}
+	for _, prop := range r.Properties {
+		p := ns.ProfileProperty{Name: ns.String(prop.Name), Value: ns.String(prop.Value)}
+		if prop.Signature != "" {
+			p.Signature = ns.Some(ns.String(prop.Signature))
+		}
+		profile.Properties = append(profile.Properties, p)
+	}
+	return profile, nil
+}
+
+// VerifyJoin authenticates a connecting player on the server side: it computes
+// the server hash, asks the session server whether the player has joined, checks
+// the property signatures against keys (skipped when no keys are given) and
+// returns the player's profile. ErrNotJoined is returned if the player did not
+// authenticate.
+func (c *SessionServerClient) VerifyJoin(username, serverID string, sharedSecret, publicKey []byte, keys []*rsa.PublicKey, ip ...string) (ns.GameProfile, error) {
+	serverHash := ComputeServerHash(serverID, sharedSecret, publicKey)
+	resp, err := c.HasJoined(username, serverHash, ip...)
+	if err != nil {
+		return ns.GameProfile{}, err
+	}
+	if resp == nil {
+		return ns.GameProfile{}, ErrNotJoined
+	}
+	if len(keys) > 0 {
+		if err := resp.Verify(keys...); err != nil {
+			return ns.GameProfile{}, err
+		}
+	}
+	return resp.GameProfile()
+}
@@ -0,0 +1,141 @@
+package session_server_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-mclib/protocol/java_protocol/session_server"
+)
+
+func signProperty(t *testing.T, key *rsa.PrivateKey, name, value string) session_server.Property {
+	t.Helper()
+	digest := sha1.Sum([]byte(value))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA1, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign: %v", err)
+	}
+	return session_server.Property{Name: name, Value: value, Signature: base64.StdEncoding.EncodeToString(sig)}
+}
+
+func TestProperty_Verify(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prop := signProperty(t, key, "textures", "eyJ0aW1lc3RhbXAiOjB9")
+	if err := prop.Verify(&other.PublicKey, &key.PublicKey); err != nil {
+		t.Errorf("expected valid signature, got: %v", err)
+	}
+	if err := prop.Verify(&other.PublicKey); err == nil {
+		t.Error("expected error for wrong key")
+	}
+
+	tampered := prop
+	tampered.Value = "eyJ0aW1lc3RhbXAiOjF9"
+	if err := tampered.Verify(&key.PublicKey); err == nil {
+		t.Error("expected error for tampered value")
+	}
+	if err := (session_server.Property{Name: "textures", Value: "x"}).Verify(&key.PublicKey); err == nil {
+		t.Error("expected error for unsigned property")
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := session_server.ParsePublicKeys([]session_server.PublicKeyEntry{{PublicKey: base64.StdEncoding.EncodeToString(der)}})
+	if err != nil || len(keys) != 1 || !keys[0].Equal(&key.PublicKey) {
+		t.Errorf("ParsePublicKeys() = %v, %v", keys, err)
+	}
+}
+
+func TestFetchProfilePropertyKeys(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/publickeys" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(session_server.PublicKeysResponse{
+			ProfilePropertyKeys: []session_server.PublicKeyEntry{{PublicKey: base64.StdEncoding.EncodeToString(der)}},
+		})
+	}))
+	defer srv.Close()
+
+	keys, err := session_server.NewClientWithURL(srv.URL).FetchProfilePropertyKeys()
+	if err != nil || len(keys) != 1 || !keys[0].Equal(&key.PublicKey) {
+		t.Errorf("FetchProfilePropertyKeys() = %v, %v", keys, err)
+	}
+	if _, err := session_server.NewClientWithURL(srv.URL + "/missing").FetchProfilePropertyKeys(); err == nil {
+		t.Error("expected error for failed request")
+	}
+}
+
+func TestVerifyJoin(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sharedSecret := []byte("0123456789abcdef")
+	serverKey := []byte("server public key")
+	wantHash := session_server.ComputeServerHash("", sharedSecret, serverKey)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("serverId") != wantHash {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		json.NewEncoder(w).Encode(session_server.HasJoinedResponse{
+			ID:         "069a79f444e94726a5befca90e38aaf5",
+			Name:       "Notch",
+			Properties: []session_server.Property{signProperty(t, key, "textures", "dGV4dHVyZXM=")},
+		})
+	}))
+	defer srv.Close()
+	client := session_server.NewClientWithURL(srv.URL)
+
+	profile, err := client.VerifyJoin("Notch", "", sharedSecret, serverKey, []*rsa.PublicKey{&key.PublicKey})
+	if err != nil {
+		t.Fatalf("VerifyJoin() error: %v", err)
+	}
+	if profile.UUID.String() != "069a79f4-44e9-4726-a5be-fca90e38aaf5" || profile.Username != "Notch" {
+		t.Errorf("unexpected profile: %+v", profile)
+	}
+	if len(profile.Properties) != 1 || !profile.Properties[0].Signature.Present {
+		t.Errorf("unexpected properties: %+v", profile.Properties)
+	}
+
+	if _, err := client.VerifyJoin("Notch", "", []byte("other secret"), serverKey, nil); !errors.Is(err, session_server.ErrNotJoined) {
+		t.Errorf("expected ErrNotJoined, got: %v", err)
+	}
+
+	other, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.VerifyJoin("Notch", "", sharedSecret, serverKey, []*rsa.PublicKey{&other.PublicKey}); err == nil {
+		t.Error("expected signature error for wrong key")
+	}
+}
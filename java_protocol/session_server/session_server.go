@@ -14,14 +14,16 @@ import (
 
 // SessionServerClient represents a session server client
 type SessionServerClient struct {
-	baseURL    string
-	httpClient *http.Client
+	baseURL       string
+	publicKeysURL string
+	httpClient    *http.Client
 }
 
 // NewSessionServerClient creates a new session server client
 func NewSessionServerClient() *SessionServerClient {
 	return &SessionServerClient{
-		baseURL: "https://sessionserver.mojang.com",
+		baseURL:       "https://sessionserver.mojang.com",
+		publicKeysURL: PublicKeysURL,
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
@@ -70,10 +72,12 @@ func (e ErrorResponse) String() string {
 	return fmt.Sprintf("%s (path: %s)", e.Error, e.Path)
 }
 
-// NewClientWithURL creates a new session server client with a custom base URL
+// NewClientWithURL creates a new session server client with a custom base URL.
+// The public keys are fetched from baseURL + "/publickeys".
 func NewClientWithURL(baseURL string) *SessionServerClient {
 	return &SessionServerClient{
-		baseURL: baseURL,
+		baseURL:       baseURL,
+		publicKeysURL: baseURL + "/publickeys",
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
@@ -0,0 +1,249 @@
+package status
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// legacyProtocolVersion is the protocol version sent in the legacy ping (1.6.4).
+const legacyProtocolVersion = 74
+
+// LegacyResponse is a server's answer to the legacy ping.
+type LegacyResponse struct {
+	// ProtocolVersion and Version are 0 and "" for servers older than 1.4,
+	// which do not report them.
+	ProtocolVersion int32
+	Version         string
+	// MOTD may contain legacy § color codes, see Description.
+	MOTD   string
+	Online int
+	Max    int
+}
+
+// Description returns the MOTD as a text component.
+func (r *LegacyResponse) Description() ns.TextComponent {
+	return ns.FromColorCodes(r.MOTD)
+}
+
+// QueryLegacy connects to address and sends the legacy (pre-1.7) 0xFE server
+// list ping, which very old servers answer instead of the status handshake.
+// Current vanilla servers still answer it as well. The whole exchange must
+// complete within timeout; 0 means no timeout.
+func QueryLegacy(address string, timeout time.Duration) (*LegacyResponse, error) {
+	client, host, port, err := dial(address, timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	return QueryLegacyConn(client.Conn(), host, port)
+}
+
+// QueryLegacyConn sends the legacy ping on rw and reads the response. The
+// request is the 1.6 form, which includes host and port and is understood by
+// servers back to Beta 1.8 (older ones ignore the trailing data).
+//
+// Request:
+//
+//	┌────────┬────────┬────────┬──────────────────────────┬──────────────────┐
+//	│  0xFE  │  0x01  │  0xFA  │  "MC|PingHost" (UTF-16)  │  Length (Short)  │
+//	└────────┴────────┴────────┴──────────────────────────┴──────────────────┘
+//	┌───────────────────────────┬───────────────────────────┬────────────────┐
+//	│  Protocol Version (Byte)  │  Host (UTF-16)            │  Port (Int)    │
+//	└───────────────────────────┴───────────────────────────┴────────────────┘
+//
+// Response:
+//
+//	┌────────┬──────────────────────────────────────────────────────────────┐
+//	│  0xFF  │  "§1\0protocol\0version\0motd\0online\0max" (UTF-16)         │
+//	└────────┴──────────────────────────────────────────────────────────────┘
+//
+// Strings are a Short length in UTF-16 code units followed by big-endian
+// UTF-16. Servers older than 1.4 answer "motd§online§max" instead.
+func QueryLegacyConn(rw io.ReadWriter, host string, port uint16) (*LegacyResponse, error) {
+	if _, err := rw.Write(legacyPingRequest(host, port)); err != nil {
+		return nil, fmt.Errorf("failed to send legacy ping: %w", err)
+	}
+
+	var id [1]byte
+	if _, err := io.ReadFull(rw, id[:]); err != nil {
+		return nil, fmt.Errorf("failed to read legacy ping response: %w", err)
+	}
+	if id[0] != 0xFF {
+		return nil, fmt.Errorf("unexpected legacy ping response 0x%02X, expected 0xFF", id[0])
+	}
+	s, err := readLegacyString(rw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read legacy ping response: %w", err)
+	}
+	return parseLegacyResponse(s)
+}
+
+func legacyPingRequest(host string, port uint16) []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{0xFE, 0x01, 0xFA})
+	writeLegacyString(&buf, "MC|PingHost")
+	hostUnits := utf16.Encode([]rune(host))
+	binary.Write(&buf, binary.BigEndian, uint16(7+2*len(hostUnits)))
+	buf.WriteByte(legacyProtocolVersion)
+	writeLegacyString(&buf, host)
+	binary.Write(&buf, binary.BigEndian, int32(port))
+	return buf.Bytes()
+}
+
+func writeLegacyString(buf *bytes.Buffer, s string) {
+	units := utf16.Encode([]rune(s))
+	binary.Write(buf, binary.BigEndian, uint16(len(units)))
+	binary.Write(buf, binary.BigEndian, units)
+}
+
+func readLegacyString(r io.Reader) (string, error) {
+	var n uint16
+	if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+		return "", err
+	}
+	units := make([]uint16, n)
+	if err := binary.Read(r, binary.BigEndian, units); err != nil {
+		return "", err
+	}
+	return string(utf16.Decode(units)), nil
+}
+
+func parseLegacyResponse(s string) (*LegacyResponse, error) {
+	if rest, ok := strings.CutPrefix(s, "§1\x00"); ok {
+		fields := strings.Split(rest, "\x00")
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("legacy ping response has %d fields, expected 5", len(fields))
+		}
+		protocol, err := strconv.ParseInt(fields[0], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid legacy protocol version %q: %w", fields[0], err)
+		}
+		r := &LegacyResponse{ProtocolVersion: int32(protocol), Version: fields[1], MOTD: fields[2]}
+		if r.Online, r.Max, err = parsePlayerCounts(fields[3], fields[4]); err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+
+	// pre-1.4: the MOTD itself cannot contain §, but split from the end anyway
+	fields := strings.Split(s, "§")
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("malformed legacy ping response %q", s)
+	}
+	n := len(fields)
+	r := &LegacyResponse{MOTD: strings.Join(fields[:n-2], "§")}
+	var err error
+	if r.Online, r.Max, err = parsePlayerCounts(fields[n-2], fields[n-1]); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func parsePlayerCounts(online, limit string) (int, int, error) {
+	o, err := strconv.Atoi(online)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid legacy online player count %q: %w", online, err)
+	}
+	m, err := strconv.Atoi(limit)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid legacy max player count %q: %w", limit, err)
+	}
+	return o, m, nil
+}
+
+// LegacyRequest is a legacy ping as received by a server.
+type LegacyRequest struct {
+	// Beta is set for the bare 0xFE sent by Beta 1.8 to 1.3 clients, which
+	// expect the "motd§online§max" response.
+	Beta bool
+	// ProtocolVersion, Host and Port are only sent by 1.6 clients.
+	ProtocolVersion int32
+	Host            string
+	Port            uint16
+}
+
+// ReadLegacyRequest reads a legacy ping from r, for servers and proxies that
+// want to answer old clients. The caller should peek the first byte first: a
+// legacy ping starts with 0xFE, which cannot start a modern handshake.
+//
+// Like the vanilla server, the request form is told apart by the bytes
+// already received: a lone 0xFE is a Beta ping and 0xFE 0x01 a 1.4/1.5 ping.
+// Only the 1.6 form (0xFE 0x01 0xFA ...) is read to its end.
+func ReadLegacyRequest(r *bufio.Reader) (*LegacyRequest, error) {
+	id, err := r.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read legacy ping: %w", err)
+	}
+	if id != 0xFE {
+		return nil, fmt.Errorf("unexpected legacy ping 0x%02X, expected 0xFE", id)
+	}
+	if r.Buffered() == 0 {
+		return &LegacyRequest{Beta: true}, nil
+	}
+	if b, err := r.ReadByte(); err != nil || b != 0x01 {
+		return nil, fmt.Errorf("malformed legacy ping: expected 0x01 after 0xFE")
+	}
+	if r.Buffered() == 0 {
+		return &LegacyRequest{}, nil
+	}
+
+	if b, err := r.ReadByte(); err != nil || b != 0xFA {
+		return nil, fmt.Errorf("malformed legacy ping: expected plugin message 0xFA")
+	}
+	channel, err := readLegacyString(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read legacy ping channel: %w", err)
+	}
+	if channel != "MC|PingHost" {
+		return nil, fmt.Errorf("unexpected legacy ping channel %q", channel)
+	}
+	var header struct {
+		Length   uint16
+		Protocol uint8
+	}
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		return nil, fmt.Errorf("failed to read legacy ping data: %w", err)
+	}
+	req := &LegacyRequest{ProtocolVersion: int32(header.Protocol)}
+	if req.Host, err = readLegacyString(r); err != nil {
+		return nil, fmt.Errorf("failed to read legacy ping host: %w", err)
+	}
+	if want := 7 + 2*len(utf16.Encode([]rune(req.Host))); int(header.Length) != want {
+		return nil, fmt.Errorf("legacy ping data length is %d, expected %d", header.Length, want)
+	}
+	var port int32
+	if err := binary.Read(r, binary.BigEndian, &port); err != nil {
+		return nil, fmt.Errorf("failed to read legacy ping port: %w", err)
+	}
+	if port < 0 || port > 0xFFFF {
+		return nil, fmt.Errorf("invalid legacy ping port %d", port)
+	}
+	req.Port = uint16(port)
+	return req, nil
+}
+
+// WriteLegacyResponse answers req with resp, in the format the request's
+// client understands. The server should close the connection afterwards.
+func WriteLegacyResponse(w io.Writer, req *LegacyRequest, resp *LegacyResponse) error {
+	var s string
+	if req.Beta {
+		// the Beta client splits on §, so it cannot appear in the MOTD
+		s = fmt.Sprintf("%s§%d§%d", ns.FromColorCodes(resp.MOTD).String(), resp.Online, resp.Max)
+	} else {
+		s = fmt.Sprintf("§1\x00%d\x00%s\x00%s\x00%d\x00%d", resp.ProtocolVersion, resp.Version, resp.MOTD, resp.Online, resp.Max)
+	}
+	var buf bytes.Buffer
+	buf.WriteByte(0xFF)
+	writeLegacyString(&buf, s)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,120 @@
+package status
+
+import (
+	jp "github.com/go-mclib/protocol/java_protocol"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// The handshake and status packets have kept their layout and IDs since 1.7,
+// so unlike the other packets (generated in go-mclib/data) they are defined
+// here to keep the package self-contained.
+
+// intentStatus is the handshake intent that switches to the status state.
+const intentStatus ns.VarInt = 1
+
+// handshakePacket is C2S Handshake (0x00).
+type handshakePacket struct {
+	ProtocolVersion ns.VarInt
+	ServerAddress   ns.String
+	ServerPort      ns.Uint16
+	Intent          ns.VarInt
+}
+
+func (p *handshakePacket) ID() ns.VarInt   { return 0x00 }
+func (p *handshakePacket) State() jp.State { return jp.StateHandshake }
+func (p *handshakePacket) Bound() jp.Bound { return jp.C2S }
+
+func (p *handshakePacket) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if p.ProtocolVersion, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	if p.ServerAddress, err = buf.ReadString(255); err != nil {
+		return err
+	}
+	if p.ServerPort, err = buf.ReadUint16(); err != nil {
+		return err
+	}
+	p.Intent, err = buf.ReadVarInt()
+	return err
+}
+
+func (p *handshakePacket) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteVarInt(p.ProtocolVersion); err != nil {
+		return err
+	}
+	if err := buf.WriteString(p.ServerAddress); err != nil {
+		return err
+	}
+	if err := buf.WriteUint16(p.ServerPort); err != nil {
+		return err
+	}
+	return buf.WriteVarInt(p.Intent)
+}
+
+// statusRequestPacket is C2S Status Request (0x00), which has no fields.
+type statusRequestPacket struct{}
+
+func (p *statusRequestPacket) ID() ns.VarInt                    { return 0x00 }
+func (p *statusRequestPacket) State() jp.State                  { return jp.StateStatus }
+func (p *statusRequestPacket) Bound() jp.Bound                  { return jp.C2S }
+func (p *statusRequestPacket) Read(buf *ns.PacketBuffer) error  { return nil }
+func (p *statusRequestPacket) Write(buf *ns.PacketBuffer) error { return nil }
+
+// statusResponsePacket is S2C Status Response (0x00).
+type statusResponsePacket struct {
+	JSON ns.String
+}
+
+func (p *statusResponsePacket) ID() ns.VarInt   { return 0x00 }
+func (p *statusResponsePacket) State() jp.State { return jp.StateStatus }
+func (p *statusResponsePacket) Bound() jp.Bound { return jp.S2C }
+
+func (p *statusResponsePacket) Read(buf *ns.PacketBuffer) error {
+	var err error
+	p.JSON, err = buf.ReadString(32767)
+	return err
+}
+
+func (p *statusResponsePacket) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteString(p.JSON)
+}
+
+// pingPacket is C2S Ping Request (0x01). The payload is echoed back in a
+// pongPacket; the vanilla client sends the current time in milliseconds.
+type pingPacket struct {
+	Payload ns.Int64
+}
+
+func (p *pingPacket) ID() ns.VarInt   { return 0x01 }
+func (p *pingPacket) State() jp.State { return jp.StateStatus }
+func (p *pingPacket) Bound() jp.Bound { return jp.C2S }
+
+func (p *pingPacket) Read(buf *ns.PacketBuffer) error {
+	var err error
+	p.Payload, err = buf.ReadInt64()
+	return err
+}
+
+func (p *pingPacket) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteInt64(p.Payload)
+}
+
+// pongPacket is S2C Pong Response (0x01).
+type pongPacket struct {
+	Payload ns.Int64
+}
+
+func (p *pongPacket) ID() ns.VarInt   { return 0x01 }
+func (p *pongPacket) State() jp.State { return jp.StateStatus }
+func (p *pongPacket) Bound() jp.Bound { return jp.S2C }
+
+func (p *pongPacket) Read(buf *ns.PacketBuffer) error {
+	var err error
+	p.Payload, err = buf.ReadInt64()
+	return err
+}
+
+func (p *pongPacket) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteInt64(p.Payload)
+}
@@ -0,0 +1,184 @@
+// Package status implements the Server List Ping (SLP): the exchange a client
+// uses to show a server's MOTD, version, player count and icon in the
+// multiplayer menu without logging in.
+//
+//	C2S Handshake (intent: status)  ─►
+//	C2S Status Request              ─►
+//	                                ◄─  S2C Status Response (JSON)
+//	C2S Ping Request (timestamp)    ─►
+//	                                ◄─  S2C Pong Response (same timestamp)
+//
+// Servers older than 1.7 do not understand the handshake and only answer the
+// legacy 0xFE ping, see QueryLegacy.
+//
+// See https://minecraft.wiki/w/Java_Edition_protocol/Server_List_Ping
+package status
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	jp "github.com/go-mclib/protocol/java_protocol"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// ProtocolVersion is the protocol version sent in the handshake (26.1).
+// Servers answer with their own version regardless of this value.
+const ProtocolVersion = 775
+
+// Response is the JSON document sent in the Status Response packet.
+type Response struct {
+	Version Version `json:"version"`
+	// Players is nil if the server hides its player count.
+	Players     *Players         `json:"players,omitempty"`
+	Description ns.TextComponent `json:"description"`
+	// Favicon is a 64x64 PNG as a data URI, see FaviconPNG.
+	Favicon            string `json:"favicon,omitempty"`
+	EnforcesSecureChat bool   `json:"enforcesSecureChat,omitempty"`
+}
+
+// Version is the server's version name (e.g. "26.1" or "Paper 26.1") and
+// protocol version.
+type Version struct {
+	Name     string `json:"name"`
+	Protocol int32  `json:"protocol"`
+}
+
+// Players is the player count and a sample of online players. The sample is
+// usually shown as the hover text of the player count and is often abused by
+// servers to show arbitrary lines of text.
+type Players struct {
+	Max    int            `json:"max"`
+	Online int            `json:"online"`
+	Sample []PlayerSample `json:"sample,omitempty"`
+}
+
+// PlayerSample is one entry of the player sample.
+type PlayerSample struct {
+	Name string `json:"name"`
+	// ID is the player's UUID in its dashed string form.
+	ID string `json:"id"`
+}
+
+const faviconPrefix = "data:image/png;base64,"
+
+// FaviconPNG decodes the favicon data URI to the raw PNG bytes. Returns nil
+// if the server has no favicon.
+func (r *Response) FaviconPNG() ([]byte, error) {
+	if r.Favicon == "" {
+		return nil, nil
+	}
+	data, ok := strings.CutPrefix(r.Favicon, faviconPrefix)
+	if !ok {
+		return nil, fmt.Errorf("favicon is not a base64 PNG data URI")
+	}
+	// older servers wrap the base64 data at 76 characters
+	data = strings.NewReplacer("\r", "", "\n", "").Replace(data)
+	png, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode favicon: %w", err)
+	}
+	return png, nil
+}
+
+// Result is the outcome of a status query.
+type Result struct {
+	Response
+	// Latency is the round trip time of the ping/pong exchange.
+	Latency time.Duration
+	// Raw is the status JSON as received, for fields Response does not cover
+	// (e.g. "forgeData" or "modinfo" of modded servers).
+	Raw string
+}
+
+// Query connects to address ("host", "host:port" or a name with a SRV record,
+// like TCPClient.Connect), queries its status and measures the latency. The
+// whole exchange must complete within timeout; 0 means no timeout.
+func Query(address string, timeout time.Duration) (*Result, error) {
+	client, host, port, err := dial(address, timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	return QueryConn(client, host, port)
+}
+
+// QueryConn performs the status exchange on an already connected client that
+// is still in the handshake state. Host and port are sent in the handshake;
+// the connection is left in the status state and not closed.
+func QueryConn(client *jp.TCPClient, host string, port uint16) (*Result, error) {
+	if err := client.WritePacket(&handshakePacket{
+		ProtocolVersion: ProtocolVersion,
+		ServerAddress:   ns.String(host),
+		ServerPort:      ns.Uint16(port),
+		Intent:          intentStatus,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to send handshake: %w", err)
+	}
+	client.SetState(jp.StateStatus)
+
+	if err := client.WritePacket(&statusRequestPacket{}); err != nil {
+		return nil, fmt.Errorf("failed to send status request: %w", err)
+	}
+	wire, err := client.ReadWirePacket()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read status response: %w", err)
+	}
+	resp, err := jp.ReadPacket[statusResponsePacket](wire)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read status response: %w", err)
+	}
+	result := &Result{Raw: string(resp.JSON)}
+	if err := json.Unmarshal([]byte(resp.JSON), &result.Response); err != nil {
+		return nil, fmt.Errorf("failed to parse status response: %w", err)
+	}
+
+	start := time.Now()
+	payload := ns.Int64(start.UnixMilli())
+	if err := client.WritePacket(&pingPacket{Payload: payload}); err != nil {
+		return nil, fmt.Errorf("failed to send ping request: %w", err)
+	}
+	wire, err = client.ReadWirePacket()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pong response: %w", err)
+	}
+	pong, err := jp.ReadPacket[pongPacket](wire)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pong response: %w", err)
+	}
+	if pong.Payload != payload {
+		return nil, fmt.Errorf("pong payload mismatch: expected %d, got %d", payload, pong.Payload)
+	}
+	result.Latency = time.Since(start)
+	return result, nil
+}
+
+// dial connects a new client to address, arming a deadline for the whole
+// exchange if timeout is positive.
+func dial(address string, timeout time.Duration) (*jp.TCPClient, string, uint16, error) {
+	client := jp.NewTCPClient()
+	opts := client.TCPOptions()
+	opts.DialTimeout = timeout
+	client.SetTCPOptions(opts)
+
+	host, portStr, err := client.Connect(address)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		client.Close()
+		return nil, "", 0, fmt.Errorf("invalid port %q: %w", portStr, err)
+	}
+	if timeout > 0 {
+		if err := client.Conn().NetConn().SetDeadline(time.Now().Add(timeout)); err != nil {
+			client.Close()
+			return nil, "", 0, fmt.Errorf("failed to set deadline: %w", err)
+		}
+	}
+	return client, host, uint16(port), nil
+}
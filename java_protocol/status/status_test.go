@@ -0,0 +1,321 @@
+package status_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	jp "github.com/go-mclib/protocol/java_protocol"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/java_protocol/status"
+	"github.com/go-mclib/protocol/java_protocol/testutil"
+)
+
+const testStatusJSON = `{
+	"version": {"name": "26.1", "protocol": 775},
+	"players": {"max": 20, "online": 1, "sample": [{"name": "Notch", "id": "069a79f4-44e9-4726-a5be-fca90e38aaf5"}]},
+	"description": {"text": "A ", "extra": [{"text": "Minecraft", "color": "gold"}, " Server"]},
+	"favicon": "data:image/png;base64,iVBORw0K\nGgo=",
+	"enforcesSecureChat": true,
+	"forgeData": {}
+}`
+
+func statusResponseWire(t *testing.T, json string) *jp.WirePacket {
+	t.Helper()
+	buf := ns.NewWriter()
+	if err := buf.WriteString(ns.String(json)); err != nil {
+		t.Fatal(err)
+	}
+	return &jp.WirePacket{PacketID: 0x00, Data: buf.Bytes()}
+}
+
+func TestQueryConn(t *testing.T) {
+	client, server := testutil.Pipe()
+	defer client.Close()
+
+	peer := testutil.NewPeer(server).
+		ExpectFunc(0x00, func(wire *jp.WirePacket) error {
+			buf := ns.NewReader(wire.Data)
+			if v, _ := buf.ReadVarInt(); v != status.ProtocolVersion {
+				t.Errorf("handshake protocol version = %d", v)
+			}
+			if host, _ := buf.ReadString(255); host != "mc.example.com" {
+				t.Errorf("handshake host = %q", host)
+			}
+			if port, _ := buf.ReadUint16(); port != 25565 {
+				t.Errorf("handshake port = %d", port)
+			}
+			if intent, _ := buf.ReadVarInt(); intent != 1 {
+				t.Errorf("handshake intent = %d, want 1 (status)", intent)
+			}
+			return nil
+		}).
+		SetState(jp.StateStatus).
+		ExpectID(0x00).
+		SendWire(statusResponseWire(t, testStatusJSON)).
+		Do("echo ping", func(c *jp.TCPClient) error {
+			wire, err := c.ReadWirePacket()
+			if err != nil {
+				return err
+			}
+			return c.WriteWirePacket(wire)
+		}).
+		Start()
+
+	res, err := status.QueryConn(client, "mc.example.com", 25565)
+	if err != nil {
+		t.Fatalf("QueryConn: %v", err)
+	}
+	peer.Finish(t)
+
+	if res.Version.Name != "26.1" || res.Version.Protocol != 775 {
+		t.Errorf("Version = %+v", res.Version)
+	}
+	if p := res.Players; p == nil || p.Max != 20 || p.Online != 1 || len(p.Sample) != 1 || p.Sample[0].Name != "Notch" {
+		t.Errorf("Players = %+v", p)
+	}
+	if got := res.Description.String(); got != "A Minecraft Server" {
+		t.Errorf("Description = %q", got)
+	}
+	if !res.EnforcesSecureChat {
+		t.Error("EnforcesSecureChat = false")
+	}
+	if !strings.Contains(res.Raw, "forgeData") {
+		t.Errorf("Raw = %q", res.Raw)
+	}
+	png, err := res.FaviconPNG()
+	if err != nil || !bytes.Equal(png, []byte("\x89PNG\r\n\x1a\n")) {
+		t.Errorf("FaviconPNG() = %x, %v", png, err)
+	}
+	if client.State() != jp.StateStatus {
+		t.Errorf("State() = %v", client.State())
+	}
+}
+
+func TestQuery(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	accepted := make(chan *testutil.Peer, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			close(accepted)
+			return
+		}
+		server := jp.NewTCPClient()
+		server.SetConn(jp.NewConn(conn))
+		accepted <- testutil.NewPeer(server).
+			ExpectID(0x00).
+			ExpectID(0x00).
+			SendWire(statusResponseWire(t, testStatusJSON)).
+			Do("echo ping", func(c *jp.TCPClient) error {
+				wire, err := c.ReadWirePacket()
+				if err != nil {
+					return err
+				}
+				return c.WriteWirePacket(wire)
+			}).
+			Start()
+	}()
+
+	res, err := status.Query(ln.Addr().String(), 5*time.Second)
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if peer := <-accepted; peer != nil {
+		peer.Finish(t)
+	}
+	if res.Version.Protocol != 775 || res.Latency <= 0 {
+		t.Errorf("result = %+v", res)
+	}
+}
+
+func TestQueryConn_PongMismatch(t *testing.T) {
+	client, server := testutil.Pipe()
+	defer client.Close()
+
+	pong := ns.NewWriter()
+	pong.WriteInt64(-1)
+	peer := testutil.NewPeer(server).
+		ExpectID(0x00).
+		ExpectID(0x00).
+		SendWire(statusResponseWire(t, `{"version":{"name":"26.1","protocol":775},"description":"hi"}`)).
+		ExpectID(0x01).
+		SendWire(&jp.WirePacket{PacketID: 0x01, Data: pong.Bytes()}).
+		Start()
+
+	_, err := status.QueryConn(client, "localhost", 25565)
+	if err == nil || !strings.Contains(err.Error(), "pong payload mismatch") {
+		t.Fatalf("expected pong mismatch, got %v", err)
+	}
+	peer.Finish(t)
+}
+
+func TestResponse_FaviconPNG(t *testing.T) {
+	var r status.Response
+	if png, err := r.FaviconPNG(); png != nil || err != nil {
+		t.Errorf("empty favicon = %x, %v", png, err)
+	}
+	r.Favicon = "data:image/jpeg;base64," + base64.StdEncoding.EncodeToString([]byte("x"))
+	if _, err := r.FaviconPNG(); err == nil {
+		t.Error("expected error for non-PNG data URI")
+	}
+}
+
+// legacyConn is a fake connection that records the request and replies with
+// a canned response.
+type legacyConn struct {
+	io.Reader
+	bytes.Buffer
+}
+
+func (c *legacyConn) Read(p []byte) (int, error) { return c.Reader.Read(p) }
+
+func legacyResponse(s string) []byte {
+	units := utf16.Encode([]rune(s))
+	var buf bytes.Buffer
+	buf.WriteByte(0xFF)
+	binary.Write(&buf, binary.BigEndian, uint16(len(units)))
+	binary.Write(&buf, binary.BigEndian, units)
+	return buf.Bytes()
+}
+
+func TestQueryLegacyConn(t *testing.T) {
+	conn := &legacyConn{Reader: bytes.NewReader(legacyResponse("§1\x0074\x001.6.4\x00§aA Minecraft Server\x003\x0020"))}
+	res, err := status.QueryLegacyConn(conn, "ab", 25565)
+	if err != nil {
+		t.Fatalf("QueryLegacyConn: %v", err)
+	}
+	want := status.LegacyResponse{ProtocolVersion: 74, Version: "1.6.4", MOTD: "§aA Minecraft Server", Online: 3, Max: 20}
+	if *res != want {
+		t.Errorf("response = %+v, want %+v", *res, want)
+	}
+	if d := res.Description(); d.String() != "A Minecraft Server" {
+		t.Errorf("Description() = %+v", d)
+	}
+
+	wantReq := []byte{0xFE, 0x01, 0xFA, 0x00, 0x0B}
+	for _, r := range "MC|PingHost" {
+		wantReq = append(wantReq, 0x00, byte(r))
+	}
+	wantReq = append(wantReq,
+		0x00, 0x0B, // 7 + 2*len("ab")
+		0x4A, // protocol 74
+		0x00, 0x02, 0x00, 'a', 0x00, 'b',
+		0x00, 0x00, 0x63, 0xDD, // 25565
+	)
+	if !bytes.Equal(conn.Bytes(), wantReq) {
+		t.Errorf("request =\n%x\nwant\n%x", conn.Bytes(), wantReq)
+	}
+}
+
+func TestQueryLegacyConn_Beta(t *testing.T) {
+	conn := &legacyConn{Reader: bytes.NewReader(legacyResponse("A Minecraft Server§0§20"))}
+	res, err := status.QueryLegacyConn(conn, "localhost", 25565)
+	if err != nil {
+		t.Fatalf("QueryLegacyConn: %v", err)
+	}
+	want := status.LegacyResponse{MOTD: "A Minecraft Server", Max: 20}
+	if *res != want {
+		t.Errorf("response = %+v, want %+v", *res, want)
+	}
+}
+
+func TestQueryLegacyConn_Invalid(t *testing.T) {
+	for name, resp := range map[string][]byte{
+		"kick packet id": {0x02, 0x00, 0x00},
+		"truncated":      legacyResponse("§1\x0074\x001.6.4")[:6],
+		"missing fields": legacyResponse("§1\x0074\x001.6.4\x00motd"),
+		"bad count":      legacyResponse("motd§x§20"),
+		"no separators":  legacyResponse("motd"),
+	} {
+		conn := &legacyConn{Reader: bytes.NewReader(resp)}
+		if _, err := status.QueryLegacyConn(conn, "localhost", 25565); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestLegacyRequest_RoundTrip(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+
+	want := status.LegacyResponse{ProtocolVersion: 74, Version: "1.6.4", MOTD: "§aHello", Online: 1, Max: 10}
+	errc := make(chan error, 1)
+	go func() {
+		defer server.Close()
+		req, err := status.ReadLegacyRequest(bufio.NewReader(server))
+		if err != nil {
+			errc <- err
+			return
+		}
+		if req.Beta || req.ProtocolVersion != 74 || req.Host != "mc.example.com" || req.Port != 25566 {
+			t.Errorf("request = %+v", req)
+		}
+		errc <- status.WriteLegacyResponse(server, req, &want)
+	}()
+
+	res, err := status.QueryLegacyConn(client, "mc.example.com", 25566)
+	if err != nil {
+		t.Fatalf("QueryLegacyConn: %v", err)
+	}
+	if err := <-errc; err != nil {
+		t.Fatalf("server: %v", err)
+	}
+	if *res != want {
+		t.Errorf("response = %+v, want %+v", *res, want)
+	}
+}
+
+func TestReadLegacyRequest_OldForms(t *testing.T) {
+	req, err := status.ReadLegacyRequest(bufio.NewReader(bytes.NewReader([]byte{0xFE})))
+	if err != nil || !req.Beta {
+		t.Fatalf("beta ping = %+v, %v", req, err)
+	}
+	var buf bytes.Buffer
+	resp := status.LegacyResponse{ProtocolVersion: 775, Version: "26.1", MOTD: "§aHello", Online: 1, Max: 10}
+	if err := status.WriteLegacyResponse(&buf, req, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if want := legacyResponse("Hello§1§10"); !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("beta response =\n%x\nwant\n%x", buf.Bytes(), want)
+	}
+
+	req, err = status.ReadLegacyRequest(bufio.NewReader(bytes.NewReader([]byte{0xFE, 0x01})))
+	if err != nil || req.Beta || req.Host != "" {
+		t.Fatalf("1.4 ping = %+v, %v", req, err)
+	}
+	buf.Reset()
+	if err := status.WriteLegacyResponse(&buf, req, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if want := legacyResponse("§1\x00775\x0026.1\x00§aHello\x001\x0010"); !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("1.4 response =\n%x\nwant\n%x", buf.Bytes(), want)
+	}
+}
+
+func TestReadLegacyRequest_Invalid(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"empty":          {},
+		"modern":         {0x10, 0x00},
+		"no 0x01":        {0xFE, 0x02},
+		"no 0xFA":        {0xFE, 0x01, 0xFB},
+		"wrong channel":  append([]byte{0xFE, 0x01, 0xFA}, legacyResponse("MC|Brand")[1:]...),
+		"truncated host": append(append([]byte{0xFE, 0x01, 0xFA}, legacyResponse("MC|PingHost")[1:]...), 0x00, 0x09, 0x4A, 0x00, 0x01),
+	} {
+		if _, err := status.ReadLegacyRequest(bufio.NewReader(bytes.NewReader(data))); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
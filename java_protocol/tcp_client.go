@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 // TCPClient is a Minecraft protocol client connection.
@@ -16,6 +17,8 @@ type TCPClient struct {
 	conn                 *Conn
 	state                State
 	compressionThreshold int
+	tcpOptions           TCPOptions
+	compressionStats     *CompressionStats
 
 	debug  bool
 	logger *log.Logger
@@ -27,6 +30,7 @@ func NewTCPClient() *TCPClient {
 		conn:                 nil,
 		state:                StateHandshake,
 		compressionThreshold: -1,
+		tcpOptions:           DefaultTCPOptions(),
 		debug:                false,
 		logger:               log.New(os.Stdout, "[TCPClient] ", log.LstdFlags),
 	}
@@ -41,15 +45,31 @@ func (c *TCPClient) Connect(address string) (host string, port string, err error
 		return "", "", fmt.Errorf("failed to resolve address: %w", err)
 	}
 
-	netConn, err := net.Dial("tcp", resolvedAddr)
+	netConn, err := c.tcpOptions.Dialer().Dial("tcp", resolvedAddr)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to connect to %s: %w", resolvedAddr, err)
 	}
+	if err := c.tcpOptions.Apply(netConn); err != nil {
+		netConn.Close()
+		return "", "", fmt.Errorf("failed to apply tcp options: %w", err)
+	}
 
 	c.conn = NewConn(netConn)
 	return net.SplitHostPort(resolvedAddr)
 }
 
+// SetTCPOptions sets the socket options used by Connect and the per-packet write timeout.
+// Must be called before Connect; connections passed to SetConn should be tuned
+// with TCPOptions.Apply by the caller.
+func (c *TCPClient) SetTCPOptions(opts TCPOptions) {
+	c.tcpOptions = opts
+}
+
+// TCPOptions returns the current socket options.
+func (c *TCPClient) TCPOptions() TCPOptions {
+	return c.tcpOptions
+}
+
 // SetConn sets the underlying connection (for testing or server-accepted connections).
 func (c *TCPClient) SetConn(conn *Conn) {
 	c.conn = conn
@@ -117,7 +137,10 @@ func (c *TCPClient) WritePacket(p Packet) error {
 
 	c.debugf("-> send: state=%v bound=%v id=0x%02X", p.State(), p.Bound(), int(p.ID()))
 
-	if err := wire.WriteTo(c.conn, c.compressionThreshold); err != nil {
+	if err := c.setWriteDeadline(); err != nil {
+		return err
+	}
+	if err := c.writeWire(wire); err != nil {
 		return fmt.Errorf("failed to write packet: %w", err)
 	}
 
@@ -136,7 +159,10 @@ func (c *TCPClient) WriteWirePacket(pkt *WirePacket) error {
 
 	c.debugf("-> send (wire): id=0x%02X data_len=%d", int(pkt.PacketID), len(pkt.Data))
 
-	if err := pkt.WriteTo(c.conn, c.compressionThreshold); err != nil {
+	if err := c.setWriteDeadline(); err != nil {
+		return err
+	}
+	if err := c.writeWire(pkt); err != nil {
 		return fmt.Errorf("failed to write wire packet: %w", err)
 	}
 
@@ -155,10 +181,40 @@ func (c *TCPClient) ReadWirePacket() (*WirePacket, error) {
 		return nil, err
 	}
 
+	if stats := c.compressionStats; stats != nil {
+		stats.recordWire(false, wire, wire.Length.Len()+int(wire.Length), c.compressionThreshold)
+	}
+
 	c.debugf("<- recv: id=0x%02X len=%d data_len=%d", int(wire.PacketID), int(wire.Length), len(wire.Data))
 	return wire, nil
 }
 
+// writeWire frames and writes a single packet, recording compression statistics if enabled.
+// Must be called with writeMu held.
+func (c *TCPClient) writeWire(wire *WirePacket) error {
+	if c.compressionStats == nil {
+		return wire.WriteTo(c.conn, c.compressionThreshold)
+	}
+	cw := &countingWriter{w: c.conn}
+	if err := wire.WriteTo(cw, c.compressionThreshold); err != nil {
+		return err
+	}
+	c.compressionStats.recordWire(true, wire, cw.n, c.compressionThreshold)
+	return nil
+}
+
+// setWriteDeadline arms the write deadline for the next packet, if a write timeout is configured.
+// Must be called with writeMu held.
+func (c *TCPClient) setWriteDeadline() error {
+	if c.tcpOptions.WriteTimeout <= 0 {
+		return nil
+	}
+	if err := c.conn.SetWriteDeadline(time.Now().Add(c.tcpOptions.WriteTimeout)); err != nil {
+		return fmt.Errorf("failed to set write deadline: %w", err)
+	}
+	return nil
+}
+
 func (c *TCPClient) debugf(format string, args ...any) {
 	if c.debug && c.logger != nil {
 		c.logger.Printf(format, args...)
@@ -0,0 +1,82 @@
+package java_protocol
+
+import (
+	"net"
+	"time"
+)
+
+// TCPOptions tunes the sockets used by the transport layer.
+// Zero values keep the operating system (or Go runtime) defaults,
+// except NoDelay, see DefaultTCPOptions.
+type TCPOptions struct {
+	// NoDelay disables Nagle's algorithm (TCP_NODELAY).
+	// Go enables it by default, which is what latency-sensitive clients want.
+	NoDelay bool
+	// KeepAlive is the TCP keep-alive probe interval.
+	// 0 uses the Go default (15s), negative disables keep-alive probes.
+	KeepAlive time.Duration
+	// ReadBufferSize and WriteBufferSize set the socket's kernel buffer sizes (SO_RCVBUF / SO_SNDBUF).
+	ReadBufferSize  int
+	WriteBufferSize int
+	// DialTimeout bounds how long Connect waits for the TCP handshake. 0 means no timeout.
+	DialTimeout time.Duration
+	// WriteTimeout is the write deadline applied before each packet is written.
+	// 0 means writes never time out.
+	WriteTimeout time.Duration
+}
+
+// DefaultTCPOptions returns the options used by NewTCPClient: Go's socket defaults
+// with TCP_NODELAY enabled.
+func DefaultTCPOptions() TCPOptions {
+	return TCPOptions{NoDelay: true}
+}
+
+// Dialer returns a net.Dialer configured with the dial timeout and keep-alive interval.
+func (o TCPOptions) Dialer() *net.Dialer {
+	return &net.Dialer{
+		Timeout:   o.DialTimeout,
+		KeepAlive: o.KeepAlive,
+	}
+}
+
+// ListenConfig returns a net.ListenConfig configured with the keep-alive interval.
+// Accepted connections should additionally be passed to Apply.
+func (o TCPOptions) ListenConfig() *net.ListenConfig {
+	return &net.ListenConfig{KeepAlive: o.KeepAlive}
+}
+
+// Apply applies the socket options to conn.
+// Connections that are not TCP connections (e.g. net.Pipe in tests) are left untouched.
+func (o TCPOptions) Apply(conn net.Conn) error {
+	tcpConn, ok := conn.(*net.TCPConn)
+	if !ok {
+		return nil
+	}
+
+	if err := tcpConn.SetNoDelay(o.NoDelay); err != nil {
+		return err
+	}
+	if o.KeepAlive < 0 {
+		if err := tcpConn.SetKeepAlive(false); err != nil {
+			return err
+		}
+	} else if o.KeepAlive > 0 {
+		if err := tcpConn.SetKeepAlive(true); err != nil {
+			return err
+		}
+		if err := tcpConn.SetKeepAlivePeriod(o.KeepAlive); err != nil {
+			return err
+		}
+	}
+	if o.ReadBufferSize > 0 {
+		if err := tcpConn.SetReadBuffer(o.ReadBufferSize); err != nil {
+			return err
+		}
+	}
+	if o.WriteBufferSize > 0 {
+		if err := tcpConn.SetWriteBuffer(o.WriteBufferSize); err != nil {
+			return err
+		}
+	}
+	return nil
+}
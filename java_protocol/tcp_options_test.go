@@ -0,0 +1,69 @@
+package java_protocol_test
+
+import (
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	jp "github.com/go-mclib/protocol/java_protocol"
+)
+
+func TestTCPOptions_Apply(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot listen on loopback: %v", err)
+	}
+	defer ln.Close()
+
+	go func() {
+		conn, err := ln.Accept()
+		if err == nil {
+			conn.Close()
+		}
+	}()
+
+	opts := jp.TCPOptions{
+		NoDelay:         true,
+		KeepAlive:       30 * time.Second,
+		ReadBufferSize:  64 * 1024,
+		WriteBufferSize: 64 * 1024,
+		DialTimeout:     time.Second,
+	}
+	conn, err := opts.Dialer().Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatalf("dial error: %v", err)
+	}
+	defer conn.Close()
+
+	if err := opts.Apply(conn); err != nil {
+		t.Errorf("apply error: %v", err)
+	}
+
+	// non-TCP connections are ignored
+	a, b := net.Pipe()
+	defer a.Close()
+	defer b.Close()
+	if err := opts.Apply(a); err != nil {
+		t.Errorf("apply on pipe should be a no-op, got: %v", err)
+	}
+}
+
+func TestTCPClient_WriteTimeout(t *testing.T) {
+	a, b := net.Pipe()
+	defer a.Close()
+	defer b.Close()
+
+	client := jp.NewTCPClient()
+	opts := jp.DefaultTCPOptions()
+	opts.WriteTimeout = 20 * time.Millisecond
+	client.SetTCPOptions(opts)
+	client.SetConn(jp.NewConn(a))
+
+	// nobody reads from b, so the write blocks until the deadline
+	err := client.WriteWirePacket(&jp.WirePacket{PacketID: 0x00, Data: []byte{0x01}})
+	if !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got: %v", err)
+	}
+}
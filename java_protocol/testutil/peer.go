@@ -0,0 +1,197 @@
+// Package testutil provides an in-memory transport and a scripted peer for testing
+// code built on java_protocol without real sockets.
+//
+//	client, server := testutil.Pipe()
+//	peer := testutil.NewPeer(server).
+//		Expect(&HandshakePacket{...}).
+//		Send(&StatusResponsePacket{...}).
+//		Start()
+//	// ... drive client ...
+//	peer.Finish(t)
+package testutil
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	jp "github.com/go-mclib/protocol/java_protocol"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// DefaultTimeout is how long Finish waits for the peer script to complete.
+const DefaultTimeout = 5 * time.Second
+
+// Pipe returns two connected TCPClients backed by net.Pipe.
+// Writes on one side block until the other side reads, like an unbuffered socket.
+func Pipe() (a, b *jp.TCPClient) {
+	connA, connB := net.Pipe()
+	a = jp.NewTCPClient()
+	a.SetConn(jp.NewConn(connA))
+	b = jp.NewTCPClient()
+	b.SetConn(jp.NewConn(connB))
+	return a, b
+}
+
+// Peer plays a script of sends and expectations against one end of a connection.
+// Steps are added with the builder methods and run in order on a separate
+// goroutine after Start.
+type Peer struct {
+	conn  *jp.TCPClient
+	steps []step
+	done  chan error
+}
+
+type step struct {
+	desc string
+	run  func(c *jp.TCPClient) error
+}
+
+// NewPeer creates a Peer using conn as its end of the connection.
+func NewPeer(conn *jp.TCPClient) *Peer {
+	return &Peer{conn: conn}
+}
+
+// Conn returns the peer's end of the connection.
+func (p *Peer) Conn() *jp.TCPClient {
+	return p.conn
+}
+
+// Do adds a custom step.
+func (p *Peer) Do(desc string, fn func(c *jp.TCPClient) error) *Peer {
+	p.steps = append(p.steps, step{desc: desc, run: fn})
+	return p
+}
+
+// Send adds a step writing pkt.
+func (p *Peer) Send(pkt jp.Packet) *Peer {
+	return p.Do(fmt.Sprintf("send 0x%02X", int(pkt.ID())), func(c *jp.TCPClient) error {
+		return c.WritePacket(pkt)
+	})
+}
+
+// SendWire adds a step writing a raw wire packet.
+func (p *Peer) SendWire(wire *jp.WirePacket) *Peer {
+	return p.Do(fmt.Sprintf("send wire 0x%02X", int(wire.PacketID)), func(c *jp.TCPClient) error {
+		return c.WriteWirePacket(wire)
+	})
+}
+
+// Expect adds a step reading the next packet and checking that it has the
+// same ID and encodes to exactly the same bytes as want.
+func (p *Peer) Expect(want jp.Packet) *Peer {
+	return p.Do(fmt.Sprintf("expect 0x%02X", int(want.ID())), func(c *jp.TCPClient) error {
+		expected, err := jp.ToWire(want)
+		if err != nil {
+			return fmt.Errorf("failed to serialize expected packet: %w", err)
+		}
+		got, err := readID(c, expected.PacketID)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(got.Data, expected.Data) {
+			return fmt.Errorf("packet data mismatch:\n got: %x\nwant: %x", []byte(got.Data), []byte(expected.Data))
+		}
+		return nil
+	})
+}
+
+// ExpectID adds a step reading the next packet and checking only its ID.
+func (p *Peer) ExpectID(id ns.VarInt) *Peer {
+	return p.ExpectFunc(id, nil)
+}
+
+// ExpectFunc adds a step reading the next packet, checking its ID and passing
+// it to fn (if not nil) for further assertions.
+func (p *Peer) ExpectFunc(id ns.VarInt, fn func(wire *jp.WirePacket) error) *Peer {
+	return p.Do(fmt.Sprintf("expect 0x%02X", int(id)), func(c *jp.TCPClient) error {
+		wire, err := readID(c, id)
+		if err != nil {
+			return err
+		}
+		if fn != nil {
+			return fn(wire)
+		}
+		return nil
+	})
+}
+
+// SetState adds a step switching the peer's connection state.
+func (p *Peer) SetState(state jp.State) *Peer {
+	return p.Do("set state "+state.String(), func(c *jp.TCPClient) error {
+		c.SetState(state)
+		return nil
+	})
+}
+
+// SetCompressionThreshold adds a step changing the peer's compression threshold,
+// e.g. right after sending or receiving Set Compression.
+func (p *Peer) SetCompressionThreshold(threshold int) *Peer {
+	return p.Do(fmt.Sprintf("set compression threshold %d", threshold), func(c *jp.TCPClient) error {
+		c.SetCompressionThreshold(threshold)
+		return nil
+	})
+}
+
+// Start runs the script on a new goroutine. Steps must not be added afterwards.
+func (p *Peer) Start() *Peer {
+	p.done = make(chan error, 1)
+	go func() {
+		p.done <- p.run()
+	}()
+	return p
+}
+
+func (p *Peer) run() error {
+	for i, s := range p.steps {
+		if err := s.run(p.conn); err != nil {
+			return fmt.Errorf("step %d (%s): %w", i, s.desc, err)
+		}
+	}
+	return nil
+}
+
+// Wait waits for the script to complete and returns the first failing step's error.
+// If timeout is positive and expires, the peer's connection is closed to unblock
+// the script and an error is returned.
+func (p *Peer) Wait(timeout time.Duration) error {
+	if p.done == nil {
+		return fmt.Errorf("peer not started")
+	}
+	if timeout <= 0 {
+		return <-p.done
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-p.done:
+		return err
+	case <-timer.C:
+		p.conn.Close()
+		err := <-p.done
+		return fmt.Errorf("peer script timed out after %v: %w", timeout, err)
+	}
+}
+
+// Finish waits up to DefaultTimeout for the script and fails t if a step failed.
+// Must be called from the test goroutine.
+func (p *Peer) Finish(t testing.TB) {
+	t.Helper()
+	if err := p.Wait(DefaultTimeout); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readID(c *jp.TCPClient, id ns.VarInt) (*jp.WirePacket, error) {
+	wire, err := c.ReadWirePacket()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read packet: %w", err)
+	}
+	if wire.PacketID != id {
+		return nil, fmt.Errorf("packet ID mismatch: expected 0x%02X, got 0x%02X", id, wire.PacketID)
+	}
+	return wire, nil
+}
@@ -0,0 +1,89 @@
+package testutil_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	jp "github.com/go-mclib/protocol/java_protocol"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/java_protocol/testutil"
+)
+
+// pingPacket mirrors the status Ping Request / Pong Response layout.
+type pingPacket struct {
+	Timestamp ns.Int64
+}
+
+func (p *pingPacket) ID() ns.VarInt   { return 0x01 }
+func (p *pingPacket) State() jp.State { return jp.StateStatus }
+func (p *pingPacket) Bound() jp.Bound { return jp.C2S }
+func (p *pingPacket) Read(buf *ns.PacketBuffer) error {
+	var err error
+	p.Timestamp, err = buf.ReadInt64()
+	return err
+}
+func (p *pingPacket) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteInt64(p.Timestamp)
+}
+
+func TestPeer_Script(t *testing.T) {
+	client, server := testutil.Pipe()
+	defer client.Close()
+
+	peer := testutil.NewPeer(server).
+		Expect(&pingPacket{Timestamp: 42}).
+		Send(&pingPacket{Timestamp: 42}).
+		SetCompressionThreshold(0).
+		ExpectFunc(0x01, func(wire *jp.WirePacket) error {
+			_, err := jp.ReadPacket[pingPacket](wire)
+			return err
+		}).
+		Start()
+
+	if err := client.WritePacket(&pingPacket{Timestamp: 42}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	wire, err := client.ReadWirePacket()
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	pong, err := jp.ReadPacket[pingPacket](wire)
+	if err != nil || pong.Timestamp != 42 {
+		t.Fatalf("unexpected pong: %+v, %v", pong, err)
+	}
+
+	client.SetCompressionThreshold(0)
+	if err := client.WritePacket(&pingPacket{Timestamp: 7}); err != nil {
+		t.Fatalf("write compressed: %v", err)
+	}
+
+	peer.Finish(t)
+}
+
+func TestPeer_Mismatch(t *testing.T) {
+	client, server := testutil.Pipe()
+	defer client.Close()
+
+	peer := testutil.NewPeer(server).Expect(&pingPacket{Timestamp: 1}).Start()
+	if err := client.WritePacket(&pingPacket{Timestamp: 2}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	err := peer.Wait(time.Second)
+	if err == nil || !strings.Contains(err.Error(), "step 0") {
+		t.Fatalf("expected step 0 to fail, got: %v", err)
+	}
+}
+
+func TestPeer_Timeout(t *testing.T) {
+	client, server := testutil.Pipe()
+	defer client.Close()
+
+	// the client never writes, so the expectation blocks until the timeout
+	peer := testutil.NewPeer(server).ExpectID(0x00).Start()
+	err := peer.Wait(20 * time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected timeout, got: %v", err)
+	}
+}
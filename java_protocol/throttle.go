@@ -0,0 +1,119 @@
+package java_protocol
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+)
+
+// DefaultThrottleWindow is the connection throttle of Bukkit-based servers
+// (bukkit.yml connection-throttle): one connection per IP every 4 seconds.
+// Connecting sooner gets a "Connection throttled!" disconnect.
+const DefaultThrottleWindow = 4 * time.Second
+
+// Throttle limits connections to one per window for each key. Servers call
+// Allow with the client's IP to reject reconnect floods; clients call Wait with
+// the server address so that many bots space out their logins. It is safe for
+// concurrent use.
+type Throttle struct {
+	mu     sync.Mutex
+	window time.Duration
+	last   map[string]time.Time
+	pruned time.Time
+}
+
+// NewThrottle creates a throttle allowing one connection per key every window.
+func NewThrottle(window time.Duration) *Throttle {
+	return &Throttle{window: window, last: make(map[string]time.Time)}
+}
+
+// Window returns the throttle window.
+func (t *Throttle) Window() time.Duration {
+	return t.window
+}
+
+// Allow reports whether a connection for key is allowed now. Like the Bukkit
+// throttle, every attempt counts: a rejected client has to wait a full window
+// after its last attempt.
+func (t *Throttle) Allow(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	t.prune(now)
+	last, seen := t.last[key]
+	t.last[key] = now
+	return !seen || now.Sub(last) >= t.window
+}
+
+// AllowAddr is Allow keyed by the IP of addr (e.g. conn.RemoteAddr()), so
+// that connections from different ports of the same host share a window.
+func (t *Throttle) AllowAddr(addr net.Addr) bool {
+	key := addr.String()
+	if host, _, err := net.SplitHostPort(key); err == nil {
+		key = host
+	}
+	return t.Allow(key)
+}
+
+// Wait blocks until a connection for key is allowed, or ctx is done. Waiting
+// callers are queued: each gets the next free slot, one window apart. A caller
+// whose ctx is done gives its slot back, unless a later caller queued behind it.
+func (t *Throttle) Wait(ctx context.Context, key string) error {
+	t.mu.Lock()
+	now := time.Now()
+	t.prune(now)
+	slot := now
+	prev, seen := t.last[key]
+	if seen && prev.Add(t.window).After(now) {
+		slot = prev.Add(t.window)
+	}
+	t.last[key] = slot
+	t.mu.Unlock()
+
+	release := func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if !t.last[key].Equal(slot) {
+			return
+		}
+		if seen {
+			t.last[key] = prev
+		} else {
+			delete(t.last, key)
+		}
+	}
+
+	wait := time.Until(slot)
+	if wait <= 0 {
+		if err := ctx.Err(); err != nil {
+			release()
+			return err
+		}
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		release()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// prune drops keys whose window has passed, at most once per window and only
+// once the map has grown enough to be worth scanning. Must be called with mu held.
+func (t *Throttle) prune(now time.Time) {
+	if len(t.last) < 1024 || now.Sub(t.pruned) < t.window {
+		return
+	}
+	t.pruned = now
+	for key, last := range t.last {
+		if now.Sub(last) >= t.window {
+			delete(t.last, key)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package java_protocol_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	jp "github.com/go-mclib/protocol/java_protocol"
+)
+
+func TestThrottle_Allow(t *testing.T) {
+	th := jp.NewThrottle(50 * time.Millisecond)
+	if !th.Allow("1.2.3.4") {
+		t.Fatal("first connection should be allowed")
+	}
+	if th.Allow("1.2.3.4") {
+		t.Error("second connection within the window should be throttled")
+	}
+	if !th.Allow("5.6.7.8") {
+		t.Error("other keys should not be throttled")
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if !th.Allow("1.2.3.4") {
+		t.Error("connection after the window should be allowed")
+	}
+}
+
+func TestThrottle_AllowAddr(t *testing.T) {
+	th := jp.NewThrottle(time.Minute)
+	a := &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 50000}
+	b := &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 50001}
+	if !th.AllowAddr(a) || th.AllowAddr(b) {
+		t.Error("connections from the same IP should share a window")
+	}
+}
+
+func TestThrottle_Wait(t *testing.T) {
+	const window = 20 * time.Millisecond
+	th := jp.NewThrottle(window)
+
+	start := time.Now()
+	var mu sync.Mutex
+	var times []time.Duration
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Go(func() {
+			if err := th.Wait(context.Background(), "mc.example.com"); err != nil {
+				t.Error(err)
+			}
+			mu.Lock()
+			times = append(times, time.Since(start))
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+
+	if len(times) != 3 {
+		t.Fatalf("got %d waits", len(times))
+	}
+	var latest time.Duration
+	for _, d := range times {
+		latest = max(latest, d)
+	}
+	if latest < 2*window {
+		t.Errorf("three queued waits finished after %v, want at least %v", latest, 2*window)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	th = jp.NewThrottle(time.Hour)
+	th.Allow("x")
+	if err := th.Wait(ctx, "x"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Wait() = %v, want context.Canceled", err)
+	}
+}
+
+func TestThrottle_WaitCancelReleasesSlot(t *testing.T) {
+	const window = 100 * time.Millisecond
+	th := jp.NewThrottle(window)
+	start := time.Now()
+	th.Allow("x")
+
+	// the cancelled caller would have taken the slot at start+window
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := th.Wait(ctx, "x"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Wait() = %v, want context.DeadlineExceeded", err)
+	}
+
+	if err := th.Wait(context.Background(), "x"); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed >= 3*window/2 {
+		t.Errorf("Wait after a cancelled caller finished after %v, want about %v", elapsed, window)
+	}
+}
@@ -0,0 +1,106 @@
+package nbt
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+)
+
+// Compression is the compression format of an NBT file.
+type Compression uint8
+
+const (
+	// CompressionNone is uncompressed NBT.
+	CompressionNone Compression = iota
+	// CompressionGzip is used by level.dat and player .dat files.
+	CompressionGzip
+	// CompressionZlib is used by chunks stored in region files.
+	CompressionZlib
+)
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionGzip:
+		return "gzip"
+	case CompressionZlib:
+		return "zlib"
+	default:
+		return fmt.Sprintf("Compression(%d)", uint8(c))
+	}
+}
+
+// DetectCompression sniffs the compression format from the magic bytes at the
+// start of data. Uncompressed NBT starts with a tag type (0x0A for a compound),
+// which never matches the gzip or zlib headers.
+func DetectCompression(data []byte) Compression {
+	if len(data) < 2 {
+		return CompressionNone
+	}
+	switch {
+	case data[0] == 0x1F && data[1] == 0x8B:
+		return CompressionGzip
+	case data[0]&0x0F == 8 && (uint16(data[0])<<8|uint16(data[1]))%31 == 0:
+		// deflate method with a valid header checksum (RFC 1950)
+		return CompressionZlib
+	default:
+		return CompressionNone
+	}
+}
+
+// DecodeCompressed reads file-format NBT (with root name) that may be gzip or
+// zlib compressed, as detected by DetectCompression. Limits such as
+// WithMaxBytes apply to the decompressed data.
+func DecodeCompressed(data []byte, opts ...ReaderOption) (Tag, string, error) {
+	var r io.Reader
+	switch DetectCompression(data) {
+	case CompressionGzip:
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read gzip header: %w", err)
+		}
+		defer zr.Close()
+		r = zr
+	case CompressionZlib:
+		zr, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read zlib header: %w", err)
+		}
+		defer zr.Close()
+		r = zr
+	default:
+		return DecodeFile(data, opts...)
+	}
+	return NewReaderFrom(r, opts...).ReadTag(false)
+}
+
+// EncodeGzip writes the tag in file format (with root name), gzip compressed
+// like level.dat and player .dat files.
+func EncodeGzip(tag Tag, rootName string) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := NewWriterTo(zw).WriteTag(tag, rootName, false); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeZlib writes the tag in file format (with root name), zlib compressed
+// like chunks in region files.
+func EncodeZlib(tag Tag, rootName string) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	if err := NewWriterTo(zw).WriteTag(tag, rootName, false); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
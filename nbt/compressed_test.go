@@ -0,0 +1,64 @@
+package nbt_test
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/go-mclib/protocol/nbt"
+)
+
+func TestDecodeCompressed_Fixture(t *testing.T) {
+	data, err := os.ReadFile(fixturePath)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if c := nbt.DetectCompression(data); c != nbt.CompressionGzip {
+		t.Fatalf("DetectCompression() = %v, want gzip", c)
+	}
+	decoded, _, err := nbt.DecodeCompressed(data)
+	if err != nil {
+		t.Fatalf("DecodeCompressed() error = %v", err)
+	}
+	if !reflect.DeepEqual(decoded, expected) {
+		t.Fatalf("decoded = %v, want %v", decoded, expected)
+	}
+}
+
+func TestDecodeCompressed_RoundTrip(t *testing.T) {
+	encoders := map[nbt.Compression]func(nbt.Tag, string) ([]byte, error){
+		nbt.CompressionNone: nbt.EncodeFile,
+		nbt.CompressionGzip: nbt.EncodeGzip,
+		nbt.CompressionZlib: nbt.EncodeZlib,
+	}
+	for compression, encode := range encoders {
+		data, err := encode(expected, "level")
+		if err != nil {
+			t.Fatalf("%v: encode error = %v", compression, err)
+		}
+		if got := nbt.DetectCompression(data); got != compression {
+			t.Errorf("%v: DetectCompression() = %v", compression, got)
+		}
+		decoded, rootName, err := nbt.DecodeCompressed(data)
+		if err != nil {
+			t.Fatalf("%v: DecodeCompressed() error = %v", compression, err)
+		}
+		if rootName != "level" || !reflect.DeepEqual(decoded, expected) {
+			t.Errorf("%v: got root %q, tag %v", compression, rootName, decoded)
+		}
+	}
+}
+
+func TestDecodeCompressed_Limits(t *testing.T) {
+	big := nbt.Compound{"data": make(nbt.ByteArray, 1<<16)}
+	data, err := nbt.EncodeGzip(big, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := nbt.DecodeCompressed(data, nbt.WithMaxBytes(1024)); err == nil {
+		t.Error("expected the byte limit to apply to decompressed data")
+	}
+	if _, _, err := nbt.DecodeCompressed(data[:10]); err == nil {
+		t.Error("expected error for truncated gzip data")
+	}
+}
@@ -0,0 +1,306 @@
+package nbt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// JSONOption configures ToJSON and FromJSON.
+type JSONOption func(*jsonOptions)
+
+type jsonOptions struct {
+	typeHints bool
+}
+
+// WithTypeHints makes the JSON conversion keep NBT types that plain JSON
+// cannot express. ToJSON wraps such values in an object
+//
+//	{"$type": "long", "value": 5}
+//
+// and FromJSON unwraps them again. Byte, Short, Long, Float and the typed
+// arrays are always wrapped; Double only when it is a whole number (which
+// would otherwise read back as Int). Int, String, List and Compound values
+// are written as plain JSON. The type names are byte, short, long, float,
+// double, byte_array, int_array and long_array.
+//
+// The element type of empty lists is not preserved, and a compound with
+// exactly the keys "$type" and "value" reads back as a typed value.
+func WithTypeHints() JSONOption {
+	return func(o *jsonOptions) {
+		o.typeHints = true
+	}
+}
+
+const (
+	jsonTypeKey  = "$type"
+	jsonValueKey = "value"
+)
+
+// ToJSON converts an NBT tag tree to JSON, like the vanilla JsonOps:
+//   - Compound → JSON object (keys sorted)
+//   - List, ByteArray, IntArray, LongArray → JSON array
+//   - String → JSON string
+//   - Byte, Short, Int, Long, Float, Double → JSON number
+//
+// Without WithTypeHints the numeric types are lost, so FromJSON reads the
+// numbers back as Int or Double. NaN and infinite floats cannot be
+// represented and return an error.
+func ToJSON(tag Tag, opts ...JSONOption) ([]byte, error) {
+	var o jsonOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	v, err := tagToJSONValue(tag, o.typeHints)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+func tagToJSONValue(tag Tag, hints bool) (any, error) {
+	typed := func(name string, v any) any {
+		if !hints {
+			return v
+		}
+		return map[string]any{jsonTypeKey: name, jsonValueKey: v}
+	}
+
+	switch v := tag.(type) {
+	case Byte:
+		return typed("byte", int8(v)), nil
+	case Short:
+		return typed("short", int16(v)), nil
+	case Int:
+		return int32(v), nil
+	case Long:
+		return typed("long", int64(v)), nil
+	case Float:
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return nil, fmt.Errorf("unsupported float value %v", v)
+		}
+		return typed("float", float32(v)), nil
+	case Double:
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return nil, fmt.Errorf("unsupported double value %v", v)
+		}
+		if float64(v) == math.Trunc(float64(v)) {
+			return typed("double", float64(v)), nil
+		}
+		return float64(v), nil
+	case String:
+		return string(v), nil
+	case ByteArray:
+		arr := make([]int8, len(v))
+		for i, b := range v {
+			arr[i] = int8(b)
+		}
+		return typed("byte_array", arr), nil
+	case IntArray:
+		return typed("int_array", []int32(v)), nil
+	case LongArray:
+		return typed("long_array", []int64(v)), nil
+	case *List:
+		return tagToJSONValue(*v, hints)
+	case List:
+		arr := make([]any, len(v.Elements))
+		for i, elem := range v.Elements {
+			val, err := tagToJSONValue(elem, hints)
+			if err != nil {
+				return nil, fmt.Errorf("index %d: %w", i, err)
+			}
+			arr[i] = val
+		}
+		return arr, nil
+	case Compound:
+		obj := make(map[string]any, len(v))
+		for k, elem := range v {
+			val, err := tagToJSONValue(elem, hints)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: %w", k, err)
+			}
+			obj[k] = val
+		}
+		return obj, nil
+	default:
+		return nil, fmt.Errorf("unsupported tag type %T", tag)
+	}
+}
+
+// FromJSON converts a JSON value to an NBT tag tree.
+// This matches the vanilla server's DynamicOps<Tag> behavior:
+//   - JSON object → Compound
+//   - JSON array → List
+//   - JSON string → String
+//   - JSON integer → Int (if fits in int32)
+//   - JSON float → Double
+//   - JSON boolean → Byte (1/0)
+//   - JSON null → Byte(0)
+//
+// With WithTypeHints, values wrapped by ToJSON are read back as their
+// original type.
+func FromJSON(data []byte, opts ...JSONOption) (Tag, error) {
+	var o jsonOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var v any
+	if o.typeHints {
+		// keep numbers exact so that longs survive the round trip
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(&v); err != nil {
+			return nil, err
+		}
+	} else if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return jsonValueToTag(v, o.typeHints)
+}
+
+func jsonValueToTag(v any, hints bool) (Tag, error) {
+	switch val := v.(type) {
+	case map[string]any:
+		if name, ok := val[jsonTypeKey].(string); ok && hints && len(val) == 2 {
+			if inner, ok := val[jsonValueKey]; ok {
+				return typedJSONValueToTag(name, inner)
+			}
+		}
+		compound := make(Compound, len(val))
+		for k, child := range val {
+			tag, err := jsonValueToTag(child, hints)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: %w", k, err)
+			}
+			compound[k] = tag
+		}
+		return compound, nil
+	case []any:
+		if len(val) == 0 {
+			return &List{ElementType: TagEnd}, nil
+		}
+		tags := make([]Tag, len(val))
+		for i, child := range val {
+			tag, err := jsonValueToTag(child, hints)
+			if err != nil {
+				return nil, fmt.Errorf("index %d: %w", i, err)
+			}
+			tags[i] = tag
+		}
+		return &List{ElementType: tags[0].ID(), Elements: tags}, nil
+	case string:
+		return String(val), nil
+	case json.Number:
+		f, err := val.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return jsonValueToTag(f, hints)
+	case float64:
+		if val == math.Trunc(val) && val >= math.MinInt32 && val <= math.MaxInt32 {
+			return Int(int32(val)), nil
+		}
+		return Double(val), nil
+	case bool:
+		if val {
+			return Byte(1), nil
+		}
+		return Byte(0), nil
+	case nil:
+		return Byte(0), nil
+	default:
+		return nil, fmt.Errorf("unsupported JSON type %T", v)
+	}
+}
+
+// typedJSONValueToTag converts the value of a {"$type": ..., "value": ...}
+// object written by ToJSON with WithTypeHints.
+func typedJSONValueToTag(name string, v any) (Tag, error) {
+	switch name {
+	case "byte":
+		n, err := jsonInt(v, 8)
+		return Byte(n), err
+	case "short":
+		n, err := jsonInt(v, 16)
+		return Short(n), err
+	case "long":
+		n, err := jsonInt(v, 64)
+		return Long(n), err
+	case "float":
+		f, err := jsonFloat(v)
+		return Float(f), err
+	case "double":
+		f, err := jsonFloat(v)
+		return Double(f), err
+	case "byte_array":
+		arr, err := jsonArray(v, 8)
+		if err != nil {
+			return nil, err
+		}
+		out := make(ByteArray, len(arr))
+		for i, n := range arr {
+			out[i] = byte(n)
+		}
+		return out, nil
+	case "int_array":
+		arr, err := jsonArray(v, 32)
+		if err != nil {
+			return nil, err
+		}
+		out := make(IntArray, len(arr))
+		for i, n := range arr {
+			out[i] = int32(n)
+		}
+		return out, nil
+	case "long_array":
+		arr, err := jsonArray(v, 64)
+		if err != nil {
+			return nil, err
+		}
+		return LongArray(arr), nil
+	default:
+		return nil, fmt.Errorf("unknown type hint %q", name)
+	}
+}
+
+// jsonInt returns v as an integer that fits in the given number of bits.
+// Type hints are only read with UseNumber, so numbers are json.Number.
+func jsonInt(v any, bits int) (int64, error) {
+	num, ok := v.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("expected a number, got %T", v)
+	}
+	n, err := num.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer %s", num)
+	}
+	if bits < 64 && (n < -1<<(bits-1) || n >= 1<<(bits-1)) {
+		return 0, fmt.Errorf("integer %d out of range for %d bits", n, bits)
+	}
+	return n, nil
+}
+
+func jsonFloat(v any) (float64, error) {
+	num, ok := v.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("expected a number, got %T", v)
+	}
+	return num.Float64()
+}
+
+func jsonArray(v any, bits int) ([]int64, error) {
+	arr, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected an array, got %T", v)
+	}
+	out := make([]int64, len(arr))
+	for i, elem := range arr {
+		n, err := jsonInt(elem, bits)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		out[i] = n
+	}
+	return out, nil
+}
@@ -0,0 +1,126 @@
+package nbt_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-mclib/protocol/nbt"
+)
+
+func jsonTestTag() nbt.Compound {
+	return nbt.Compound{
+		"byte":   nbt.Byte(-3),
+		"short":  nbt.Short(300),
+		"int":    nbt.Int(70000),
+		"long":   nbt.Long(math.MaxInt64),
+		"float":  nbt.Float(0.1),
+		"double": nbt.Double(2.5),
+		"whole":  nbt.Double(64),
+		"name":   nbt.String("Steve"),
+		"bytes":  nbt.ByteArray{0, 1, 0xFF},
+		"ints":   nbt.IntArray{-1, 2},
+		"longs":  nbt.LongArray{math.MinInt64, 4},
+		"list": &nbt.List{ElementType: nbt.TagLong, Elements: []nbt.Tag{
+			nbt.Long(1), nbt.Long(2),
+		}},
+		"nested": nbt.Compound{"flag": nbt.Byte(1)},
+	}
+}
+
+func TestToJSON(t *testing.T) {
+	got, err := nbt.ToJSON(jsonTestTag())
+	if err != nil {
+		t.Fatalf("ToJSON: %v", err)
+	}
+	want := `{"byte":-3,"bytes":[0,1,-1],"double":2.5,"float":0.1,"int":70000,"ints":[-1,2],` +
+		`"list":[1,2],"long":9223372036854775807,"longs":[-9223372036854775808,4],` +
+		`"name":"Steve","nested":{"flag":1},"short":300,"whole":64}`
+	if string(got) != want {
+		t.Errorf("ToJSON =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestToJSONTypeHints(t *testing.T) {
+	got, err := nbt.ToJSON(nbt.Compound{
+		"a": nbt.Long(5),
+		"b": nbt.Int(5),
+		"c": nbt.Double(5),
+		"d": nbt.Double(5.5),
+		"e": nbt.IntArray{1},
+	}, nbt.WithTypeHints())
+	if err != nil {
+		t.Fatalf("ToJSON: %v", err)
+	}
+	want := `{"a":{"$type":"long","value":5},"b":5,"c":{"$type":"double","value":5},"d":5.5,` +
+		`"e":{"$type":"int_array","value":[1]}}`
+	if string(got) != want {
+		t.Errorf("ToJSON =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestJSONRoundTripTypeHints(t *testing.T) {
+	original := jsonTestTag()
+	data, err := nbt.ToJSON(original, nbt.WithTypeHints())
+	if err != nil {
+		t.Fatalf("ToJSON: %v", err)
+	}
+	tag, err := nbt.FromJSON(data, nbt.WithTypeHints())
+	if err != nil {
+		t.Fatalf("FromJSON: %v", err)
+	}
+	if got, want := nbt.Stringify(tag), nbt.Stringify(original); got != want {
+		t.Errorf("round trip =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestJSONRoundTripLossy(t *testing.T) {
+	data, err := nbt.ToJSON(jsonTestTag())
+	if err != nil {
+		t.Fatalf("ToJSON: %v", err)
+	}
+	tag, err := nbt.FromJSON(data)
+	if err != nil {
+		t.Fatalf("FromJSON: %v", err)
+	}
+	c := tag.(nbt.Compound)
+	// without hints, numbers come back as Int or Double
+	for key, want := range map[string]byte{
+		"byte":  nbt.TagInt,
+		"short": nbt.TagInt,
+		"whole": nbt.TagInt,
+		"long":  nbt.TagDouble,
+		"float": nbt.TagDouble,
+		"bytes": nbt.TagList,
+	} {
+		if got := c[key].ID(); got != want {
+			t.Errorf("%s: type = %s, want %s", key, nbt.TagName(got), nbt.TagName(want))
+		}
+	}
+}
+
+func TestFromJSONIgnoresHintsByDefault(t *testing.T) {
+	tag, err := nbt.FromJSON([]byte(`{"$type":"long","value":5}`))
+	if err != nil {
+		t.Fatalf("FromJSON: %v", err)
+	}
+	if tag.ID() != nbt.TagCompound {
+		t.Errorf("type = %s, want Compound", nbt.TagName(tag.ID()))
+	}
+}
+
+func TestJSONTypeHintErrors(t *testing.T) {
+	for _, input := range []string{
+		`{"$type":"byte","value":128}`,
+		`{"$type":"long","value":1.5}`,
+		`{"$type":"int_array","value":[1,"x"]}`,
+		`{"$type":"uuid","value":1}`,
+	} {
+		if _, err := nbt.FromJSON([]byte(input), nbt.WithTypeHints()); err == nil {
+			t.Errorf("FromJSON(%s): expected error", input)
+		}
+	}
+
+	if _, err := nbt.ToJSON(nbt.Double(math.NaN())); err == nil {
+		t.Error("ToJSON(NaN): expected error")
+	}
+}
@@ -361,3 +361,118 @@ func TestEmptyList(t *testing.T) {
 		t.Errorf("list length = %d, want 0", decodedList.Len())
 	}
 }
+
+func TestReadRaw(t *testing.T) {
+	compound := nbt.Compound{
+		"action": nbt.String("minecraft:custom"),
+		"payload": nbt.Compound{
+			"ids":  nbt.IntArray{1, 2, 3},
+			"tags": nbt.List{ElementType: nbt.TagString, Elements: []nbt.Tag{nbt.String("a")}},
+		},
+	}
+
+	for _, network := range []bool{true, false} {
+		data, err := nbt.Encode(compound, "root", network)
+		if err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+		// trailing bytes must not be consumed
+		stream := append(bytes.Clone(data), 0xAB, 0xCD)
+
+		src := bytes.NewReader(stream)
+		raw, err := nbt.NewReaderFrom(src).ReadRaw(network)
+		if err != nil {
+			t.Fatalf("ReadRaw(network=%v) error = %v", network, err)
+		}
+		if !bytes.Equal(raw, data) {
+			t.Errorf("ReadRaw(network=%v) = %x, want %x", network, raw, data)
+		}
+		if src.Len() != 2 {
+			t.Errorf("ReadRaw(network=%v) left %d bytes, want 2", network, src.Len())
+		}
+	}
+
+	raw, err := nbt.NewReader([]byte{nbt.TagEnd}).ReadRaw(true)
+	if err != nil || !bytes.Equal(raw, []byte{nbt.TagEnd}) {
+		t.Errorf("ReadRaw(TAG_End) = %x, %v", raw, err)
+	}
+}
+
+func TestReadRawDepthLimit(t *testing.T) {
+	var tag nbt.Tag = nbt.Compound{}
+	for range 10 {
+		tag = nbt.Compound{"nested": tag}
+	}
+	data, err := nbt.EncodeNetwork(tag)
+	if err != nil {
+		t.Fatalf("EncodeNetwork() error = %v", err)
+	}
+
+	if _, err := nbt.NewReader(data, nbt.WithMaxDepth(5)).ReadRaw(true); err == nil {
+		t.Error("expected depth limit error")
+	}
+}
+
+func TestMalformedLengths(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"negative byte array", []byte{nbt.TagByteArray, 0xFF, 0xFF, 0xFF, 0xFF}},
+		{"negative int array", []byte{nbt.TagIntArray, 0x80, 0x00, 0x00, 0x00}},
+		{"negative long array", []byte{nbt.TagLongArray, 0xFF, 0xFF, 0xFF, 0xFE}},
+		{"negative list", []byte{nbt.TagList, nbt.TagInt, 0xFF, 0xFF, 0xFF, 0xFF}},
+		{"oversized byte array", []byte{nbt.TagByteArray, 0x7F, 0xFF, 0xFF, 0xFF}},
+		{"oversized list", []byte{nbt.TagList, nbt.TagLong, 0x01, 0x00, 0x00, 0x00}},
+		{"list of end tags", []byte{nbt.TagList, nbt.TagEnd, 0x7F, 0xFF, 0xFF, 0xFF}},
+		{"list of unknown tags", []byte{nbt.TagList, 0x63, 0x00, 0x00, 0x00, 0x01}},
+		{"unknown tag", []byte{nbt.TagCompound, 0x63, 0x00, 0x00}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := nbt.DecodeNetwork(tt.data); err == nil {
+				t.Error("DecodeNetwork() should fail")
+			}
+			if _, err := nbt.NewReader(tt.data).ReadRaw(true); err == nil {
+				t.Error("ReadRaw() should fail")
+			}
+			if err := nbt.VisitReader(nbt.NewReader(tt.data), nbt.BaseVisitor{}, true); err == nil {
+				t.Error("VisitReader() should fail")
+			}
+		})
+	}
+}
+
+func FuzzDecodeNetwork(f *testing.F) {
+	compound := nbt.Compound{
+		"name":  nbt.String("test"),
+		"bytes": nbt.ByteArray{1, 2, 3},
+		"ints":  nbt.IntArray{1, 2},
+		"longs": nbt.LongArray{1},
+		"list":  nbt.List{ElementType: nbt.TagDouble, Elements: []nbt.Tag{nbt.Double(1.5)}},
+	}
+	data, err := nbt.EncodeNetwork(compound)
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(data)
+	f.Add([]byte{nbt.TagByteArray, 0xFF, 0xFF, 0xFF, 0xFF})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		tag, err := nbt.DecodeNetwork(data)
+		raw, rawErr := nbt.NewReader(data).ReadRaw(true)
+		if (err == nil) != (rawErr == nil) {
+			t.Fatalf("DecodeNetwork() error = %v, ReadRaw() error = %v", err, rawErr)
+		}
+		nbt.VisitReader(nbt.NewReader(data), nbt.BaseVisitor{}, true)
+		if err != nil {
+			return
+		}
+		if _, err := nbt.EncodeNetwork(tag); err != nil {
+			t.Fatalf("EncodeNetwork() error = %v", err)
+		}
+		if !bytes.Equal(raw, data[:len(raw)]) {
+			t.Fatalf("ReadRaw() = %x, not a prefix of the input", raw)
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package nbt
+
+import (
+	"fmt"
+	"io"
+)
+
+// ReadRaw reads a single tag and returns its exact encoded bytes without building
+// the tag tree. The returned bytes include the tag type and, in file format, the
+// root name, so they can be written back verbatim or decoded later with Decode.
+//
+// Depth and byte limits configured on the Reader still apply.
+func (r *Reader) ReadRaw(network bool) ([]byte, error) {
+	capture := &captureReader{r: r.r}
+	r.r = capture
+	defer func() { r.r = capture.r }()
+
+	tagType, err := r.readByte()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tag type: %w", err)
+	}
+	if tagType == TagEnd {
+		return capture.buf, nil
+	}
+	if !network {
+		if _, err := r.readString(); err != nil {
+			return nil, fmt.Errorf("failed to read root name: %w", err)
+		}
+	}
+	if err := skipTagPayload(r, tagType); err != nil {
+		return nil, err
+	}
+	return capture.buf, nil
+}
+
+// captureReader records every byte read through it.
+type captureReader struct {
+	r   io.Reader
+	buf []byte
+}
+
+func (c *captureReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.buf = append(c.buf, p[:n]...)
+	return n, err
+}
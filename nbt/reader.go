@@ -162,8 +162,8 @@ func (r *Reader) readByteArray() (ByteArray, error) {
 	if err != nil {
 		return nil, err
 	}
-	if length < 0 {
-		return nil, fmt.Errorf("negative byte array length: %d", length)
+	if err := r.checkLength("byte array", length, 1); err != nil {
+		return nil, err
 	}
 
 	data := make([]byte, length)
@@ -188,8 +188,8 @@ func (r *Reader) readList() (List, error) {
 	if err != nil {
 		return List{}, err
 	}
-	if length < 0 {
-		return List{}, fmt.Errorf("negative list length: %d", length)
+	if err := r.checkListLength(elemType, length); err != nil {
+		return List{}, err
 	}
 
 	elements := make([]Tag, length)
@@ -243,8 +243,8 @@ func (r *Reader) readIntArray() (IntArray, error) {
 	if err != nil {
 		return nil, err
 	}
-	if length < 0 {
-		return nil, fmt.Errorf("negative int array length: %d", length)
+	if err := r.checkLength("int array", length, 4); err != nil {
+		return nil, err
 	}
 
 	data := make(IntArray, length)
@@ -263,8 +263,8 @@ func (r *Reader) readLongArray() (LongArray, error) {
 	if err != nil {
 		return nil, err
 	}
-	if length < 0 {
-		return nil, fmt.Errorf("negative long array length: %d", length)
+	if err := r.checkLength("long array", length, 8); err != nil {
+		return nil, err
 	}
 
 	data := make(LongArray, length)
@@ -386,6 +386,49 @@ func (r *Reader) popDepth() {
 	r.depth--
 }
 
+// checkLength validates a length prefix before anything is allocated for it: it
+// must not be negative, and the smallest possible encoding of that many elements
+// must fit in the remaining byte limit.
+func (r *Reader) checkLength(kind string, length int32, elemSize int64) error {
+	if length < 0 {
+		return fmt.Errorf("negative %s length: %d", kind, length)
+	}
+	if r.maxBytes > 0 && int64(length)*elemSize > r.maxBytes-r.bytesRead {
+		return errors.New("NBT data exceeds maximum byte limit")
+	}
+	return nil
+}
+
+// checkListLength validates a list length prefix like checkLength. Only empty
+// lists may have an end or unknown element type, as those carry no payload.
+func (r *Reader) checkListLength(elemType byte, length int32) error {
+	if err := r.checkLength("list", length, minPayloadSize(elemType)); err != nil {
+		return err
+	}
+	if length > 0 && (elemType == TagEnd || elemType > TagLongArray) {
+		return fmt.Errorf("invalid list element type %d for length %d", elemType, length)
+	}
+	return nil
+}
+
+// minPayloadSize returns the smallest encoded payload size of a tag type.
+func minPayloadSize(tagType byte) int64 {
+	switch tagType {
+	case TagByte, TagCompound:
+		return 1
+	case TagShort, TagString:
+		return 2
+	case TagInt, TagFloat, TagByteArray, TagIntArray, TagLongArray:
+		return 4
+	case TagLong, TagDouble:
+		return 8
+	case TagList:
+		return 5
+	default:
+		return 0
+	}
+}
+
 func (r *Reader) accountBytes(n int64) error {
 	r.bytesRead += n
 	if r.maxBytes > 0 && r.bytesRead > r.maxBytes {
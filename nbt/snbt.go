@@ -1,14 +1,15 @@
 package nbt
 
 import (
-	"encoding/json"
 	"fmt"
-	"math"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 )
 
 // Stringify converts an NBT tag to its SNBT (Stringified NBT) representation.
+// Compound keys are written in sorted order, so the output is deterministic.
 // https://minecraft.wiki/w/NBT_format#SNBT_format
 func Stringify(tag Tag) string {
 	var sb strings.Builder
@@ -62,6 +63,8 @@ func writeTag(sb *strings.Builder, tag Tag) {
 		}
 		sb.WriteByte(']')
 	case *List:
+		writeTag(sb, *v)
+	case List:
 		sb.WriteByte('[')
 		for i, elem := range v.Elements {
 			if i > 0 {
@@ -72,19 +75,17 @@ func writeTag(sb *strings.Builder, tag Tag) {
 		sb.WriteByte(']')
 	case Compound:
 		sb.WriteByte('{')
-		first := true
-		for k, child := range v {
-			if !first {
+		for i, k := range slices.Sorted(maps.Keys(v)) {
+			if i > 0 {
 				sb.WriteByte(',')
 			}
-			first = false
 			if needsQuoting(k) {
 				writeQuotedString(sb, k)
 			} else {
 				sb.WriteString(k)
 			}
 			sb.WriteByte(':')
-			writeTag(sb, child)
+			writeTag(sb, v[k])
 		}
 		sb.WriteByte('}')
 	case End:
@@ -100,8 +101,22 @@ func writeQuotedString(sb *strings.Builder, s string) {
 			sb.WriteString(`\"`)
 		case '\\':
 			sb.WriteString(`\\`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\f':
+			sb.WriteString(`\f`)
 		default:
-			sb.WriteRune(r)
+			if r < 0x20 || r == 0x7F {
+				fmt.Fprintf(sb, `\x%02X`, r)
+			} else {
+				sb.WriteRune(r)
+			}
 		}
 	}
 	sb.WriteByte('"')
@@ -124,64 +139,3 @@ func isUnquotedChar(r rune) bool {
 	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') ||
 		(r >= '0' && r <= '9') || r == '_' || r == '-' || r == '.' || r == '+'
 }
-
-// FromJSON converts a JSON value to an NBT tag tree.
-// This matches the vanilla server's DynamicOps<Tag> behavior:
-//   - JSON object → Compound
-//   - JSON array → List
-//   - JSON string → String
-//   - JSON integer → Int (if fits in int32)
-//   - JSON float → Double
-//   - JSON boolean → Byte (1/0)
-//   - JSON null → Byte(0)
-func FromJSON(data []byte) (Tag, error) {
-	var v any
-	if err := json.Unmarshal(data, &v); err != nil {
-		return nil, err
-	}
-	return jsonValueToTag(v)
-}
-
-func jsonValueToTag(v any) (Tag, error) {
-	switch val := v.(type) {
-	case map[string]any:
-		compound := make(Compound, len(val))
-		for k, child := range val {
-			tag, err := jsonValueToTag(child)
-			if err != nil {
-				return nil, fmt.Errorf("key %q: %w", k, err)
-			}
-			compound[k] = tag
-		}
-		return compound, nil
-	case []any:
-		if len(val) == 0 {
-			return &List{ElementType: TagEnd}, nil
-		}
-		tags := make([]Tag, len(val))
-		for i, child := range val {
-			tag, err := jsonValueToTag(child)
-			if err != nil {
-				return nil, fmt.Errorf("index %d: %w", i, err)
-			}
-			tags[i] = tag
-		}
-		return &List{ElementType: tags[0].ID(), Elements: tags}, nil
-	case string:
-		return String(val), nil
-	case float64:
-		if val == math.Trunc(val) && val >= math.MinInt32 && val <= math.MaxInt32 {
-			return Int(int32(val)), nil
-		}
-		return Double(val), nil
-	case bool:
-		if val {
-			return Byte(1), nil
-		}
-		return Byte(0), nil
-	case nil:
-		return Byte(0), nil
-	default:
-		return nil, fmt.Errorf("unsupported JSON type %T", v)
-	}
-}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 // Parse parses an SNBT (stringified NBT) string into a Tag tree. It accepts the
@@ -239,8 +240,9 @@ func (p *snbtParser) quoted() (string, error) {
 			if p.pos >= len(p.s) {
 				return "", fmt.Errorf("snbt: dangling escape")
 			}
-			sb.WriteByte(p.s[p.pos]) // lenient: keep the escaped char verbatim
-			p.pos++
+			if err := p.escape(&sb); err != nil {
+				return "", err
+			}
 		case q:
 			p.pos++
 			return sb.String(), nil
@@ -252,6 +254,48 @@ func (p *snbtParser) quoted() (string, error) {
 	return "", fmt.Errorf("snbt: unterminated string")
 }
 
+// escape decodes the escape sequence after a backslash: the vanilla escapes
+// \b \s \t \n \f \r and \xHH, \uHHHH, \UHHHHHHHH code points. Any other
+// escaped character (including quotes and backslashes) is kept verbatim.
+func (p *snbtParser) escape(sb *strings.Builder) error {
+	c := p.s[p.pos]
+	p.pos++
+	switch c {
+	case 'b':
+		sb.WriteByte('\b')
+	case 's':
+		sb.WriteByte(' ')
+	case 't':
+		sb.WriteByte('\t')
+	case 'n':
+		sb.WriteByte('\n')
+	case 'f':
+		sb.WriteByte('\f')
+	case 'r':
+		sb.WriteByte('\r')
+	case 'x', 'u', 'U':
+		n := 2
+		switch c {
+		case 'u':
+			n = 4
+		case 'U':
+			n = 8
+		}
+		if p.pos+n > len(p.s) {
+			return fmt.Errorf("snbt: truncated \\%c escape at offset %d", c, p.pos)
+		}
+		v, err := strconv.ParseUint(p.s[p.pos:p.pos+n], 16, 32)
+		if err != nil || v > utf8.MaxRune {
+			return fmt.Errorf("snbt: invalid \\%c escape %q at offset %d", c, p.s[p.pos:p.pos+n], p.pos)
+		}
+		sb.WriteRune(rune(v))
+		p.pos += n
+	default:
+		sb.WriteByte(c)
+	}
+	return nil
+}
+
 func (p *snbtParser) readToken() string {
 	start := p.pos
 	for p.pos < len(p.s) && isBareword(p.s[p.pos]) {
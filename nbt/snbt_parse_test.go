@@ -73,9 +73,54 @@ func TestParseRoundTripBinary(t *testing.T) {
 }
 
 func TestParseErrors(t *testing.T) {
-	for _, bad := range []string{`{`, `{a:}`, `[1,2`, `{a:1 b:2}`, ``, `{a:1},`} {
+	for _, bad := range []string{`{`, `{a:}`, `[1,2`, `{a:1 b:2}`, ``, `{a:1},`, `"\x4"`, `"\uZZZZ"`, `"\U00110000"`} {
 		if _, err := Parse(bad); err == nil {
 			t.Errorf("Parse(%q) = nil error, want error", bad)
 		}
 	}
 }
+
+func TestParseEscapes(t *testing.T) {
+	tests := []struct {
+		in   string
+		want String
+	}{
+		{`"line\nbreak"`, "line\nbreak"},
+		{`'tab\there'`, "tab\there"},
+		{`"a\sb"`, "a b"},
+		{`"\x41\u00e9\U0001F600"`, "Aé😀"},
+		{`"say \"hi\""`, `say "hi"`},
+		{`'it\'s'`, "it's"},
+		{`"back\\slash"`, `back\slash`},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(%s) error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%s) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestStringifyParseRoundTrip(t *testing.T) {
+	in := Compound{
+		"text":  String("first line\n\tsecond \"quoted\" \x01"),
+		"b":     Byte(1),
+		"items": &List{ElementType: TagCompound, Elements: []Tag{Compound{"id": String("minecraft:stone")}}},
+		"ints":  IntArray{1, -2},
+	}
+	s := Stringify(in)
+	if want := `{b:1b,ints:[I;1,-2],items:[{id:"minecraft:stone"}],text:"first line\n\tsecond \"quoted\" \x01"}`; s != want {
+		t.Errorf("Stringify = %s, want %s", s, want)
+	}
+	out, err := Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(Stringify) error: %v", err)
+	}
+	if Stringify(out) != s {
+		t.Errorf("round trip = %s, want %s", Stringify(out), s)
+	}
+}
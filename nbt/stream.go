@@ -0,0 +1,241 @@
+package nbt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// StreamWriter encodes NBT incrementally, without building a Tag tree first.
+// It is the writing counterpart of the Visitor API: compounds and lists are
+// opened with BeginCompound and BeginList and closed with End, and values are
+// written in between.
+//
+// Every value takes a name. Inside a compound it is the entry name; for the
+// root it is the root name (ignored in network format); for list elements it
+// is ignored. Lists must declare their element type and length up front, as
+// the length precedes the elements on the wire.
+//
+//	sw := nbt.NewStreamWriter(w, true)
+//	sw.BeginCompound("")
+//	sw.WriteString("name", "Steve")
+//	sw.BeginList("Inventory", nbt.TagCompound, len(items))
+//	for _, item := range items {
+//		sw.BeginCompound("")
+//		sw.WriteString("id", item.ID)
+//		sw.WriteInt8("count", item.Count)
+//		sw.End()
+//	}
+//	sw.End() // Inventory
+//	sw.End() // root
+type StreamWriter struct {
+	w       *Writer
+	network bool
+	stack   []streamFrame
+	started bool // root tag header written
+}
+
+type streamFrame struct {
+	list     bool
+	elemType byte
+	length   int
+	written  int
+}
+
+// NewStreamWriter creates a StreamWriter that writes to w.
+//
+// If network is true, writes in network format (no root name).
+func NewStreamWriter(w io.Writer, network bool) *StreamWriter {
+	return &StreamWriter{w: NewWriterTo(w), network: network}
+}
+
+// Depth returns the number of compounds and lists that are open.
+func (s *StreamWriter) Depth() int {
+	return len(s.stack)
+}
+
+// BeginCompound starts a compound. Entries are written until the matching End.
+func (s *StreamWriter) BeginCompound(name string) error {
+	if err := s.header(TagCompound, name); err != nil {
+		return err
+	}
+	s.stack = append(s.stack, streamFrame{})
+	return nil
+}
+
+// BeginList starts a list of length elements of elemType. Exactly length
+// elements must be written before the matching End.
+func (s *StreamWriter) BeginList(name string, elemType byte, length int) error {
+	if length < 0 {
+		return fmt.Errorf("negative list length: %d", length)
+	}
+	if elemType > TagLongArray || elemType == TagEnd && length > 0 {
+		return fmt.Errorf("invalid list element type %d for length %d", elemType, length)
+	}
+	if err := s.header(TagList, name); err != nil {
+		return err
+	}
+	wireType := elemType
+	if length == 0 {
+		wireType = TagEnd
+	}
+	if err := s.w.writeByte(wireType); err != nil {
+		return err
+	}
+	if err := s.w.writeInt(int32(length)); err != nil {
+		return err
+	}
+	s.stack = append(s.stack, streamFrame{list: true, elemType: elemType, length: length})
+	return nil
+}
+
+// End closes the innermost open compound or list.
+func (s *StreamWriter) End() error {
+	if len(s.stack) == 0 {
+		return errors.New("End without open compound or list")
+	}
+	top := s.stack[len(s.stack)-1]
+	s.stack = s.stack[:len(s.stack)-1]
+	if top.list {
+		if top.written != top.length {
+			return fmt.Errorf("list has %d of %d elements", top.written, top.length)
+		}
+		return nil
+	}
+	return s.w.writeByte(TagEnd)
+}
+
+// WriteInt8 writes a TAG_Byte.
+func (s *StreamWriter) WriteInt8(name string, v int8) error {
+	if err := s.header(TagByte, name); err != nil {
+		return err
+	}
+	return Byte(v).write(s.w)
+}
+
+// WriteBool writes a TAG_Byte of 1 or 0.
+func (s *StreamWriter) WriteBool(name string, v bool) error {
+	if v {
+		return s.WriteInt8(name, 1)
+	}
+	return s.WriteInt8(name, 0)
+}
+
+// WriteInt16 writes a TAG_Short.
+func (s *StreamWriter) WriteInt16(name string, v int16) error {
+	if err := s.header(TagShort, name); err != nil {
+		return err
+	}
+	return Short(v).write(s.w)
+}
+
+// WriteInt32 writes a TAG_Int.
+func (s *StreamWriter) WriteInt32(name string, v int32) error {
+	if err := s.header(TagInt, name); err != nil {
+		return err
+	}
+	return Int(v).write(s.w)
+}
+
+// WriteInt64 writes a TAG_Long.
+func (s *StreamWriter) WriteInt64(name string, v int64) error {
+	if err := s.header(TagLong, name); err != nil {
+		return err
+	}
+	return Long(v).write(s.w)
+}
+
+// WriteFloat32 writes a TAG_Float.
+func (s *StreamWriter) WriteFloat32(name string, v float32) error {
+	if err := s.header(TagFloat, name); err != nil {
+		return err
+	}
+	return Float(v).write(s.w)
+}
+
+// WriteFloat64 writes a TAG_Double.
+func (s *StreamWriter) WriteFloat64(name string, v float64) error {
+	if err := s.header(TagDouble, name); err != nil {
+		return err
+	}
+	return Double(v).write(s.w)
+}
+
+// WriteString writes a TAG_String.
+func (s *StreamWriter) WriteString(name string, v string) error {
+	if err := s.header(TagString, name); err != nil {
+		return err
+	}
+	return String(v).write(s.w)
+}
+
+// WriteByteArray writes a TAG_Byte_Array.
+func (s *StreamWriter) WriteByteArray(name string, v []byte) error {
+	if err := s.header(TagByteArray, name); err != nil {
+		return err
+	}
+	return ByteArray(v).write(s.w)
+}
+
+// WriteIntArray writes a TAG_Int_Array.
+func (s *StreamWriter) WriteIntArray(name string, v []int32) error {
+	if err := s.header(TagIntArray, name); err != nil {
+		return err
+	}
+	return IntArray(v).write(s.w)
+}
+
+// WriteLongArray writes a TAG_Long_Array, e.g. a heightmap.
+func (s *StreamWriter) WriteLongArray(name string, v []int64) error {
+	if err := s.header(TagLongArray, name); err != nil {
+		return err
+	}
+	return LongArray(v).write(s.w)
+}
+
+// WriteTag writes a complete tag, for parts of the structure that are already
+// materialized.
+func (s *StreamWriter) WriteTag(name string, tag Tag) error {
+	if tag.ID() == TagEnd {
+		return errors.New("cannot write TAG_End as a value")
+	}
+	if err := s.header(tag.ID(), name); err != nil {
+		return err
+	}
+	return tag.write(s.w)
+}
+
+// header writes what precedes a value in the current context: the tag type
+// and name in a compound or at the root, nothing in a list.
+func (s *StreamWriter) header(tagType byte, name string) error {
+	if len(s.stack) == 0 {
+		if s.started {
+			return errors.New("root tag already written")
+		}
+		s.started = true
+		if err := s.w.writeByte(tagType); err != nil {
+			return err
+		}
+		if s.network {
+			return nil
+		}
+		return s.w.writeString(name)
+	}
+
+	top := &s.stack[len(s.stack)-1]
+	if !top.list {
+		if err := s.w.writeByte(tagType); err != nil {
+			return err
+		}
+		return s.w.writeString(name)
+	}
+	if tagType != top.elemType {
+		return fmt.Errorf("list element %d has type %s, expected %s",
+			top.written, TagName(tagType), TagName(top.elemType))
+	}
+	if top.written == top.length {
+		return fmt.Errorf("list has more than %d elements", top.length)
+	}
+	top.written++
+	return nil
+}
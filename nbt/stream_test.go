@@ -0,0 +1,148 @@
+package nbt_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-mclib/protocol/nbt"
+)
+
+// streamTestTag is the tree written by writeStreamTestTag; keys are in sorted
+// order so the output matches Encode byte for byte.
+var streamTestTag = nbt.Compound{
+	"Health": nbt.Float(20),
+	"Heightmaps": nbt.Compound{
+		"MOTION_BLOCKING": nbt.LongArray{1, 2, 3},
+	},
+	"Inventory": nbt.List{ElementType: nbt.TagCompound, Elements: []nbt.Tag{
+		nbt.Compound{"count": nbt.Byte(64), "id": nbt.String("minecraft:stone")},
+		nbt.Compound{"count": nbt.Byte(1), "id": nbt.String("minecraft:diamond_sword")},
+	}},
+	"Pos":    nbt.List{ElementType: nbt.TagDouble, Elements: []nbt.Tag{nbt.Double(1.5), nbt.Double(64), nbt.Double(-3)}},
+	"Tags":   nbt.List{ElementType: nbt.TagEnd},
+	"UUID":   nbt.IntArray{1, 2, 3, 4},
+	"data":   nbt.ByteArray{9},
+	"flying": nbt.Byte(1),
+	"level":  nbt.Int(30),
+	"name":   nbt.String("Steve"),
+	"seed":   nbt.Long(-42),
+	"slot":   nbt.Short(8),
+	"speed":  nbt.Double(0.1),
+	"extra":  nbt.Compound{"a": nbt.String("b")},
+}
+
+func writeStreamTestTag(t *testing.T, sw *nbt.StreamWriter, rootName string) {
+	t.Helper()
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	must(sw.BeginCompound(rootName))
+	must(sw.WriteFloat32("Health", 20))
+	must(sw.BeginCompound("Heightmaps"))
+	must(sw.WriteLongArray("MOTION_BLOCKING", []int64{1, 2, 3}))
+	must(sw.End())
+	must(sw.BeginList("Inventory", nbt.TagCompound, 2))
+	for _, item := range []struct {
+		count int8
+		id    string
+	}{{64, "minecraft:stone"}, {1, "minecraft:diamond_sword"}} {
+		must(sw.BeginCompound(""))
+		must(sw.WriteInt8("count", item.count))
+		must(sw.WriteString("id", item.id))
+		must(sw.End())
+	}
+	must(sw.End())
+	must(sw.BeginList("Pos", nbt.TagDouble, 3))
+	for _, v := range []float64{1.5, 64, -3} {
+		must(sw.WriteFloat64("", v))
+	}
+	must(sw.End())
+	must(sw.BeginList("Tags", nbt.TagString, 0))
+	must(sw.End())
+	must(sw.WriteIntArray("UUID", []int32{1, 2, 3, 4}))
+	must(sw.WriteByteArray("data", []byte{9}))
+	must(sw.WriteTag("extra", nbt.Compound{"a": nbt.String("b")}))
+	must(sw.WriteBool("flying", true))
+	must(sw.WriteInt32("level", 30))
+	must(sw.WriteString("name", "Steve"))
+	must(sw.WriteInt64("seed", -42))
+	must(sw.WriteInt16("slot", 8))
+	must(sw.WriteFloat64("speed", 0.1))
+	if sw.Depth() != 1 {
+		t.Fatalf("Depth() = %d, want 1", sw.Depth())
+	}
+	must(sw.End())
+}
+
+func TestStreamWriter(t *testing.T) {
+	for _, network := range []bool{true, false} {
+		var buf bytes.Buffer
+		writeStreamTestTag(t, nbt.NewStreamWriter(&buf, network), "Player")
+
+		want, err := nbt.Encode(streamTestTag, "Player", network)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf.Bytes(), want) {
+			t.Errorf("network=%v: stream output differs from Encode\ngot  %x\nwant %x", network, buf.Bytes(), want)
+		}
+	}
+}
+
+func TestStreamWriterErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		write func(sw *nbt.StreamWriter) error
+	}{
+		{"end without begin", func(sw *nbt.StreamWriter) error {
+			return sw.End()
+		}},
+		{"second root", func(sw *nbt.StreamWriter) error {
+			if err := sw.WriteInt32("", 1); err != nil {
+				return nil
+			}
+			return sw.WriteInt32("", 2)
+		}},
+		{"wrong element type", func(sw *nbt.StreamWriter) error {
+			if err := sw.BeginList("", nbt.TagInt, 1); err != nil {
+				return nil
+			}
+			return sw.WriteString("", "x")
+		}},
+		{"too many elements", func(sw *nbt.StreamWriter) error {
+			if err := sw.BeginList("", nbt.TagInt, 1); err != nil {
+				return nil
+			}
+			if err := sw.WriteInt32("", 1); err != nil {
+				return nil
+			}
+			return sw.WriteInt32("", 2)
+		}},
+		{"too few elements", func(sw *nbt.StreamWriter) error {
+			if err := sw.BeginList("", nbt.TagInt, 2); err != nil {
+				return nil
+			}
+			if err := sw.WriteInt32("", 1); err != nil {
+				return nil
+			}
+			return sw.End()
+		}},
+		{"negative length", func(sw *nbt.StreamWriter) error {
+			return sw.BeginList("", nbt.TagInt, -1)
+		}},
+		{"list of end", func(sw *nbt.StreamWriter) error {
+			return sw.BeginList("", nbt.TagEnd, 1)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.write(nbt.NewStreamWriter(&bytes.Buffer{}, true)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
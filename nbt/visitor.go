@@ -1,5 +1,7 @@
 package nbt
 
+import "fmt"
+
 // Visitor defines the interface for visiting NBT structures in a streaming fashion.
 // This allows processing NBT data without loading it entirely into memory.
 type Visitor interface {
@@ -240,6 +242,9 @@ func visitTagPayload(r *Reader, tagType byte, v Visitor) error {
 		if err != nil {
 			return err
 		}
+		if err := r.checkLength("byte array", length, 1); err != nil {
+			return err
+		}
 		data := make([]byte, length)
 		if err := r.readFull(data); err != nil {
 			return err
@@ -264,6 +269,9 @@ func visitTagPayload(r *Reader, tagType byte, v Visitor) error {
 		if err != nil {
 			return err
 		}
+		if err := r.checkLength("int array", length, 4); err != nil {
+			return err
+		}
 		data := make([]int32, length)
 		for i := range data {
 			data[i], err = r.readInt()
@@ -278,6 +286,9 @@ func visitTagPayload(r *Reader, tagType byte, v Visitor) error {
 		if err != nil {
 			return err
 		}
+		if err := r.checkLength("long array", length, 8); err != nil {
+			return err
+		}
 		data := make([]int64, length)
 		for i := range data {
 			data[i], err = r.readLong()
@@ -288,7 +299,7 @@ func visitTagPayload(r *Reader, tagType byte, v Visitor) error {
 		return v.VisitLongArray(data)
 
 	default:
-		return nil
+		return fmt.Errorf("unknown tag type: %d", tagType)
 	}
 }
 
@@ -302,6 +313,9 @@ func visitList(r *Reader, v Visitor) error {
 	if err != nil {
 		return err
 	}
+	if err := r.checkListLength(elemType, length); err != nil {
+		return err
+	}
 
 	elemVisitor, err := v.VisitListStart(elemType, int(length))
 	if err != nil {
@@ -381,12 +395,19 @@ func skipTagPayload(r *Reader, tagType byte) error {
 		if err != nil {
 			return err
 		}
+		if err := r.checkLength("byte array", length, 1); err != nil {
+			return err
+		}
 		data := make([]byte, length)
 		return r.readFull(data)
 	case TagString:
 		_, err := r.readString()
 		return err
 	case TagList:
+		if err := r.pushDepth(); err != nil {
+			return err
+		}
+		defer r.popDepth()
 		elemType, err := r.readByte()
 		if err != nil {
 			return err
@@ -395,6 +416,9 @@ func skipTagPayload(r *Reader, tagType byte) error {
 		if err != nil {
 			return err
 		}
+		if err := r.checkListLength(elemType, length); err != nil {
+			return err
+		}
 		for range length {
 			if err := skipTagPayload(r, elemType); err != nil {
 				return err
@@ -402,6 +426,10 @@ func skipTagPayload(r *Reader, tagType byte) error {
 		}
 		return nil
 	case TagCompound:
+		if err := r.pushDepth(); err != nil {
+			return err
+		}
+		defer r.popDepth()
 		for {
 			entryType, err := r.readByte()
 			if err != nil {
@@ -422,6 +450,9 @@ func skipTagPayload(r *Reader, tagType byte) error {
 		if err != nil {
 			return err
 		}
+		if err := r.checkLength("int array", length, 4); err != nil {
+			return err
+		}
 		for range length {
 			if _, err := r.readInt(); err != nil {
 				return err
@@ -433,6 +464,9 @@ func skipTagPayload(r *Reader, tagType byte) error {
 		if err != nil {
 			return err
 		}
+		if err := r.checkLength("long array", length, 8); err != nil {
+			return err
+		}
 		for range length {
 			if _, err := r.readLong(); err != nil {
 				return err
@@ -440,6 +474,6 @@ func skipTagPayload(r *Reader, tagType byte) error {
 		}
 		return nil
 	default:
-		return nil
+		return fmt.Errorf("unknown tag type: %d", tagType)
 	}
 }
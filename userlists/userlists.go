@@ -0,0 +1,197 @@
+// Package userlists reads and writes the vanilla server's player list files:
+// whitelist.json, ops.json, banned-players.json and banned-ips.json. Server
+// implementations can use it to share admin files with vanilla servers and
+// their tooling.
+package userlists
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// File names the vanilla server uses in its working directory.
+const (
+	WhitelistFile     = "whitelist.json"
+	OpsFile           = "ops.json"
+	BannedPlayersFile = "banned-players.json"
+	BannedIPsFile     = "banned-ips.json"
+)
+
+// Defaults the vanilla server fills in for the ban commands.
+const (
+	DefaultBanSource = "Server"
+	DefaultBanReason = "Banned by an operator."
+)
+
+// DateFormat is the format of ban dates (Java's "yyyy-MM-dd HH:mm:ss Z").
+const DateFormat = "2006-01-02 15:04:05 -0700"
+
+// expiresForever is written instead of a date for permanent bans.
+const expiresForever = "forever"
+
+// Player identifies a player in a list.
+type Player struct {
+	UUID ns.UUID `json:"uuid"`
+	Name string  `json:"name"`
+}
+
+// WhitelistEntry is an entry of whitelist.json.
+type WhitelistEntry = Player
+
+// OpEntry is an entry of ops.json.
+type OpEntry struct {
+	UUID ns.UUID `json:"uuid"`
+	Name string  `json:"name"`
+	// Level is the permission level, 1 to 4.
+	Level               int  `json:"level"`
+	BypassesPlayerLimit bool `json:"bypassesPlayerLimit"`
+}
+
+// Ban holds the fields common to player and IP bans.
+type Ban struct {
+	Created time.Time
+	// Source is who issued the ban: a player name, or "Server" for the console.
+	Source string
+	// Expires is the end of a temporary ban, zero if the ban is permanent.
+	Expires time.Time
+	Reason  string
+}
+
+// NewBan creates a ban starting now. Empty source and reason are replaced by
+// the vanilla defaults; a zero expires makes the ban permanent.
+func NewBan(source, reason string, expires time.Time) Ban {
+	if source == "" {
+		source = DefaultBanSource
+	}
+	if reason == "" {
+		reason = DefaultBanReason
+	}
+	return Ban{Created: time.Now().Truncate(time.Second), Source: source, Expires: expires, Reason: reason}
+}
+
+// Expired reports whether a temporary ban has ended at now.
+func (b Ban) Expired(now time.Time) bool {
+	return !b.Expires.IsZero() && !now.Before(b.Expires)
+}
+
+// banJSON is the JSON form of Ban, with dates as strings.
+type banJSON struct {
+	Created string `json:"created"`
+	Source  string `json:"source"`
+	Expires string `json:"expires"`
+	Reason  string `json:"reason"`
+}
+
+func (b Ban) toJSON() banJSON {
+	j := banJSON{Created: b.Created.Format(DateFormat), Source: b.Source, Expires: expiresForever, Reason: b.Reason}
+	if !b.Expires.IsZero() {
+		j.Expires = b.Expires.Format(DateFormat)
+	}
+	return j
+}
+
+// fromJSON converts the JSON form. Like the vanilla server, dates it cannot
+// parse are treated as unset: now for the creation date, permanent for the
+// expiry.
+func (j banJSON) fromJSON() Ban {
+	b := Ban{Source: j.Source, Reason: j.Reason}
+	if t, err := time.Parse(DateFormat, j.Created); err == nil {
+		b.Created = t
+	} else {
+		b.Created = time.Now().Truncate(time.Second)
+	}
+	if t, err := time.Parse(DateFormat, j.Expires); err == nil {
+		b.Expires = t
+	}
+	return b
+}
+
+// PlayerBan is an entry of banned-players.json.
+type PlayerBan struct {
+	Player
+	Ban
+}
+
+func (e PlayerBan) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Player
+		banJSON
+	}{e.Player, e.Ban.toJSON()})
+}
+
+func (e *PlayerBan) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		Player
+		banJSON
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	e.Player, e.Ban = aux.Player, aux.banJSON.fromJSON()
+	return nil
+}
+
+// IPBan is an entry of banned-ips.json.
+type IPBan struct {
+	IP string
+	Ban
+}
+
+func (e IPBan) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		IP string `json:"ip"`
+		banJSON
+	}{e.IP, e.Ban.toJSON()})
+}
+
+func (e *IPBan) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		IP string `json:"ip"`
+		banJSON
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	e.IP, e.Ban = aux.IP, aux.banJSON.fromJSON()
+	return nil
+}
+
+// Load reads a list file, e.g. Load[OpEntry](OpsFile). A missing file is an
+// empty list, as for the vanilla server.
+func Load[T any](path string) ([]T, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	var entries []T
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return entries, nil
+}
+
+// Save writes a list file, indented like the vanilla server does. The file is
+// replaced atomically.
+func Save[T any](path string, entries []T) error {
+	if entries == nil {
+		entries = []T{}
+	}
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", path, err)
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,168 @@
+package userlists_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/userlists"
+)
+
+// as written by a vanilla 26.1 server
+const (
+	vanillaOps = `[
+  {
+    "uuid": "069a79f4-44e9-4726-a5be-fca90e38aaf5",
+    "name": "Notch",
+    "level": 4,
+    "bypassesPlayerLimit": false
+  }
+]`
+	vanillaBannedPlayers = `[
+  {
+    "uuid": "853c80ef-3c37-49fd-aa49-938b674adae6",
+    "name": "jeb_",
+    "created": "2026-03-24 18:02:11 +0100",
+    "source": "Notch",
+    "expires": "forever",
+    "reason": "Banned by an operator."
+  }
+]`
+	vanillaBannedIPs = `[
+  {
+    "ip": "203.0.113.7",
+    "created": "2026-03-24 18:05:42 +0100",
+    "source": "Server",
+    "expires": "2026-04-24 18:05:42 +0100",
+    "reason": "Spam"
+  }
+]`
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func mustUUID(t *testing.T, s string) ns.UUID {
+	t.Helper()
+	u, err := ns.UUIDFromString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+// roundTrip checks that saving what was loaded gives back the vanilla file.
+func roundTrip[T any](t *testing.T, path, want string, entries []T) {
+	t.Helper()
+	if err := userlists.Save(path, entries); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != want {
+		t.Errorf("Save() wrote\n%s\nwant\n%s", data, want)
+	}
+}
+
+func TestOps(t *testing.T) {
+	path := writeFile(t, userlists.OpsFile, vanillaOps)
+	ops, err := userlists.Load[userlists.OpEntry](path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	want := []userlists.OpEntry{{UUID: mustUUID(t, "069a79f4-44e9-4726-a5be-fca90e38aaf5"), Name: "Notch", Level: 4}}
+	if !reflect.DeepEqual(ops, want) {
+		t.Errorf("Load() = %+v, want %+v", ops, want)
+	}
+	roundTrip(t, path, vanillaOps, ops)
+}
+
+func TestBannedPlayers(t *testing.T) {
+	path := writeFile(t, userlists.BannedPlayersFile, vanillaBannedPlayers)
+	bans, err := userlists.Load[userlists.PlayerBan](path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(bans) != 1 {
+		t.Fatalf("Load() = %+v", bans)
+	}
+	ban := bans[0]
+	if ban.Name != "jeb_" || ban.UUID != mustUUID(t, "853c80ef3c3749fdaa49938b674adae6") || ban.Source != "Notch" {
+		t.Errorf("ban = %+v", ban)
+	}
+	if !ban.Expires.IsZero() || ban.Expired(time.Now().AddDate(100, 0, 0)) {
+		t.Errorf("ban should be permanent, expires = %v", ban.Expires)
+	}
+	if created := ban.Created.UTC(); created != time.Date(2026, 3, 24, 17, 2, 11, 0, time.UTC) {
+		t.Errorf("Created = %v", created)
+	}
+	roundTrip(t, path, vanillaBannedPlayers, bans)
+}
+
+func TestBannedIPs(t *testing.T) {
+	path := writeFile(t, userlists.BannedIPsFile, vanillaBannedIPs)
+	bans, err := userlists.Load[userlists.IPBan](path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(bans) != 1 || bans[0].IP != "203.0.113.7" || bans[0].Reason != "Spam" {
+		t.Fatalf("Load() = %+v", bans)
+	}
+	expires := bans[0].Expires
+	if bans[0].Expired(expires.Add(-time.Second)) || !bans[0].Expired(expires) {
+		t.Errorf("Expired() around %v is wrong", expires)
+	}
+	roundTrip(t, path, vanillaBannedIPs, bans)
+}
+
+func TestWhitelist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), userlists.WhitelistFile)
+
+	// a missing file is an empty list, and an empty list is saved as []
+	entries, err := userlists.Load[userlists.WhitelistEntry](path)
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("Load() = %+v, %v", entries, err)
+	}
+	roundTrip(t, path, "[]", entries)
+
+	entries = append(entries, userlists.WhitelistEntry{UUID: mustUUID(t, "069a79f4-44e9-4726-a5be-fca90e38aaf5"), Name: "Notch"})
+	if err := userlists.Save(path, entries); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := userlists.Load[userlists.WhitelistEntry](path)
+	if err != nil || !reflect.DeepEqual(loaded, entries) {
+		t.Errorf("Load() = %+v, %v, want %+v", loaded, err, entries)
+	}
+}
+
+func TestNewBan(t *testing.T) {
+	ban := userlists.NewBan("", "", time.Time{})
+	if ban.Source != userlists.DefaultBanSource || ban.Reason != userlists.DefaultBanReason || !ban.Expires.IsZero() {
+		t.Errorf("NewBan() = %+v", ban)
+	}
+	if ban.Created.IsZero() || ban.Expired(time.Now()) {
+		t.Errorf("NewBan() = %+v", ban)
+	}
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	path := writeFile(t, userlists.OpsFile, `{"uuid":"not-a-uuid"}`)
+	if _, err := userlists.Load[userlists.OpEntry](path); err == nil {
+		t.Error("expected error for malformed file")
+	}
+	path = writeFile(t, userlists.OpsFile, `[{"uuid":"not-a-uuid","name":"x"}]`)
+	if _, err := userlists.Load[userlists.OpEntry](path); err == nil {
+		t.Error("expected error for malformed UUID")
+	}
+}